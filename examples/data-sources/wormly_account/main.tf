@@ -0,0 +1,48 @@
+terraform {
+  required_providers {
+    wormly = {
+      source = "radarnex/wormly"
+    }
+  }
+}
+
+provider "wormly" {
+  api_key = var.wormly_api_key
+}
+
+variable "wormly_api_key" {
+  description = "Wormly API key"
+  type        = string
+  sensitive   = true
+}
+
+variable "monitored_urls" {
+  description = "URLs to monitor with an HTTP sensor each"
+  type        = list(string)
+  default     = ["https://example.com"]
+}
+
+data "wormly_account" "current" {}
+
+resource "wormly_host" "web" {
+  name = "web"
+}
+
+resource "wormly_sensor_http" "web" {
+  for_each = toset(var.monitored_urls)
+
+  host_id = wormly_host.web.id
+  url     = each.value
+
+  lifecycle {
+    precondition {
+      condition     = data.wormly_account.current.sensors_used + length(var.monitored_urls) <= data.wormly_account.current.sensors_allowed
+      error_message = "Not enough sensor quota left on the ${data.wormly_account.current.plan_name} plan."
+    }
+  }
+}
+
+output "sensors_remaining" {
+  description = "Sensors that can still be created on the account"
+  value       = data.wormly_account.current.sensors_allowed - data.wormly_account.current.sensors_used
+}
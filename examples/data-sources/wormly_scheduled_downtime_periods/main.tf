@@ -0,0 +1,33 @@
+terraform {
+  required_providers {
+    wormly = {
+      source = "radarnex/wormly"
+    }
+  }
+}
+
+provider "wormly" {
+  api_key = var.wormly_api_key
+}
+
+variable "wormly_api_key" {
+  description = "Wormly API key"
+  type        = string
+  sensitive   = true
+}
+
+variable "host_id" {
+  description = "ID of the host to list downtime periods for"
+  type        = number
+}
+
+# List only the weekly maintenance windows of a host
+data "wormly_scheduled_downtime_periods" "weekly" {
+  host_id    = var.host_id
+  recurrence = "WEEKLY"
+}
+
+output "weekly_downtime" {
+  description = "Weekday and time range of each weekly downtime period"
+  value       = [for period in data.wormly_scheduled_downtime_periods.weekly.periods : "${period.on} ${period.start}-${period.end} ${period.timezone}"]
+}
@@ -0,0 +1,37 @@
+terraform {
+  required_providers {
+    wormly = {
+      source = "radarnex/wormly"
+    }
+  }
+}
+
+provider "wormly" {
+  api_key = var.wormly_api_key
+}
+
+variable "wormly_api_key" {
+  description = "Wormly API key"
+  type        = string
+  sensitive   = true
+}
+
+variable "existing_sensor_id" {
+  description = "ID of an existing HTTP sensor in the format <host_id>/<sensor_id>"
+  type        = string
+}
+
+# Query a single sensor
+data "wormly_sensor_http_lookup" "homepage" {
+  id = var.existing_sensor_id
+}
+
+output "homepage_url" {
+  description = "URL monitored by the sensor"
+  value       = data.wormly_sensor_http_lookup.homepage.url
+}
+
+output "homepage_timeout" {
+  description = "Timeout of the sensor in seconds"
+  value       = data.wormly_sensor_http_lookup.homepage.timeout
+}
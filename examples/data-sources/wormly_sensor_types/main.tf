@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    wormly = {
+      source = "radarnex/wormly"
+    }
+  }
+}
+
+provider "wormly" {
+  api_key = var.wormly_api_key
+}
+
+variable "wormly_api_key" {
+  description = "Wormly API key"
+  type        = string
+  sensitive   = true
+}
+
+# Look up sensor type names by identifier
+data "wormly_sensor_types" "all" {}
+
+output "http_sensor_type" {
+  description = "Name of sensor type 2"
+  value       = data.wormly_sensor_types.all.names["2"]
+}
@@ -0,0 +1,35 @@
+terraform {
+  required_providers {
+    wormly = {
+      source = "radarnex/wormly"
+    }
+  }
+}
+
+provider "wormly" {
+  api_key = var.wormly_api_key
+}
+
+variable "wormly_api_key" {
+  description = "Wormly API key"
+  type        = string
+  sensitive   = true
+}
+
+variable "host_ids" {
+  description = "IDs of the hosts to list HTTP sensors for"
+  type        = set(number)
+}
+
+# List the sensors of several hosts at once
+data "wormly_sensors_http_multi" "dashboard" {
+  host_ids = var.host_ids
+}
+
+output "sensor_urls_by_host" {
+  description = "URLs monitored on each host"
+  value = {
+    for host_id, host in data.wormly_sensors_http_multi.dashboard.sensors_by_host :
+    host_id => [for sensor in host.sensors : sensor.params.url]
+  }
+}
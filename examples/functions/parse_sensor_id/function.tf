@@ -0,0 +1,3 @@
+output "sensor_host_id" {
+  value = provider::wormly::parse_sensor_id(wormly_sensor_http.example.id).host_id
+}
@@ -0,0 +1,4 @@
+import {
+  to = wormly_sensor_http.example
+  id = provider::wormly::sensor_id(12345, 67890)
+}
@@ -0,0 +1,5 @@
+resource "wormly_contact" "oncall" {
+  name  = "On-call engineer"
+  email = "oncall@example.com"
+  sms   = "+15555550100"
+}
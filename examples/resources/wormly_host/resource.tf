@@ -20,7 +20,9 @@ variable "wormly_api_key" {
 # Create a host
 resource "wormly_host" "example" {
   name = "example"
-  # enabled       = false
+  # uptime_monitoring = false
+  # health_monitoring = true
+  # delete_associated = ["sensors", "downtime_periods"]
   test_interval = 60
 }
 
@@ -0,0 +1,12 @@
+resource "wormly_host" "web_1" {
+  name = "web-1"
+}
+
+resource "wormly_host" "web_2" {
+  name = "web-2"
+}
+
+resource "wormly_host_group" "web" {
+  name     = "Web servers"
+  host_ids = [wormly_host.web_1.id, wormly_host.web_2.id]
+}
@@ -0,0 +1,2 @@
+# Maintenance windows can be imported with the host ID and period ID
+terraform import wormly_maintenance_window.upgrade 123/456
@@ -0,0 +1,10 @@
+resource "wormly_host" "example" {
+  name = "example.com"
+}
+
+# Silence alerts during a one-off upgrade; a window may cross midnight
+resource "wormly_maintenance_window" "upgrade" {
+  host_id   = wormly_host.example.id
+  starts_at = "2025-12-31T23:00:00+01:00"
+  ends_at   = "2026-01-01T03:00:00+01:00"
+}
@@ -0,0 +1,8 @@
+resource "wormly_sensor_acknowledgement" "checkout" {
+  sensor_id = 456
+
+  # Change to acknowledge the sensor again during a later incident
+  triggers = {
+    incident = "INC-1234"
+  }
+}
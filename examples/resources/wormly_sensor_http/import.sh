@@ -0,0 +1,5 @@
+# HTTP sensors can be imported with the host ID and sensor ID
+terraform import wormly_sensor_http.example 123/456
+
+# or with the sensor ID alone, in which case every host is searched for the sensor
+terraform import wormly_sensor_http.example 456
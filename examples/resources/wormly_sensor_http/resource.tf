@@ -34,6 +34,12 @@ resource "wormly_sensor_http" "example" {
   verify_ssl_cert = true
 }
 
+# Reference an existing host by name instead of by ID
+resource "wormly_sensor_http" "by_host_name" {
+  host_name = "example"
+  url       = "https://example.com/health"
+}
+
 # Output the created resources
 output "host_id" {
   description = "ID of the created host"
@@ -0,0 +1,13 @@
+resource "wormly_host" "web" {
+  name = "web-1"
+}
+
+resource "wormly_host" "api" {
+  name = "api-1"
+}
+
+resource "wormly_status_page" "public" {
+  title    = "Service status"
+  host_ids = [wormly_host.web.id, wormly_host.api.id]
+  public   = true
+}
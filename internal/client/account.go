@@ -0,0 +1,141 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// AccountInfo represents the Wormly account the API key belongs to.
+type AccountInfo struct {
+	Plan string `json:"plan"`
+	// APIRateLimit is the number of API requests per second the plan allows, or 0 when the API does not report it.
+	APIRateLimit float64 `json:"api_rate_limit"`
+}
+
+// WormlyAccountInfoResponse represents the API response for getAccountInfo.
+type WormlyAccountInfoResponse struct {
+	ErrorCode int    `json:"errorcode"`
+	Message   string `json:"message,omitempty"`
+	Account   struct {
+		Plan         string      `json:"plan"`
+		APIRateLimit json.Number `json:"apiratelimit"` // Can be returned as string or number
+	} `json:"account"`
+}
+
+// AccountAPI defines the interface for account-related operations.
+type AccountAPI interface {
+	GetAccountInfo(ctx context.Context) (*AccountInfo, error)
+	GetAccount(ctx context.Context) (*Account, error)
+	Ping(ctx context.Context) error
+}
+
+// Ensure Client implements AccountAPI.
+var _ AccountAPI = (*Client)(nil)
+
+// GetAccountInfo retrieves details of the account the API key belongs to.
+func (c *Client) GetAccountInfo(ctx context.Context) (*AccountInfo, error) {
+	var response WormlyAccountInfoResponse
+	if err := c.makeFormRequestGET(ctx, "getAccountInfo", nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get account info: %w", err)
+	}
+
+	if response.ErrorCode != 0 {
+		return nil, fmt.Errorf("API returned error code %d: %s", response.ErrorCode, response.Message)
+	}
+
+	account := &AccountInfo{
+		Plan: response.Account.Plan,
+	}
+	if response.Account.APIRateLimit != "" {
+		rateLimit, err := strconv.ParseFloat(response.Account.APIRateLimit.String(), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid apiratelimit value: %s", response.Account.APIRateLimit)
+		}
+		account.APIRateLimit = rateLimit
+	}
+
+	return account, nil
+}
+
+// Ping checks that the API is reachable and accepts the API key, using the
+// cheapest read the API offers. An API key that is rejected is reported as an
+// error that matches ErrAuthFailed.
+func (c *Client) Ping(ctx context.Context) error {
+	var response WormlyAccountInfoResponse
+	if err := c.makeFormRequestGET(ctx, "getAccountInfo", nil, &response); err != nil {
+		return fmt.Errorf("failed to reach the Wormly API: %w", err)
+	}
+
+	if response.ErrorCode != 0 {
+		return mapErrorCode(response.ErrorCode, response.Message)
+	}
+
+	return nil
+}
+
+// Account represents the plan, sensor quota and alerting state of the Wormly account.
+type Account struct {
+	PlanName       string
+	SensorsUsed    int
+	SensorsAllowed int
+	AlertsMuted    bool
+}
+
+// WormlyAccountResponse represents the API response for getAccount.
+type WormlyAccountResponse struct {
+	ErrorCode int    `json:"errorcode"`
+	Message   string `json:"message,omitempty"`
+	Account   struct {
+		PlanName       string      `json:"planname"`
+		SensorsUsed    json.Number `json:"sensorsused"`    // Can be returned as string or number
+		SensorsAllowed json.Number `json:"sensorsallowed"` // Can be returned as string or number
+		AlertsMuted    json.Number `json:"alertsmuted"`    // Can be returned as string or number
+	} `json:"account"`
+}
+
+// GetAccount retrieves the plan and sensor quota of the account the API key belongs to.
+func (c *Client) GetAccount(ctx context.Context) (*Account, error) {
+	var response WormlyAccountResponse
+	if err := c.makeFormRequestGET(ctx, "getAccount", nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	if response.ErrorCode != 0 {
+		return nil, fmt.Errorf("API returned error code %d: %s", response.ErrorCode, response.Message)
+	}
+
+	account := &Account{
+		PlanName: response.Account.PlanName,
+	}
+
+	fields := []struct {
+		name  string
+		value json.Number
+		dest  *int
+	}{
+		{name: "sensorsused", value: response.Account.SensorsUsed, dest: &account.SensorsUsed},
+		{name: "sensorsallowed", value: response.Account.SensorsAllowed, dest: &account.SensorsAllowed},
+	}
+	for _, field := range fields {
+		if field.value == "" {
+			continue
+		}
+		n, err := strconv.Atoi(field.value.String())
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value: %s", field.name, field.value)
+		}
+		*field.dest = n
+	}
+
+	if response.Account.AlertsMuted != "" {
+		muted, err := strconv.Atoi(response.Account.AlertsMuted.String())
+		if err != nil {
+			return nil, fmt.Errorf("invalid alertsmuted value: %s", response.Account.AlertsMuted)
+		}
+		account.AlertsMuted = muted != 0
+	}
+
+	return account, nil
+}
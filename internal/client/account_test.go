@@ -0,0 +1,232 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetAccountInfo(t *testing.T) {
+	tests := []struct {
+		name           string
+		responseBody   string
+		expectedError  bool
+		expectedResult *AccountInfo
+	}{
+		{
+			name:           "rate limit as number",
+			responseBody:   `{"errorcode": 0, "account": {"plan": "Pro", "apiratelimit": 5}}`,
+			expectedResult: &AccountInfo{Plan: "Pro", APIRateLimit: 5},
+		},
+		{
+			name:           "rate limit as string",
+			responseBody:   `{"errorcode": 0, "account": {"plan": "Basic", "apiratelimit": "0.5"}}`,
+			expectedResult: &AccountInfo{Plan: "Basic", APIRateLimit: 0.5},
+		},
+		{
+			name:           "rate limit not reported",
+			responseBody:   `{"errorcode": 0, "account": {"plan": "Pro"}}`,
+			expectedResult: &AccountInfo{Plan: "Pro"},
+		},
+		{
+			name:          "invalid rate limit",
+			responseBody:  `{"errorcode": 0, "account": {"apiratelimit": "fast"}}`,
+			expectedError: true,
+		},
+		{
+			name:          "API error",
+			responseBody:  `{"errorcode": 1, "message": "Unknown command"}`,
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal("getAccountInfo", r.FormValue("cmd"))
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, tt.responseBody)
+			}))
+			defer server.Close()
+
+			client, err := New(Options{
+				HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 10.0,
+				MaxRetries:        3,
+				InitialBackoff:    time.Second,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        30 * time.Second,
+			})
+			assert.NoError(err, "Failed to create client")
+
+			result, err := client.GetAccountInfo(t.Context())
+
+			if tt.expectedError {
+				assert.Error(err, "Expected error but got none")
+				return
+			}
+
+			assert.NoError(err, "Unexpected error")
+			assert.Equal(tt.expectedResult, result)
+		})
+	}
+}
+
+func TestClient_GetAccount(t *testing.T) {
+	tests := []struct {
+		name           string
+		responseBody   string
+		expectedError  bool
+		expectedResult *Account
+	}{
+		{
+			name:         "numbers as strings",
+			responseBody: `{"errorcode": 0, "account": {"planname": "Professional", "sensorsused": "12", "sensorsallowed": "50", "alertsmuted": "0"}}`,
+			expectedResult: &Account{
+				PlanName:       "Professional",
+				SensorsUsed:    12,
+				SensorsAllowed: 50,
+			},
+		},
+		{
+			name:         "numbers as numbers",
+			responseBody: `{"errorcode": 0, "account": {"planname": "Basic", "sensorsused": 5, "sensorsallowed": 5, "alertsmuted": 1}}`,
+			expectedResult: &Account{
+				PlanName:       "Basic",
+				SensorsUsed:    5,
+				SensorsAllowed: 5,
+				AlertsMuted:    true,
+			},
+		},
+		{
+			name:           "quota not reported",
+			responseBody:   `{"errorcode": 0, "account": {"planname": "Trial"}}`,
+			expectedResult: &Account{PlanName: "Trial"},
+		},
+		{
+			name:          "invalid sensor count",
+			responseBody:  `{"errorcode": 0, "account": {"sensorsused": "many"}}`,
+			expectedError: true,
+		},
+		{
+			name:          "invalid alerts muted",
+			responseBody:  `{"errorcode": 0, "account": {"alertsmuted": "yes"}}`,
+			expectedError: true,
+		},
+		{
+			name:          "API error",
+			responseBody:  `{"errorcode": 1, "message": "Unknown command"}`,
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal("getAccount", r.FormValue("cmd"))
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, tt.responseBody)
+			}))
+			defer server.Close()
+
+			client, err := New(Options{
+				HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 10.0,
+				MaxRetries:        3,
+				InitialBackoff:    time.Second,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        30 * time.Second,
+			})
+			assert.NoError(err, "Failed to create client")
+
+			result, err := client.GetAccount(t.Context())
+
+			if tt.expectedError {
+				assert.Error(err, "Expected error but got none")
+				return
+			}
+
+			assert.NoError(err, "Unexpected error")
+			assert.Equal(tt.expectedResult, result)
+		})
+	}
+}
+
+func TestClient_Ping(t *testing.T) {
+	tests := []struct {
+		name             string
+		status           int
+		responseBody     string
+		expectedError    string
+		expectAuthFailed bool
+	}{
+		{
+			name:         "success",
+			status:       http.StatusOK,
+			responseBody: `{"errorcode": 0, "account": {"plan": "Pro"}}`,
+		},
+		{
+			name:             "invalid API key",
+			status:           http.StatusOK,
+			responseBody:     `{"errorcode": 3, "message": "Invalid API key"}`,
+			expectedError:    "Invalid API key",
+			expectAuthFailed: true,
+		},
+		{
+			name:          "unreachable API",
+			status:        http.StatusNotFound,
+			responseBody:  `not found`,
+			expectedError: "failed to reach the Wormly API",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal("getAccountInfo", r.FormValue("cmd"))
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, tt.responseBody)
+			}))
+			defer server.Close()
+
+			client, err := New(Options{
+				HTTPClient:        &http.Client{},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 1000.0,
+				MaxRetries:        0,
+				InitialBackoff:    time.Millisecond,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        10 * time.Millisecond,
+			})
+			assert.NoError(err, "Failed to create client")
+
+			err = client.Ping(t.Context())
+
+			if tt.expectedError == "" {
+				assert.NoError(err)
+				return
+			}
+			assert.ErrorContains(err, tt.expectedError)
+			assert.Equal(tt.expectAuthFailed, errors.Is(err, ErrAuthFailed))
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultCircuitBreakerCooldown is how long an open circuit breaker rejects requests before probing the API again.
+const DefaultCircuitBreakerCooldown = 30 * time.Second
+
+// ErrCircuitOpen is wrapped by errors for requests rejected because the API has
+// failed too many times in a row.
+var ErrCircuitOpen = errors.New("circuit breaker open")
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+// circuitBreaker stops sending requests after threshold consecutive transient
+// failures. Once open it rejects requests for the cooldown, then lets a single
+// probe through: a probe that succeeds closes the breaker, one that fails opens
+// it again. A threshold of 0 disables the breaker.
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu       sync.Mutex
+	state    circuitState
+	failures int
+	openedAt time.Time
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+	}
+}
+
+// allow reports whether a request may be sent, returning an error wrapping
+// ErrCircuitOpen when it may not.
+func (b *circuitBreaker) allow() error {
+	if b.threshold == 0 {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case circuitOpen:
+		if remaining := b.cooldown - b.now().Sub(b.openedAt); remaining > 0 {
+			return fmt.Errorf("%w after %d consecutive transient failures; not contacting the Wormly API for another %v",
+				ErrCircuitOpen, b.failures, remaining.Round(time.Second))
+		}
+		// Cooldown is over: this request probes whether the API has recovered
+		b.state = circuitHalfOpen
+		return nil
+	case circuitHalfOpen:
+		return fmt.Errorf("%w after %d consecutive transient failures; waiting for a probe request to the Wormly API",
+			ErrCircuitOpen, b.failures)
+	default:
+		return nil
+	}
+}
+
+// recordSuccess closes the breaker after a request that was not a transient failure.
+func (b *circuitBreaker) recordSuccess() {
+	if b.threshold == 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.state = circuitClosed
+	b.failures = 0
+}
+
+// recordFailure counts a transient failure. When that leaves the breaker open
+// it returns an error wrapping both ErrCircuitOpen and err.
+func (b *circuitBreaker) recordFailure(err error) error {
+	if b.threshold == 0 {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	if b.state != circuitHalfOpen && b.failures < b.threshold {
+		return nil
+	}
+
+	b.state = circuitOpen
+	b.openedAt = b.now()
+	return fmt.Errorf("%w after %d consecutive transient failures, pausing requests to the Wormly API for %v: %w",
+		ErrCircuitOpen, b.failures, b.cooldown, err)
+}
+
+// recordInconclusive handles a request that failed without showing whether the
+// API is healthy. An interrupted probe hands the next request the chance to probe.
+func (b *circuitBreaker) recordInconclusive() {
+	if b.threshold == 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == circuitHalfOpen {
+		// openedAt is unchanged, so the cooldown is already over
+		b.state = circuitOpen
+	}
+}
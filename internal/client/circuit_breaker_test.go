@@ -0,0 +1,171 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newCircuitBreakerTestClient returns a client with the given breaker threshold
+// whose breaker reads the time from *now.
+func newCircuitBreakerTestClient(t *testing.T, serverURL string, threshold int, now *time.Time) *Client {
+	t.Helper()
+
+	client, err := New(Options{
+		HTTPClient:              &http.Client{},
+		APIKey:                  "test-api-key",
+		BaseURL:                 serverURL,
+		UserAgent:               "test-agent/1.0",
+		RequestsPerSecond:       1000.0,
+		MaxRetries:              5,
+		InitialBackoff:          time.Millisecond,
+		BackoffMultiplier:       2.0,
+		MaxBackoff:              10 * time.Millisecond,
+		CircuitBreakerThreshold: threshold,
+	})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+	client.breaker.now = func() time.Time { return *now }
+
+	return client
+}
+
+func doTestRequest(t *testing.T, client *Client, url string) error {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(t.Context(), "GET", url, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	resp, err := client.Do(t.Context(), req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func TestClient_CircuitBreaker(t *testing.T) {
+	var failing atomic.Bool
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if failing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	now := time.Now()
+	client := newCircuitBreakerTestClient(t, server.URL, 2, &now)
+	failing.Store(true)
+
+	// Closed -> open: the breaker trips before the retries are exhausted
+	err := doTestRequest(t, client, server.URL)
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Expected ErrCircuitOpen after consecutive failures, got: %v", err)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("Expected the breaker to trip after 2 requests, got %d", got)
+	}
+
+	// Open: requests fail without reaching the server
+	err = doTestRequest(t, client, server.URL)
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Expected ErrCircuitOpen while open, got: %v", err)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("Expected no requests while open, got %d in total", got)
+	}
+
+	// Half-open: after the cooldown a failed probe opens the breaker again
+	now = now.Add(DefaultCircuitBreakerCooldown)
+	err = doTestRequest(t, client, server.URL)
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Expected ErrCircuitOpen after a failed probe, got: %v", err)
+	}
+	if got := requests.Load(); got != 3 {
+		t.Errorf("Expected a single probe request, got %d in total", got)
+	}
+
+	// Half-open -> closed: a successful probe lets requests through again
+	now = now.Add(DefaultCircuitBreakerCooldown)
+	failing.Store(false)
+	if err := doTestRequest(t, client, server.URL); err != nil {
+		t.Fatalf("Expected the probe to succeed, got: %v", err)
+	}
+	if err := doTestRequest(t, client, server.URL); err != nil {
+		t.Fatalf("Expected requests to succeed once closed, got: %v", err)
+	}
+	if got := requests.Load(); got != 5 {
+		t.Errorf("Expected 5 requests in total, got %d", got)
+	}
+}
+
+func TestClient_CircuitBreaker_Disabled(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	now := time.Now()
+	client := newCircuitBreakerTestClient(t, server.URL, 0, &now)
+
+	err := doTestRequest(t, client, server.URL)
+	if err == nil || errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Expected a plain retry error with the breaker disabled, got: %v", err)
+	}
+	if got := requests.Load(); got != 6 {
+		t.Errorf("Expected all 6 attempts to reach the server, got %d", got)
+	}
+}
+
+func TestCircuitBreaker_InterruptedProbe(t *testing.T) {
+	now := time.Now()
+	breaker := newCircuitBreaker(1, time.Minute)
+	breaker.now = func() time.Time { return now }
+
+	if err := breaker.recordFailure(errors.New("HTTP 503")); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Expected the breaker to open, got: %v", err)
+	}
+
+	now = now.Add(time.Minute)
+	if err := breaker.allow(); err != nil {
+		t.Fatalf("Expected a probe to be allowed after the cooldown, got: %v", err)
+	}
+	if err := breaker.allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Expected concurrent requests to be rejected during the probe, got: %v", err)
+	}
+
+	// A cancelled probe says nothing about the API, so the next request probes instead
+	breaker.recordInconclusive()
+	if err := breaker.allow(); err != nil {
+		t.Fatalf("Expected another probe after an interrupted one, got: %v", err)
+	}
+}
+
+func TestNew_InvalidCircuitBreakerThreshold(t *testing.T) {
+	_, err := New(Options{
+		HTTPClient:              &http.Client{},
+		APIKey:                  "test-api-key",
+		BaseURL:                 "https://api.example.com",
+		UserAgent:               "test-agent/1.0",
+		RequestsPerSecond:       10.0,
+		MaxRetries:              3,
+		InitialBackoff:          time.Second,
+		BackoffMultiplier:       2.0,
+		MaxBackoff:              30 * time.Second,
+		CircuitBreakerThreshold: -1,
+	})
+	if err == nil {
+		t.Fatal("Expected error for a negative circuit breaker threshold, got none")
+	}
+}
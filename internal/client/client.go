@@ -5,18 +5,30 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
 	"log"
+	"math/rand/v2"
 	"net"
 	"net/http"
 	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"syscall"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"golang.org/x/time/rate"
 )
 
+// logSubsystem is the tflog subsystem that client operations log under. Entries
+// appear with the "provider.wormly" module in Terraform's debug output.
+const logSubsystem = "wormly"
+
 // Logger defines the interface for logging within the client.
 type Logger interface {
 	Printf(format string, v ...interface{})
@@ -43,7 +55,147 @@ func NewStdLogger(logger *log.Logger) *StdLogger {
 	return &StdLogger{logger: logger}
 }
 
+// RetryStrategy selects how the delay between retry attempts evolves.
+type RetryStrategy string
+
+const (
+	// RetryStrategyExponential multiplies the delay by the backoff multiplier after every attempt.
+	RetryStrategyExponential RetryStrategy = "exponential"
+	// RetryStrategyExponentialJitter behaves like RetryStrategyExponential but randomises
+	// the upper half of every delay to spread out retries from concurrent operations.
+	RetryStrategyExponentialJitter RetryStrategy = "exponential_jitter"
+	// RetryStrategyConstant waits the initial backoff between every attempt.
+	RetryStrategyConstant RetryStrategy = "constant"
+)
+
+// RetryStrategies lists the supported retry strategies.
+var RetryStrategies = []RetryStrategy{
+	RetryStrategyExponential,
+	RetryStrategyExponentialJitter,
+	RetryStrategyConstant,
+}
+
+// IsValid reports whether s is a supported retry strategy.
+func (s RetryStrategy) IsValid() bool {
+	for _, strategy := range RetryStrategies {
+		if s == strategy {
+			return true
+		}
+	}
+	return false
+}
+
+// ResponseFormat selects the format Wormly API responses are requested and decoded in.
+type ResponseFormat string
+
+const (
+	// ResponseFormatJSON requests and decodes JSON responses. It is the default.
+	ResponseFormatJSON ResponseFormat = "json"
+	// ResponseFormatXML requests and decodes XML responses, for legacy
+	// endpoints that do not serve JSON.
+	ResponseFormatXML ResponseFormat = "xml"
+)
+
+// ResponseFormats lists the supported response formats.
+var ResponseFormats = []ResponseFormat{
+	ResponseFormatJSON,
+	ResponseFormatXML,
+}
+
+// IsValid reports whether f is a supported response format.
+func (f ResponseFormat) IsValid() bool {
+	return slices.Contains(ResponseFormats, f)
+}
+
+// DefaultRetryOnStatus lists the HTTP status codes retried when no custom set is configured.
+var DefaultRetryOnStatus = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// DefaultMaxResponseBytes is the largest response body read when no custom limit is configured.
+const DefaultMaxResponseBytes int64 = 10 << 20
+
+// ErrNotFound is wrapped by errors for objects the API does not return, such as
+// a scheduled downtime period that was deleted outside Terraform.
+var ErrNotFound = errors.New("not found")
+
+// Errors wrapped by APIError for Wormly errorcode values callers can act on.
+var (
+	ErrInvalidHost    = errors.New("invalid host")
+	ErrAuthFailed     = errors.New("authentication failed")
+	ErrQuotaExceeded  = errors.New("quota exceeded")
+	ErrUnknownCommand = errors.New("unknown command")
+)
+
+// Wormly errorcode values with a dedicated error. Any other non-zero code is a
+// generic failure described only by the accompanying message.
+const (
+	ErrorCodeInvalidHost    = 2
+	ErrorCodeAuthFailed     = 3
+	ErrorCodeQuotaExceeded  = 4
+	ErrorCodeUnknownCommand = 5
+)
+
+// APIError is returned for a response with a non-zero errorcode. It unwraps to
+// ErrInvalidHost, ErrAuthFailed, ErrQuotaExceeded or ErrUnknownCommand for the
+// matching codes.
+type APIError struct {
+	Code    int
+	Message string
+	kind    error
+}
+
+// Error returns the errorcode and message reported by the API.
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("API returned error code %d", e.Code)
+	}
+	return fmt.Sprintf("API returned error code %d: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the named error for the errorcode, if any.
+func (e *APIError) Unwrap() error {
+	return e.kind
+}
+
+// mapErrorCode converts a non-zero Wormly errorcode and its message into an *APIError.
+func mapErrorCode(code int, msg string) error {
+	apiErr := &APIError{Code: code, Message: msg}
+	switch code {
+	case ErrorCodeInvalidHost:
+		apiErr.kind = ErrInvalidHost
+	case ErrorCodeAuthFailed:
+		apiErr.kind = ErrAuthFailed
+	case ErrorCodeQuotaExceeded:
+		apiErr.kind = ErrQuotaExceeded
+	case ErrorCodeUnknownCommand:
+		apiErr.kind = ErrUnknownCommand
+	}
+	return apiErr
+}
+
+// MetricsHook receives retry and rate limiting events so operators can see how
+// often requests are retried or throttled. Implementations must be safe for
+// concurrent use, since Terraform runs operations in parallel.
+type MetricsHook interface {
+	// OnRetry is called before a request is retried; attempt is the zero-based
+	// number of the attempt about to be made, so the first retry reports 1.
+	OnRetry(command string, attempt int)
+	// OnRateLimitWait is called with the time a request spent waiting for the
+	// rate limiter before it was sent.
+	OnRateLimitWait(d time.Duration)
+}
+
 // Client wraps an HTTP client with Wormly-specific functionality.
+//
+// State is scoped as follows: the rate limiter is shared by every request made
+// through the client, while retry backoff is per operation. Each call to Do or
+// makeFormRequest starts from initialBackoff regardless of how earlier calls
+// ended, so one slow command never delays the retries of the next one.
 type Client struct {
 	httpClient        *http.Client
 	apiKey            string
@@ -54,44 +206,194 @@ type Client struct {
 	initialBackoff    time.Duration
 	backoffMultiplier float64
 	maxBackoff        time.Duration
+	retryStrategy     RetryStrategy
+	retryOnStatus     []int
+	maxResponseBytes  int64
+	breaker           *circuitBreaker
+	metrics           MetricsHook
+	commandTimeout    time.Duration
+	responseFormat    ResponseFormat
 	logger            Logger
 	debugEnabled      bool
+
+	// Waits out the backoff between retries; replaced in tests to record the delays.
+	sleep func(ctx context.Context, d time.Duration) error
+
+	// Set once the API rejects getHostSensor, so later reads go straight to getHostSensors.
+	singleSensorFetchUnavailable atomic.Bool
+
+	// Caches getScheduledDowntimePeriods per host, so several period resources
+	// on the same host share one list fetch.
+	downtimePeriods hostCache[[]ScheduledDowntimePeriod]
+
+	// Caches getHostStatus per host for GetHostMonitoring, so checks of the
+	// same host share one request.
+	hostMonitoring hostCache[HostMonitoring]
+}
+
+// Options configures a Client. Fields left at their zero value select the
+// default described on each field.
+type Options struct {
+	// HTTPClient sends the requests.
+	HTTPClient *http.Client
+	APIKey     string
+	// BaseURL is the absolute URL of the API, such as https://api.wormly.com.
+	BaseURL   string
+	UserAgent string
+
+	// RequestsPerSecond is the sustained rate of requests.
+	RequestsPerSecond float64
+	// RequestsBurst is how many requests may be sent at once; 0 selects 1.
+	RequestsBurst int
+
+	// MaxRetries is how many times a transient failure is retried.
+	MaxRetries        int
+	InitialBackoff    time.Duration
+	BackoffMultiplier float64
+	MaxBackoff        time.Duration
+	// RetryStrategy is how backoff grows between retries; empty selects RetryStrategyExponential.
+	RetryStrategy RetryStrategy
+	// RetryOnStatus lists the HTTP status codes retried; nil selects DefaultRetryOnStatus.
+	RetryOnStatus []int
+
+	// MaxResponseBytes is the largest response body read; 0 selects DefaultMaxResponseBytes.
+	MaxResponseBytes int64
+	// CircuitBreakerThreshold is how many consecutive transient failures
+	// pause requests to the API; 0 disables the breaker.
+	CircuitBreakerThreshold int
+
+	// CommandTimeout, when positive, bounds each Wormly API command including
+	// its rate limiter wait, retries and backoff, even if the caller's context
+	// has no deadline. Individual HTTP requests are still bounded by the HTTP
+	// client's own timeout.
+	CommandTimeout time.Duration
+	// ResponseFormat is the format responses are requested and decoded in;
+	// empty selects ResponseFormatJSON.
+	ResponseFormat ResponseFormat
+	// Metrics, when set, is notified of retries and rate limiter waits.
+	Metrics MetricsHook
+
+	// Logger receives debug output; nil discards it.
+	Logger       Logger
+	DebugEnabled bool
 }
 
 // New creates a new Wormly API client.
-func New(httpClient *http.Client, apiKey, baseURL, userAgent string,
-	requestsPerSecond float64, maxRetries int, initialBackoff time.Duration,
-	backoffMultiplier float64, maxBackoff time.Duration, logger Logger, debugEnabled bool) (*Client, error) {
+func New(opts Options) (*Client, error) {
+	retryStrategy := opts.RetryStrategy
+	if retryStrategy == "" {
+		retryStrategy = RetryStrategyExponential
+	}
+	if !retryStrategy.IsValid() {
+		return nil, fmt.Errorf("unsupported retry strategy %q", retryStrategy)
+	}
+
+	retryOnStatus := opts.RetryOnStatus
+	if retryOnStatus == nil {
+		retryOnStatus = DefaultRetryOnStatus
+	}
+
+	if opts.CircuitBreakerThreshold < 0 {
+		return nil, fmt.Errorf("circuit breaker threshold must not be negative, got %d", opts.CircuitBreakerThreshold)
+	}
+
+	maxResponseBytes := opts.MaxResponseBytes
+	if maxResponseBytes <= 0 {
+		maxResponseBytes = DefaultMaxResponseBytes
+	}
+
+	requestsBurst := opts.RequestsBurst
+	if requestsBurst == 0 {
+		requestsBurst = 1
+	}
+	if requestsBurst < 1 {
+		return nil, fmt.Errorf("requests burst must be at least 1, got %d", requestsBurst)
+	}
+
+	responseFormat := opts.ResponseFormat
+	if responseFormat == "" {
+		responseFormat = ResponseFormatJSON
+	}
+	if !responseFormat.IsValid() {
+		return nil, fmt.Errorf("unsupported response format %q", responseFormat)
+	}
+
+	baseURL, err := normalizeBaseURL(opts.BaseURL)
+	if err != nil {
+		return nil, err
+	}
 
 	// Create rate limiter
-	limiter := rate.NewLimiter(rate.Limit(requestsPerSecond), 1)
+	limiter := rate.NewLimiter(rate.Limit(opts.RequestsPerSecond), requestsBurst)
 
+	logger := opts.Logger
 	if logger == nil {
 		logger = NoOpLogger{}
 	}
 
 	return &Client{
-		httpClient:        httpClient,
-		apiKey:            apiKey,
+		httpClient:        opts.HTTPClient,
+		apiKey:            opts.APIKey,
 		baseURL:           baseURL,
-		userAgent:         userAgent,
+		userAgent:         opts.UserAgent,
 		limiter:           limiter,
-		maxRetries:        maxRetries,
-		initialBackoff:    initialBackoff,
-		backoffMultiplier: backoffMultiplier,
-		maxBackoff:        maxBackoff,
+		maxRetries:        opts.MaxRetries,
+		initialBackoff:    opts.InitialBackoff,
+		backoffMultiplier: opts.BackoffMultiplier,
+		maxBackoff:        opts.MaxBackoff,
+		retryStrategy:     retryStrategy,
+		retryOnStatus:     slices.Clone(retryOnStatus),
+		maxResponseBytes:  maxResponseBytes,
+		breaker:           newCircuitBreaker(opts.CircuitBreakerThreshold, DefaultCircuitBreakerCooldown),
+		metrics:           opts.Metrics,
+		commandTimeout:    opts.CommandTimeout,
+		responseFormat:    responseFormat,
 		logger:            logger,
-		debugEnabled:      debugEnabled,
+		debugEnabled:      opts.DebugEnabled,
+		sleep:             sleepContext,
 	}, nil
 }
 
-// Do executes an HTTP request with rate limiting and retry logic.
-func (c *Client) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
-	// Apply rate limiting
-	if err := c.limiter.Wait(ctx); err != nil {
-		return nil, fmt.Errorf("rate limiter wait failed: %w", err)
+// CommandTimeout returns the configured bound on each API command, or 0 when
+// commands are only bounded by the caller's context.
+func (c *Client) CommandTimeout() time.Duration {
+	return c.commandTimeout
+}
+
+// ResponseFormat returns the format responses are requested and decoded in.
+func (c *Client) ResponseFormat() ResponseFormat {
+	return c.responseFormat
+}
+
+// normalizeBaseURL checks that baseURL is an absolute URL and trims trailing
+// slashes from its path, so a routing prefix such as "https://gw.corp/wormly/api/"
+// is posted to as "https://gw.corp/wormly/api".
+func normalizeBaseURL(baseURL string) (string, error) {
+	parsed, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid base URL %q: %w", baseURL, err)
+	}
+	if !parsed.IsAbs() || parsed.Host == "" {
+		return "", fmt.Errorf("base URL must be an absolute URL such as https://api.wormly.com, got %q", baseURL)
+	}
+
+	parsed.Path = strings.TrimRight(parsed.Path, "/")
+	parsed.RawPath = strings.TrimRight(parsed.RawPath, "/")
+
+	return parsed.String(), nil
+}
+
+// Close closes the idle keep-alive connections of the underlying transport.
+// Requests in flight are not interrupted and the client stays usable; it is a
+// no-op when the transport does not pool connections.
+func (c *Client) Close() {
+	if c.httpClient != nil {
+		c.httpClient.CloseIdleConnections()
 	}
+}
 
+// Do executes an HTTP request with rate limiting and retry logic.
+func (c *Client) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
 	// Inject headers if not already set
 	if req.Header.Get("Authorization") == "" {
 		req.Header.Set("Authorization", "Bearer "+c.apiKey)
@@ -103,60 +405,209 @@ func (c *Client) Do(ctx context.Context, req *http.Request) (*http.Response, err
 		req.Header.Set("Content-Type", "application/json")
 	}
 
+	ctx = c.newLogContext(ctx, map[string]interface{}{
+		"method": req.Method,
+		"path":   req.URL.Path,
+	})
+
+	idempotent := req.Method != http.MethodPost && req.Method != http.MethodPatch
+	return c.doWithRetry(ctx, req.Method+" "+req.URL.Path, idempotent, func(attempt int) (*http.Response, error) {
+		c.debugf(ctx, map[string]interface{}{"attempt": attempt},
+			"Attempt %d: Making request to %s", attempt, req.URL)
+
+		return c.httpClient.Do(req.WithContext(contextWithAttempt(req.Context(), attempt)))
+	})
+}
+
+// idempotentCommands lists the POST commands that leave the account in the same
+// state however often they are repeated, so they are safe to retry after a
+// transient HTTP status even though the server may already have applied them.
+// Commands that create objects, such as createHost, are left out: repeating one
+// whose response was lost would create a duplicate.
+var idempotentCommands = map[string]bool{
+	"deleteContact":                 true,
+	"deleteHost":                    true,
+	"deleteHostGroup":               true,
+	"deleteScheduledDowntimePeriod": true,
+	"deleteSensor":                  true,
+	"deleteStatusPage":              true,
+	"enableSensor":                  true,
+	"disableSensor":                 true,
+	"acknowledgeSensorError":        true,
+	"enableHostHealthMonitoring":    true,
+	"disableHostHealthMonitoring":   true,
+	"enableHostUptimeMonitoring":    true,
+	"disableHostUptimeMonitoring":   true,
+	"publishStatusPage":             true,
+	"unpublishStatusPage":           true,
+	"setGlobalAlertMute":            true,
+	"setHostAlertRecipients":        true,
+	"setSensorParams":               true,
+}
+
+// isIdempotentRequest reports whether a Wormly API command sent with method may
+// be retried after a transient HTTP status. Read commands are named get* and
+// usually sent with GET.
+func isIdempotentRequest(method, command string) bool {
+	return method == http.MethodGet || strings.HasPrefix(command, "get") || idempotentCommands[command]
+}
+
+// rateLimitWaitLogThreshold is the shortest rate limiter wait that is logged;
+// shorter waits are routine.
+const rateLimitWaitLogThreshold = 250 * time.Millisecond
+
+// doWithRetry applies rate limiting and calls send with the zero-based attempt
+// number until it returns a response that is not a transient failure or the
+// retries are exhausted. Transient HTTP responses are closed before retrying;
+// any other response is returned as is. command identifies the operation to
+// the metrics hook.
+//
+// A transient HTTP status other than 429 is only retried when idempotent is
+// true: the server may have applied the request before failing, so repeating a
+// mutation could apply it twice. Transient network errors are retried either way.
+func (c *Client) doWithRetry(ctx context.Context, command string, idempotent bool, send func(attempt int) (*http.Response, error)) (*http.Response, error) {
+	// Apply rate limiting
+	waitStart := time.Now()
+	if err := c.limiter.Wait(ctx); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			// Cancelled or timed out while waiting: terminal
+			return nil, fmt.Errorf("rate limiter wait failed: %w", ctxErr)
+		}
+		// The limiter refuses up front, without waiting, when the next free
+		// slot lies beyond the context deadline. Retrying cannot help: the
+		// deadline approaches exactly as fast as the wait shrinks. Report it
+		// as the deadline it is so callers handle it like any other timeout.
+		return nil, fmt.Errorf("rate limiter wait failed: %w: %w", err, context.DeadlineExceeded)
+	}
+	waited := time.Since(waitStart)
+	if waited >= rateLimitWaitLogThreshold {
+		// Long waits would otherwise make a slow apply look like a hang
+		c.debugf(ctx, map[string]interface{}{"rate_limit_wait": waited.String()},
+			"Command %s waited %s for the rate limiter (%g requests per second, burst %d)",
+			command, waited.Round(time.Millisecond), float64(c.limiter.Limit()), c.limiter.Burst())
+	}
+	if c.metrics != nil {
+		c.metrics.OnRateLimitWait(waited)
+	}
+
 	var lastErr error
+	// The outcome of each failed attempt, summarized in the error once retries run out
+	var attemptStatuses []string
+	// Backoff is per operation; it is intentionally not carried over between commands.
 	backoff := c.initialBackoff
 
 	for attempt := 0; attempt <= c.maxRetries; attempt++ {
-		if c.debugEnabled {
-			c.logger.Printf("Attempt %d: Making request to %s", attempt, req.URL)
+		if err := c.breaker.allow(); err != nil {
+			if lastErr != nil {
+				return nil, fmt.Errorf("%w (last error: %w)", err, c.redactError(lastErr))
+			}
+			return nil, err
 		}
 
-		// Make the request
-		resp, err := c.httpClient.Do(req)
+		resp, err := send(attempt)
 		if err != nil {
 			// Check if it's a transient network error
 			if isTransientNetworkError(err) {
 				lastErr = err
+				attemptStatuses = append(attemptStatuses, "network error")
+				if openErr := c.breaker.recordFailure(c.redactError(err)); openErr != nil {
+					return nil, openErr
+				}
 				if attempt < c.maxRetries {
-					if c.debugEnabled {
-						c.logger.Printf("Transient network error: %v. Retrying in %v", err, backoff)
+					c.debugf(ctx, map[string]interface{}{"attempt": attempt, "error": err.Error()},
+						"Transient network error: %v. Retrying in %v", err, backoff)
+					if sleepErr := c.sleep(ctx, backoff); sleepErr != nil {
+						return nil, fmt.Errorf("%w (last error: %w)", sleepErr, c.redactError(err))
 					}
-					time.Sleep(backoff)
 					backoff = c.calculateNextBackoff(backoff)
+					c.reportRetry(command, attempt+1)
 					continue
 				}
+				break
+			} else {
+				// Say nothing about the API's health, such as a cancelled context
+				c.breaker.recordInconclusive()
 			}
-			return nil, err
+			return nil, c.redactError(err)
 		}
 
 		// Check for transient HTTP errors
-		if isTransientHTTPError(resp.StatusCode) {
+		if isTransientHTTPError(resp.StatusCode, c.retryOnStatus) {
 			resp.Body.Close()
 			lastErr = fmt.Errorf("HTTP %d: %s", resp.StatusCode, resp.Status)
+			attemptStatuses = append(attemptStatuses, strconv.Itoa(resp.StatusCode))
+			if openErr := c.breaker.recordFailure(lastErr); openErr != nil {
+				return nil, openErr
+			}
+			// A rate limited request was rejected before it was processed
+			if !idempotent && resp.StatusCode != http.StatusTooManyRequests {
+				c.debugf(ctx, map[string]interface{}{"attempt": attempt, "status_code": resp.StatusCode},
+					"Transient HTTP error: %v. Not retrying %s, as repeating it could apply it twice", lastErr, command)
+				return nil, fmt.Errorf("%w; %s was not retried, as repeating it could apply it twice", lastErr, command)
+			}
 			if attempt < c.maxRetries {
-				if c.debugEnabled {
-					c.logger.Printf("Transient HTTP error: %v. Retrying in %v", lastErr, backoff)
+				c.debugf(ctx, map[string]interface{}{"attempt": attempt, "status_code": resp.StatusCode},
+					"Transient HTTP error: %v. Retrying in %v", lastErr, backoff)
+				if sleepErr := c.sleep(ctx, backoff); sleepErr != nil {
+					return nil, fmt.Errorf("%w (last error: %w)", sleepErr, lastErr)
 				}
-				time.Sleep(backoff)
 				backoff = c.calculateNextBackoff(backoff)
+				c.reportRetry(command, attempt+1)
 				continue
 			}
-			return nil, lastErr
+			break
 		}
 
 		// Success or non-retryable error
+		c.breaker.recordSuccess()
 		return resp, nil
 	}
 
-	return nil, fmt.Errorf("request failed after %d retries: %w", c.maxRetries, lastErr)
+	// Without retries there is no sequence of failures to summarize
+	if c.maxRetries == 0 {
+		return nil, c.redactError(lastErr)
+	}
+	return nil, fmt.Errorf("request failed after %d retries, attempt statuses [%s]: %w",
+		c.maxRetries, strings.Join(attemptStatuses, ", "), c.redactError(lastErr))
+}
+
+// sleepContext waits for d, returning early with the context's error when ctx is done first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// reportRetry notifies the metrics hook, if any, that attempt of command is about to be made.
+func (c *Client) reportRetry(command string, attempt int) {
+	if c.metrics != nil {
+		c.metrics.OnRetry(command, attempt)
+	}
 }
 
-// calculateNextBackoff calculates the next backoff duration with exponential backoff.
+// calculateNextBackoff calculates the next backoff duration according to the retry strategy.
 func (c *Client) calculateNextBackoff(current time.Duration) time.Duration {
+	if c.retryStrategy == RetryStrategyConstant {
+		return c.initialBackoff
+	}
+
 	next := time.Duration(float64(current) * c.backoffMultiplier)
 	if next > c.maxBackoff {
-		return c.maxBackoff
+		next = c.maxBackoff
+	}
+
+	if c.retryStrategy == RetryStrategyExponentialJitter && next > 0 {
+		// Equal jitter: keep half of the delay and randomise the other half
+		half := next / 2
+		next = half + rand.N(next-half+1)
 	}
+
 	return next
 }
 
@@ -166,6 +617,13 @@ func isTransientNetworkError(err error) bool {
 		return false
 	}
 
+	// DNS lookups that may succeed on retry, such as a SERVFAIL or an unresponsive
+	// resolver; a permanent answer like NXDOMAIN is not retried
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTemporary || dnsErr.IsTimeout
+	}
+
 	// Check for network errors
 	if netErr, ok := err.(net.Error); ok {
 		return netErr.Timeout()
@@ -189,131 +647,329 @@ func isTransientNetworkError(err error) bool {
 	return false
 }
 
-// isTransientHTTPError checks if an HTTP status code indicates a transient error.
-func isTransientHTTPError(statusCode int) bool {
-	switch statusCode {
-	case http.StatusTooManyRequests,
-		http.StatusInternalServerError,
-		http.StatusBadGateway,
-		http.StatusServiceUnavailable,
-		http.StatusGatewayTimeout:
-		return true
-	}
-	return false
+// isTransientHTTPError checks if an HTTP status code is one of the codes configured as transient.
+func isTransientHTTPError(statusCode int, retryOnStatus []int) bool {
+	return slices.Contains(retryOnStatus, statusCode)
 }
 
-// makeFormRequest is a helper method for making form-encoded API requests (Wormly API style).
+// makeFormRequest makes a form-encoded POST request to the Wormly API.
 func (c *Client) makeFormRequest(ctx context.Context, command string, params map[string]string, result interface{}) error {
-	// Apply rate limiting
-	if err := c.limiter.Wait(ctx); err != nil {
-		return fmt.Errorf("rate limiter wait failed: %w", err)
+	ctx = c.newCommandLogContext(ctx, command, params)
+	data := c.formValues(ctx, command, params)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL, bytes.NewBufferString(data.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
 	}
-	// Build form data
+
+	// Set headers for form data (don't use the generic headers from Do method)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("User-Agent", c.userAgent)
+
+	return c.sendFormRequest(ctx, req, command, result)
+}
+
+// makeFormRequestGET makes a GET request to the Wormly API with the parameters
+// encoded in the query string. Use it for idempotent read commands only.
+func (c *Client) makeFormRequestGET(ctx context.Context, command string, params map[string]string, result interface{}) error {
+	ctx = c.newCommandLogContext(ctx, command, params)
+
+	requestURL, err := c.commandURL(c.formValues(ctx, command, params))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("User-Agent", c.userAgent)
+
+	return c.sendFormRequest(ctx, req, command, result)
+}
+
+// makeJSONRequest makes a POST request to the Wormly API with payload encoded
+// as a JSON body. The command and authentication key are sent in the query
+// string, as JSON endpoints do not read them from the body.
+func (c *Client) makeJSONRequest(ctx context.Context, command string, payload interface{}, result interface{}) error {
+	ctx = c.newCommandLogContext(ctx, command, nil)
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	requestURL, err := c.commandURL(c.formValues(ctx, command, nil))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", requestURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+
+	return c.sendFormRequest(ctx, req, command, result)
+}
+
+// commandURL returns the base URL with data merged into its query string.
+func (c *Client) commandURL(data url.Values) (string, error) {
+	requestURL, err := url.Parse(c.baseURL)
+	if err != nil {
+		return "", err
+	}
+	query := requestURL.Query()
+	for key, values := range data {
+		query[key] = values
+	}
+	requestURL.RawQuery = query.Encode()
+
+	return requestURL.String(), nil
+}
+
+// formValues builds the parameters of a Wormly API command, including the authentication key.
+func (c *Client) formValues(ctx context.Context, command string, params map[string]string) url.Values {
 	data := url.Values{}
 	data.Set("cmd", command)
 	data.Set("key", c.apiKey)
-	data.Set("response", "json")
+	data.Set("response", string(c.responseFormat))
 
 	for key, value := range params {
 		data.Set(key, value)
 	}
 
-	if c.debugEnabled {
-		// Create a safe copy of params for logging (without API key)
-		safeParams := make(map[string]string)
-		for k, v := range params {
-			safeParams[k] = v
-		}
-		c.logger.Printf("Wormly API request - command: %s, params: %+v", command, safeParams)
+	// Create a safe copy of params for logging (without API key)
+	safeParams := make(map[string]string)
+	for k, v := range params {
+		safeParams[k] = v
 	}
+	c.debugf(ctx, nil, "Wormly API request - command: %s, params: %+v", command, safeParams)
 
-	req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL, bytes.NewBufferString(data.Encode()))
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
-	}
+	return data
+}
 
-	// Set headers for form data (don't use the generic headers from Do method)
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	req.Header.Set("User-Agent", c.userAgent)
+// sendFormRequest sends a prepared Wormly API request with rate limiting and
+// retries, and decodes the JSON response into result. The whole exchange,
+// including reading the response, is bounded by the command timeout.
+func (c *Client) sendFormRequest(ctx context.Context, req *http.Request, command string, result interface{}) error {
+	parent := ctx
+	if c.commandTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.commandTimeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
 
-	var lastErr error
-	backoff := c.initialBackoff
+	resp, err := c.doWithRetry(ctx, command, isIdempotentRequest(req.Method, command), func(attempt int) (*http.Response, error) {
+		c.debugf(ctx, map[string]interface{}{"attempt": attempt},
+			"Attempt %d: Making form request to %s with command %s", attempt, c.baseURL, command)
 
-	for attempt := 0; attempt <= c.maxRetries; attempt++ {
-		if c.debugEnabled {
-			c.logger.Printf("Attempt %d: Making form request to %s with command %s", attempt, c.baseURL, command)
+		// Make the request directly without using Do to avoid header conflicts
+		start := time.Now()
+		resp, err := c.httpClient.Do(req.WithContext(contextWithAttempt(req.Context(), attempt)))
+		elapsed := time.Since(start)
+		if err != nil {
+			c.debugf(ctx, map[string]interface{}{"attempt": attempt, "elapsed": elapsed.String()},
+				"Attempt %d: command %s failed after %s: %v", attempt, command, elapsed, err)
+			return nil, err
 		}
+		c.debugf(ctx, map[string]interface{}{"attempt": attempt, "status_code": resp.StatusCode, "elapsed": elapsed.String()},
+			"Attempt %d: command %s returned status %d in %s", attempt, command, resp.StatusCode, elapsed)
+		return resp, nil
+	})
+	if err != nil {
+		// Only blame the command timeout when the caller's own context is still live
+		if parent.Err() == nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("command %s did not complete within the command timeout of %s: %w", command, c.commandTimeout, err)
+		}
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		// The body only feeds the error message, so a truncated one is good enough
+		bodyBytes, _ := io.ReadAll(io.LimitReader(resp.Body, c.maxResponseBytes))
+		c.debugf(ctx, map[string]interface{}{"status_code": resp.StatusCode},
+			"API request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+		if looksLikeHTML(resp.Header.Get("Content-Type"), bodyBytes) {
+			return fmt.Errorf("API request failed with status %d: %w", resp.StatusCode, c.htmlResponseError())
+		}
+		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, c.redact(string(bodyBytes)))
+	}
 
-		// Make the request directly without using Do to avoid header conflicts
-		resp, err := c.httpClient.Do(req)
+	if result != nil {
+		// Read response body for potential debugging
+		responseBytes, err := c.readResponseBody(resp.Body)
 		if err != nil {
-			// Check if it's a transient network error
-			if isTransientNetworkError(err) {
-				lastErr = err
-				if attempt < c.maxRetries {
-					if c.debugEnabled {
-						c.logger.Printf("Transient network error: %v. Retrying in %v", err, backoff)
-					}
-					time.Sleep(backoff)
-					backoff = c.calculateNextBackoff(backoff)
-					continue
-				}
-			}
 			return err
 		}
 
-		// Check for transient HTTP errors
-		if isTransientHTTPError(resp.StatusCode) {
-			resp.Body.Close()
-			lastErr = fmt.Errorf("HTTP %d: %s", resp.StatusCode, resp.Status)
-			if attempt < c.maxRetries {
-				if c.debugEnabled {
-					c.logger.Printf("Transient HTTP error: %v. Retrying in %v", lastErr, backoff)
+		c.debugf(ctx, map[string]interface{}{"status_code": resp.StatusCode},
+			"Wormly API response: %s", string(responseBytes))
+
+		// Decode the response
+		if c.responseFormat == ResponseFormatXML {
+			if err := xml.Unmarshal(responseBytes, result); err != nil {
+				contentType := resp.Header.Get("Content-Type")
+				if looksLikeHTML(contentType, responseBytes) {
+					return fmt.Errorf("failed to decode response (%s): %w", c.responseExcerpt(contentType, responseBytes), c.htmlResponseError())
 				}
-				time.Sleep(backoff)
-				backoff = c.calculateNextBackoff(backoff)
-				continue
+				return fmt.Errorf("failed to decode XML response (%s): %w", c.responseExcerpt(contentType, responseBytes), err)
 			}
-			return lastErr
+			return nil
 		}
-
-		// Success or non-retryable error
-		defer resp.Body.Close()
-
-		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-			bodyBytes, _ := io.ReadAll(resp.Body)
-			if c.debugEnabled {
-				c.logger.Printf("API request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+		if err := json.Unmarshal(responseBytes, result); err != nil {
+			contentType := resp.Header.Get("Content-Type")
+			if looksLikeHTML(contentType, responseBytes) {
+				return fmt.Errorf("failed to decode response (%s): %w", c.responseExcerpt(contentType, responseBytes), c.htmlResponseError())
+			}
+			if !json.Valid(responseBytes) {
+				return fmt.Errorf("failed to decode response: received a non-JSON response (%s): %w; "+
+					"check that base_url points at the Wormly API (%s)", c.responseExcerpt(contentType, responseBytes), err, c.baseURL)
 			}
-			return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+			return fmt.Errorf("failed to decode response: %w", err)
 		}
+	}
 
-		if result != nil {
-			// Read response body for potential debugging
-			responseBytes, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return fmt.Errorf("failed to read response body: %w", err)
-			}
+	return nil
+}
 
-			if c.debugEnabled {
-				c.logger.Printf("Wormly API response: %s", string(responseBytes))
-			}
+// readResponseBody reads body, failing once it exceeds the client's maximum response size.
+func (c *Client) readResponseBody(body io.Reader) ([]byte, error) {
+	// Read one byte past the limit to tell a body of exactly the limit from a larger one
+	data, err := io.ReadAll(io.LimitReader(body, c.maxResponseBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	if int64(len(data)) > c.maxResponseBytes {
+		return nil, fmt.Errorf("response body exceeds the maximum of %d bytes; raise max_response_bytes if the account legitimately returns larger responses", c.maxResponseBytes)
+	}
+	return data, nil
+}
 
-			// Decode the response
-			if err := json.Unmarshal(responseBytes, result); err != nil {
-				return fmt.Errorf("failed to decode response: %w", err)
-			}
-		}
+// htmlResponseError describes an HTML page returned where an API response was expected.
+func (c *Client) htmlResponseError() error {
+	return fmt.Errorf("received an HTML page from %s, which doesn't look like the Wormly API; "+
+		"check that base_url points at the API (https://api.wormly.com) rather than the web UI", c.baseURL)
+}
 
-		return nil
+// responseExcerptBytes bounds how much of an unexpected response body is quoted in errors.
+const responseExcerptBytes = 200
+
+// responseExcerpt describes an unexpected response by its content type and the
+// start of its body, so that gateway and proxy error pages can be recognised.
+func (c *Client) responseExcerpt(contentType string, body []byte) string {
+	excerpt := bytes.TrimSpace(body)
+	truncated := len(excerpt) > responseExcerptBytes
+	if truncated {
+		excerpt = excerpt[:responseExcerptBytes]
+	}
+
+	description := fmt.Sprintf("Content-Type %q, body %q", contentType, c.redact(string(excerpt)))
+	if truncated {
+		description += " (truncated)"
+	}
+	return description
+}
+
+// looksLikeHTML reports whether a response body is an HTML page rather than an API response.
+func looksLikeHTML(contentType string, body []byte) bool {
+	if strings.HasPrefix(strings.ToLower(contentType), "text/html") {
+		return true
 	}
 
-	return fmt.Errorf("request failed after %d retries: %w", c.maxRetries, lastErr)
+	start := bytes.ToLower(bytes.TrimSpace(body))
+	return bytes.HasPrefix(start, []byte("<!doctype html")) || bytes.HasPrefix(start, []byte("<html"))
+}
+
+// DebugLog logs a debug message under the provider's tflog subsystem and,
+// if debug logging is enabled, through the client's Logger. Credentials are
+// redacted from the message.
+func (c *Client) DebugLog(ctx context.Context, format string, v ...interface{}) {
+	msg := c.redact(fmt.Sprintf(format, v...))
+	tflog.SubsystemDebug(c.newLogContext(ctx, nil), logSubsystem, msg)
+	if c.debugEnabled {
+		c.logger.Printf("[DEBUG] %s", msg)
+	}
 }
 
-// DebugLog logs a debug message if debug logging is enabled.
-func (c *Client) DebugLog(format string, v ...interface{}) {
+// debugf emits a structured debug entry with fields under the provider's tflog
+// subsystem and mirrors the message to the client's Logger when debug logging
+// is enabled. Credentials are redacted from the message and string fields.
+func (c *Client) debugf(ctx context.Context, fields map[string]interface{}, format string, v ...interface{}) {
+	msg := c.redact(fmt.Sprintf(format, v...))
+	if fields != nil {
+		for key, value := range fields {
+			if s, ok := value.(string); ok {
+				fields[key] = c.redact(s)
+			}
+		}
+		tflog.SubsystemDebug(ctx, logSubsystem, msg, fields)
+	} else {
+		tflog.SubsystemDebug(ctx, logSubsystem, msg)
+	}
 	if c.debugEnabled {
-		c.logger.Printf("[DEBUG] "+format, v...)
+		c.logger.Printf("%s", msg)
 	}
 }
+
+// newLogContext returns ctx with the client's tflog subsystem initialised and
+// fields attached to every entry logged through it. Contexts that already carry
+// the subsystem keep their existing fields, including the request ID.
+func (c *Client) newLogContext(ctx context.Context, fields map[string]interface{}) context.Context {
+	if requestIDFromContext(ctx) == "" {
+		ctx = tflog.NewSubsystem(ctx, logSubsystem)
+		ctx = context.WithValue(ctx, requestIDKey{}, newRequestID())
+		ctx = tflog.SubsystemSetField(ctx, logSubsystem, "request_id", requestIDFromContext(ctx))
+	}
+	for key, value := range fields {
+		ctx = tflog.SubsystemSetField(ctx, logSubsystem, key, value)
+	}
+	return ctx
+}
+
+// newCommandLogContext returns a log context for a Wormly API command, tagged
+// with the command name and, when the command targets a host, its ID.
+func (c *Client) newCommandLogContext(ctx context.Context, command string, params map[string]string) context.Context {
+	fields := map[string]interface{}{"command": command}
+	if hostID, ok := params["hostid"]; ok {
+		fields["host_id"] = hostID
+	}
+	return c.newLogContext(ctx, fields)
+}
+
+// attemptKey is the context key under which the attempt number of a request is stored.
+type attemptKey struct{}
+
+// contextWithAttempt returns ctx annotated with the zero-based attempt number of a request.
+func contextWithAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, attemptKey{}, attempt)
+}
+
+// AttemptFromContext returns the attempt number of the request carrying ctx: 0
+// for the first try and n for the nth retry. The client annotates the context of
+// every outgoing request, so embedders can read it from an http.RoundTripper
+// installed on the HTTP client passed to New, for example to tag trace spans.
+// ok is false when ctx does not belong to a request sent by the client.
+func AttemptFromContext(ctx context.Context) (attempt int, ok bool) {
+	attempt, ok = ctx.Value(attemptKey{}).(int)
+	return attempt, ok
+}
+
+// requestIDKey is the context key under which the current request ID is stored.
+type requestIDKey struct{}
+
+// requestIDFromContext returns the request ID stored in ctx, or an empty string.
+func requestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// newRequestID returns a random identifier used to correlate the log entries of one request.
+func newRequestID() string {
+	return fmt.Sprintf("%016x", rand.Uint64())
+}
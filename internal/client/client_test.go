@@ -1,29 +1,38 @@
 package client
 
 import (
+	"bytes"
+	"cmp"
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
 )
 
 func TestNew(t *testing.T) {
 	httpClient := &http.Client{Timeout: 30 * time.Second}
-	client, err := New(
-		httpClient,
-		"test-api-key",
-		"https://api.example.com",
-		"test-agent/1.0",
-		10.0,
-		3,
-		time.Second,
-		2.0,
-		30*time.Second,
-		NoOpLogger{}, // logger
-		false,        // debug
-	)
+	client, err := New(Options{
+		HTTPClient:        httpClient,
+		APIKey:            "test-api-key",
+		BaseURL:           "https://api.example.com",
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 10.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+	})
 
 	if err != nil {
 		t.Fatalf("New() returned error: %v", err)
@@ -45,6 +54,10 @@ func TestNew(t *testing.T) {
 		t.Errorf("Expected userAgent to be 'test-agent/1.0', got %q", client.userAgent)
 	}
 
+	if client.maxResponseBytes != DefaultMaxResponseBytes {
+		t.Errorf("Expected maxResponseBytes to default to %d, got %d", DefaultMaxResponseBytes, client.maxResponseBytes)
+	}
+
 	if client.maxRetries != 3 {
 		t.Errorf("Expected maxRetries to be 3, got %d", client.maxRetries)
 	}
@@ -84,19 +97,17 @@ func TestClient_Do_HeaderInjection(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client, err := New(
-		&http.Client{},
-		"test-api-key",
-		server.URL,
-		"test-agent/1.0",
-		10.0,
-		3,
-		time.Millisecond,
-		2.0,
-		time.Second,
-		NoOpLogger{}, // logger
-		false,        // debug
-	)
+	client, err := New(Options{
+		HTTPClient:        &http.Client{},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 10.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Millisecond,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        time.Second,
+	})
 	if err != nil {
 		t.Fatalf("New() returned error: %v", err)
 	}
@@ -127,19 +138,17 @@ func TestClient_Do_RateLimiting(t *testing.T) {
 	defer server.Close()
 
 	// Set a very low rate limit (1 request per 100ms)
-	client, err := New(
-		&http.Client{},
-		"test-api-key",
-		server.URL,
-		"test-agent/1.0",
-		10.0, // 10 requests per second = 1 request per 100ms
-		0,    // No retries for this test
-		time.Millisecond,
-		2.0,
-		time.Second,
-		NoOpLogger{}, // logger
-		false,        // debug
-	)
+	client, err := New(Options{
+		HTTPClient:        &http.Client{},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 10.0,
+		MaxRetries:        0,
+		InitialBackoff:    time.Millisecond,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        time.Second,
+	})
 	if err != nil {
 		t.Fatalf("New() returned error: %v", err)
 	}
@@ -173,6 +182,284 @@ func TestClient_Do_RateLimiting(t *testing.T) {
 	}
 }
 
+func TestClient_Do_RateLimitBurst(t *testing.T) {
+	tests := []struct {
+		name        string
+		burst       int
+		minDuration time.Duration
+		maxDuration time.Duration
+	}{
+		{
+			// 20 RPS = 1 request per 50ms; requests 2-5 each wait for a token
+			name:        "burst 1 serializes requests",
+			burst:       1,
+			minDuration: 190 * time.Millisecond,
+		},
+		{
+			// All five requests fit in the initial bucket
+			name:        "burst 5 allows rapid requests",
+			burst:       5,
+			maxDuration: 40 * time.Millisecond,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			client, err := New(Options{
+				HTTPClient:        &http.Client{},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 20.0,
+				RequestsBurst:     tt.burst,
+				MaxRetries:        0,
+				InitialBackoff:    time.Millisecond,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        time.Second,
+			})
+			if err != nil {
+				t.Fatalf("New() returned error: %v", err)
+			}
+
+			start := time.Now()
+			for i := 0; i < 5; i++ {
+				req, err := http.NewRequest("GET", server.URL+"/test", nil)
+				if err != nil {
+					t.Fatalf("Failed to create request: %v", err)
+				}
+
+				resp, err := client.Do(t.Context(), req)
+				if err != nil {
+					t.Fatalf("Do() returned error: %v", err)
+				}
+				resp.Body.Close()
+			}
+			elapsed := time.Since(start)
+
+			if tt.minDuration > 0 && elapsed < tt.minDuration {
+				t.Errorf("5 requests completed in %v, expected at least %v", elapsed, tt.minDuration)
+			}
+			if tt.maxDuration > 0 && elapsed > tt.maxDuration {
+				t.Errorf("5 requests took %v, expected at most %v", elapsed, tt.maxDuration)
+			}
+		})
+	}
+}
+
+func TestClient_Do_RateLimitWaitErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         func(t *testing.T) context.Context
+		expectError error
+	}{
+		{
+			// The burst is used up, so the request waits ~500ms for a token
+			name: "exhausted burst waits under a generous deadline",
+			ctx: func(t *testing.T) context.Context {
+				ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+				t.Cleanup(cancel)
+				return ctx
+			},
+		},
+		{
+			// The next token is ~500ms away; the limiter refuses without waiting
+			name: "deadline before the next token",
+			ctx: func(t *testing.T) context.Context {
+				ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+				t.Cleanup(cancel)
+				return ctx
+			},
+			expectError: context.DeadlineExceeded,
+		},
+		{
+			name: "cancelled context",
+			ctx: func(t *testing.T) context.Context {
+				ctx, cancel := context.WithCancel(t.Context())
+				cancel()
+				return ctx
+			},
+			expectError: context.Canceled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestCount := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestCount++
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			client, err := New(Options{
+				HTTPClient:        &http.Client{},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 2.0,
+				MaxRetries:        0,
+				InitialBackoff:    time.Millisecond,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        time.Second,
+			})
+			if err != nil {
+				t.Fatalf("New() returned error: %v", err)
+			}
+
+			// Use up the burst
+			req, err := http.NewRequest("GET", server.URL+"/test", nil)
+			if err != nil {
+				t.Fatalf("Failed to create request: %v", err)
+			}
+			resp, err := client.Do(t.Context(), req)
+			if err != nil {
+				t.Fatalf("Do() returned error: %v", err)
+			}
+			resp.Body.Close()
+
+			req, err = http.NewRequest("GET", server.URL+"/test", nil)
+			if err != nil {
+				t.Fatalf("Failed to create request: %v", err)
+			}
+			start := time.Now()
+			resp, err = client.Do(tt.ctx(t), req)
+			elapsed := time.Since(start)
+
+			if tt.expectError == nil {
+				if err != nil {
+					t.Fatalf("Do() returned error: %v", err)
+				}
+				resp.Body.Close()
+				if requestCount != 2 {
+					t.Errorf("Expected 2 requests, got %d", requestCount)
+				}
+				return
+			}
+
+			if !errors.Is(err, tt.expectError) {
+				t.Fatalf("Expected error matching %v, got %v", tt.expectError, err)
+			}
+			if elapsed > 200*time.Millisecond {
+				t.Errorf("Expected the wait to fail promptly, took %v", elapsed)
+			}
+			if requestCount != 1 {
+				t.Errorf("Expected the refused request not to be sent, got %d requests", requestCount)
+			}
+		})
+	}
+}
+
+func TestClient_MakeFormRequest_LogsRateLimitWait(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"errorcode": 0}`)
+	}))
+	defer server.Close()
+
+	// 2 requests per second with a burst of 1: the second request waits ~500ms
+	logger := &recordingLogger{}
+	client, err := New(Options{
+		HTTPClient:        &http.Client{},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 2.0,
+		MaxRetries:        0,
+		InitialBackoff:    time.Millisecond,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        time.Second,
+		Logger:            logger,
+		DebugEnabled:      true,
+	})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	if err := client.makeFormRequest(t.Context(), "getHosts", nil, nil); err != nil {
+		t.Fatalf("makeFormRequest() returned error: %v", err)
+	}
+	if strings.Contains(logger.output.String(), "for the rate limiter") {
+		t.Errorf("Expected no wait to be logged for a request within the burst, got:\n%s", logger.output.String())
+	}
+
+	if err := client.makeFormRequest(t.Context(), "getHostStatus", nil, nil); err != nil {
+		t.Fatalf("makeFormRequest() returned error: %v", err)
+	}
+	if !strings.Contains(logger.output.String(), "Command getHostStatus waited") ||
+		!strings.Contains(logger.output.String(), "(2 requests per second, burst 1)") {
+		t.Errorf("Expected the rate limiter wait of getHostStatus to be logged, got:\n%s", logger.output.String())
+	}
+}
+
+func TestNew_RequestsBurst(t *testing.T) {
+	client, err := New(Options{BaseURL: "https://api.example.com", RequestsPerSecond: 10.0})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+	if burst := client.limiter.Burst(); burst != 1 {
+		t.Errorf("Expected an unset requests burst to select 1, got %d", burst)
+	}
+
+	if _, err := New(Options{BaseURL: "https://api.example.com", RequestsPerSecond: 10.0, RequestsBurst: -1}); err == nil {
+		t.Fatal("Expected error for requests burst below 1, got none")
+	}
+}
+
+func TestNew_InvalidResponseFormat(t *testing.T) {
+	if _, err := New(Options{BaseURL: "https://api.example.com", ResponseFormat: "yaml"}); err == nil {
+		t.Fatal("Expected error for an unsupported response format, got none")
+	}
+}
+
+func TestNew_BaseURL(t *testing.T) {
+	tests := []struct {
+		name        string
+		baseURL     string
+		expected    string
+		expectError bool
+	}{
+		{name: "trailing slash", baseURL: "https://x/api/", expected: "https://x/api"},
+		{name: "routing prefix", baseURL: "https://gw.corp/wormly/api", expected: "https://gw.corp/wormly/api"},
+		{name: "root with query", baseURL: "https://x/?region=eu", expected: "https://x?region=eu"},
+		{name: "schemeless", baseURL: "x/api", expectError: true},
+		{name: "relative path", baseURL: "/api", expectError: true},
+		{name: "empty", baseURL: "", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := New(Options{
+				HTTPClient:        &http.Client{},
+				APIKey:            "test-api-key",
+				BaseURL:           tt.baseURL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 10.0,
+				MaxRetries:        3,
+				InitialBackoff:    time.Second,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        30 * time.Second,
+			})
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("Expected error for base URL %q, got none", tt.baseURL)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("New() returned error: %v", err)
+			}
+			if client.baseURL != tt.expected {
+				t.Errorf("Expected baseURL %q, got %q", tt.expected, client.baseURL)
+			}
+		})
+	}
+}
+
 func TestClient_Do_RetryOnTransientErrors(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -243,19 +530,17 @@ func TestClient_Do_RetryOnTransientErrors(t *testing.T) {
 			}))
 			defer server.Close()
 
-			client, err := New(
-				&http.Client{},
-				"test-api-key",
-				server.URL,
-				"test-agent/1.0",
-				1000.0, // High rate limit to avoid rate limiting in tests
-				3,      // 3 retries
-				time.Millisecond,
-				2.0,
-				100*time.Millisecond,
-				NoOpLogger{}, // logger
-				false,        // debug
-			)
+			client, err := New(Options{
+				HTTPClient:        &http.Client{},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 1000.0,
+				MaxRetries:        3,
+				InitialBackoff:    time.Millisecond,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        100 * time.Millisecond,
+			})
 			if err != nil {
 				t.Fatalf("New() returned error: %v", err)
 			}
@@ -329,19 +614,17 @@ func TestClient_Do_ExponentialBackoff(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client, err := New(
-		&http.Client{},
-		"test-api-key",
-		server.URL,
-		"test-agent/1.0",
-		1000.0,               // High rate limit
-		3,                    // 3 retries
-		50*time.Millisecond,  // 50ms initial backoff
-		2.0,                  // Double each time
-		500*time.Millisecond, // 500ms max backoff
-		NoOpLogger{},         // logger
-		false,                // debug
-	)
+	client, err := New(Options{
+		HTTPClient:        &http.Client{},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 1000.0,
+		MaxRetries:        3,
+		InitialBackoff:    50 * time.Millisecond,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        500 * time.Millisecond,
+	})
 	if err != nil {
 		t.Fatalf("New() returned error: %v", err)
 	}
@@ -390,19 +673,17 @@ func TestClient_Do_ExponentialBackoff(t *testing.T) {
 }
 
 func TestClient_Do_BackoffCap(t *testing.T) {
-	client, err := New(
-		&http.Client{},
-		"test-api-key",
-		"https://api.example.com",
-		"test-agent/1.0",
-		1000.0,
-		5,
-		100*time.Millisecond, // 100ms initial
-		3.0,                  // Triple each time
-		200*time.Millisecond, // 200ms max (should cap the backoff)
-		NoOpLogger{},         // logger
-		false,                // debug
-	)
+	client, err := New(Options{
+		HTTPClient:        &http.Client{},
+		APIKey:            "test-api-key",
+		BaseURL:           "https://api.example.com",
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 1000.0,
+		MaxRetries:        5,
+		InitialBackoff:    100 * time.Millisecond,
+		BackoffMultiplier: 3.0,
+		MaxBackoff:        200 * time.Millisecond,
+	})
 	if err != nil {
 		t.Fatalf("New() returned error: %v", err)
 	}
@@ -424,6 +705,125 @@ func TestClient_Do_BackoffCap(t *testing.T) {
 	}
 }
 
+func TestClient_CalculateNextBackoff_Strategies(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy RetryStrategy
+		expected []time.Duration
+	}{
+		{
+			name:     "exponential",
+			strategy: RetryStrategyExponential,
+			expected: []time.Duration{
+				100 * time.Millisecond,
+				200 * time.Millisecond,
+				400 * time.Millisecond,
+				500 * time.Millisecond, // capped
+			},
+		},
+		{
+			name:     "empty defaults to exponential",
+			strategy: "",
+			expected: []time.Duration{
+				100 * time.Millisecond,
+				200 * time.Millisecond,
+				400 * time.Millisecond,
+				500 * time.Millisecond, // capped
+			},
+		},
+		{
+			name:     "constant",
+			strategy: RetryStrategyConstant,
+			expected: []time.Duration{
+				100 * time.Millisecond,
+				100 * time.Millisecond,
+				100 * time.Millisecond,
+				100 * time.Millisecond,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := New(Options{
+				HTTPClient:        &http.Client{},
+				APIKey:            "test-api-key",
+				BaseURL:           "https://api.example.com",
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 1000.0,
+				MaxRetries:        5,
+				InitialBackoff:    100 * time.Millisecond,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        500 * time.Millisecond,
+				RetryStrategy:     tt.strategy,
+			})
+			if err != nil {
+				t.Fatalf("New() returned error: %v", err)
+			}
+
+			// Deterministic strategies must produce the same sequence on every run
+			for run := 0; run < 3; run++ {
+				backoff := client.initialBackoff
+				for i, exp := range tt.expected {
+					if backoff != exp {
+						t.Errorf("Run %d, backoff %d: expected %v, got %v", run, i, exp, backoff)
+					}
+					backoff = client.calculateNextBackoff(backoff)
+				}
+			}
+		})
+	}
+}
+
+func TestClient_CalculateNextBackoff_ExponentialJitter(t *testing.T) {
+	client, err := New(Options{
+		HTTPClient:        &http.Client{},
+		APIKey:            "test-api-key",
+		BaseURL:           "https://api.example.com",
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 1000.0,
+		MaxRetries:        5,
+		InitialBackoff:    100 * time.Millisecond,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        500 * time.Millisecond,
+		RetryStrategy:     RetryStrategyExponentialJitter,
+	})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	// Each delay keeps at least half of the exponential value and never exceeds it or the cap
+	for i := 0; i < 100; i++ {
+		next := client.calculateNextBackoff(200 * time.Millisecond)
+		if next < 200*time.Millisecond || next > 400*time.Millisecond {
+			t.Fatalf("Jittered backoff out of range [200ms, 400ms]: %v", next)
+		}
+
+		capped := client.calculateNextBackoff(400 * time.Millisecond)
+		if capped < 250*time.Millisecond || capped > 500*time.Millisecond {
+			t.Fatalf("Jittered capped backoff out of range [250ms, 500ms]: %v", capped)
+		}
+	}
+}
+
+func TestNew_InvalidRetryStrategy(t *testing.T) {
+	_, err := New(Options{
+		HTTPClient:        &http.Client{},
+		APIKey:            "test-api-key",
+		BaseURL:           "https://api.example.com",
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 10.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+		RetryStrategy:     RetryStrategy("linear"),
+	})
+	if err == nil {
+		t.Fatal("Expected error for unsupported retry strategy, got none")
+	}
+}
+
 func TestIsTransientNetworkError(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -446,9 +846,24 @@ func TestIsTransientNetworkError(t *testing.T) {
 			expected: false, // Temporary errors are no longer considered transient per Go 1.18+ guidance
 		},
 		{
-			name:     "connection refused",
+			name:     "permanent DNS error",
 			err:      &net.OpError{Op: "dial", Err: &net.DNSError{Err: "connection refused", IsTimeout: false, IsTemporary: false}},
-			expected: false, // DNS errors are not syscall errors
+			expected: false, // DNS errors are only retried when the resolver flags them as temporary
+		},
+		{
+			name:     "DNS host not found",
+			err:      &url.Error{Op: "Post", URL: "https://api.wormly.com", Err: &net.OpError{Op: "dial", Err: &net.DNSError{Err: "no such host", Name: "api.wormly.com", IsNotFound: true}}},
+			expected: false,
+		},
+		{
+			name:     "temporary DNS error",
+			err:      &url.Error{Op: "Post", URL: "https://api.wormly.com", Err: &net.OpError{Op: "dial", Err: &net.DNSError{Err: "server misbehaving", Name: "api.wormly.com", IsTemporary: true}}},
+			expected: true,
+		},
+		{
+			name:     "DNS timeout",
+			err:      &net.OpError{Op: "dial", Err: &net.DNSError{Err: "i/o timeout", Name: "api.wormly.com", IsTimeout: true}},
+			expected: true,
 		},
 		{
 			name:     "timeout in op error",
@@ -489,7 +904,7 @@ func TestIsTransientHTTPError(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(fmt.Sprintf("status_%d", tt.statusCode), func(t *testing.T) {
-			result := isTransientHTTPError(tt.statusCode)
+			result := isTransientHTTPError(tt.statusCode, DefaultRetryOnStatus)
 			if result != tt.expected {
 				t.Errorf("isTransientHTTPError(%d) = %v, expected %v", tt.statusCode, result, tt.expected)
 			}
@@ -509,3 +924,1210 @@ type temporaryError struct{}
 func (e *temporaryError) Error() string   { return "temporary" }
 func (e *temporaryError) Timeout() bool   { return false }
 func (e *temporaryError) Temporary() bool { return true }
+
+func TestClient_MakeFormRequest_BackoffResetsPerOperation(t *testing.T) {
+	requests := 0
+	failuresRemaining := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if failuresRemaining > 0 {
+			failuresRemaining--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"errorcode": 0}`)
+	}))
+	defer server.Close()
+
+	client, err := New(Options{
+		HTTPClient:        &http.Client{},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 1000.0,
+		MaxRetries:        3,
+		InitialBackoff:    50 * time.Millisecond,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        500 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	// Record the backoff of every retry instead of waiting it out
+	var backoffs []time.Duration
+	client.sleep = func(_ context.Context, d time.Duration) error {
+		backoffs = append(backoffs, d)
+		return nil
+	}
+
+	// First operation fails twice before succeeding, growing the backoff to 100ms
+	failuresRemaining = 2
+	if err := client.makeFormRequest(t.Context(), "getHostStatus", nil, nil); err != nil {
+		t.Fatalf("First operation returned error: %v", err)
+	}
+	if requests != 3 {
+		t.Fatalf("Expected 3 requests for the first operation, got %d", requests)
+	}
+
+	// Second operation fails once; its retry must start from initialBackoff again
+	failuresRemaining = 1
+	if err := client.makeFormRequest(t.Context(), "getHostStatus", nil, nil); err != nil {
+		t.Fatalf("Second operation returned error: %v", err)
+	}
+	if requests != 5 {
+		t.Fatalf("Expected 5 requests in total, got %d", requests)
+	}
+
+	expected := []time.Duration{50 * time.Millisecond, 100 * time.Millisecond, 50 * time.Millisecond}
+	if !slices.Equal(backoffs, expected) {
+		t.Errorf("Expected backoffs %v, got %v", expected, backoffs)
+	}
+}
+
+func TestClient_MakeFormRequest_Methods(t *testing.T) {
+	tests := []struct {
+		name                string
+		call                func(*Client) error
+		expectedMethod      string
+		expectedContentType string
+		expectedCommand     string
+		expectedHostID      string
+		expectQuery         bool
+	}{
+		{
+			name:            "read command uses GET with query string",
+			call:            func(c *Client) error { _, err := c.GetHostSettings(t.Context(), 123); return err },
+			expectedMethod:  "GET",
+			expectedCommand: "getHostSettings",
+			expectedHostID:  "123",
+			expectQuery:     true,
+		},
+		{
+			name:                "write command uses form-encoded POST",
+			call:                func(c *Client) error { return c.DeleteHost(t.Context(), 123) },
+			expectedMethod:      "POST",
+			expectedContentType: "application/x-www-form-urlencoded",
+			expectedCommand:     "deleteHost",
+			expectedHostID:      "123",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != tt.expectedMethod {
+					t.Errorf("Expected method %s, got %s", tt.expectedMethod, r.Method)
+				}
+				if ct := r.Header.Get("Content-Type"); ct != tt.expectedContentType {
+					t.Errorf("Expected Content-Type %q, got %q", tt.expectedContentType, ct)
+				}
+
+				values := r.URL.Query()
+				if !tt.expectQuery {
+					if err := r.ParseForm(); err != nil {
+						t.Fatalf("Failed to parse form: %v", err)
+					}
+					if r.URL.RawQuery != "" {
+						t.Errorf("Expected no query string, got %q", r.URL.RawQuery)
+					}
+					values = r.PostForm
+				}
+
+				if values.Get("cmd") != tt.expectedCommand {
+					t.Errorf("Expected cmd %q, got %q", tt.expectedCommand, values.Get("cmd"))
+				}
+				if values.Get("key") != "test-api-key" {
+					t.Errorf("Expected key 'test-api-key', got %q", values.Get("key"))
+				}
+				if values.Get("response") != "json" {
+					t.Errorf("Expected response 'json', got %q", values.Get("response"))
+				}
+				if values.Get("hostid") != tt.expectedHostID {
+					t.Errorf("Expected hostid %q, got %q", tt.expectedHostID, values.Get("hostid"))
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, `{"errorcode": 0, "settings": {"testinterval": 60}}`)
+			}))
+			defer server.Close()
+
+			client, err := New(Options{
+				HTTPClient:        &http.Client{},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 1000.0,
+				MaxRetries:        0,
+				InitialBackoff:    time.Millisecond,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        time.Second,
+			})
+			if err != nil {
+				t.Fatalf("New() returned error: %v", err)
+			}
+
+			if err := tt.call(client); err != nil {
+				t.Fatalf("Request returned error: %v", err)
+			}
+		})
+	}
+}
+
+func TestClient_MakeFormRequestGET_PreservesBaseURLQuery(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("region") != "eu" {
+			t.Errorf("Expected base URL query to be preserved, got %q", r.URL.RawQuery)
+		}
+		if r.URL.Query().Get("cmd") != "getContacts" {
+			t.Errorf("Expected cmd 'getContacts', got %q", r.URL.Query().Get("cmd"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"errorcode": 0, "contacts": []}`)
+	}))
+	defer server.Close()
+
+	client, err := New(Options{
+		HTTPClient:        &http.Client{},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL + "/?region=eu",
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 1000.0,
+		MaxRetries:        0,
+		InitialBackoff:    time.Millisecond,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        time.Second,
+	})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	if _, err := client.ListContacts(t.Context()); err != nil {
+		t.Fatalf("ListContacts() returned error: %v", err)
+	}
+}
+
+func TestClient_MakeJSONRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if got := r.Header.Get("Content-Type"); got != "application/json" {
+			t.Errorf("Expected Content-Type 'application/json', got %q", got)
+		}
+		if got := r.Header.Get("User-Agent"); got != "test-agent/1.0" {
+			t.Errorf("Expected User-Agent 'test-agent/1.0', got %q", got)
+		}
+
+		query := r.URL.Query()
+		if query.Get("cmd") != "setHostTags" || query.Get("key") != "test-api-key" || query.Get("response") != "json" {
+			t.Errorf("Expected command parameters in the query string, got %q", r.URL.RawQuery)
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("Failed to read body: %v", err)
+		}
+		if string(body) != `{"hostid":123,"tags":["web","eu"]}` {
+			t.Errorf("Unexpected body: %s", body)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"errorcode": 0, "hostid": 123}`)
+	}))
+	defer server.Close()
+
+	client, err := New(Options{
+		HTTPClient:        &http.Client{},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 1000.0,
+		MaxRetries:        0,
+		InitialBackoff:    time.Millisecond,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        time.Second,
+	})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	payload := struct {
+		HostID int      `json:"hostid"`
+		Tags   []string `json:"tags"`
+	}{HostID: 123, Tags: []string{"web", "eu"}}
+
+	var result struct {
+		ErrorCode int `json:"errorcode"`
+		HostID    int `json:"hostid"`
+	}
+	if err := client.makeJSONRequest(t.Context(), "setHostTags", payload, &result); err != nil {
+		t.Fatalf("makeJSONRequest() returned error: %v", err)
+	}
+	if result.HostID != 123 {
+		t.Errorf("Expected decoded host ID 123, got %d", result.HostID)
+	}
+
+	if err := client.makeJSONRequest(t.Context(), "setHostTags", func() {}, nil); err == nil || !strings.Contains(err.Error(), "failed to encode request") {
+		t.Errorf("Expected an encoding error, got %v", err)
+	}
+}
+
+func TestClient_Do_RetryOnStatus(t *testing.T) {
+	tests := []struct {
+		name             string
+		retryOnStatus    []int
+		expectedRequests int
+		expectedStatus   int
+	}{
+		{
+			name:             "default set does not retry 408",
+			retryOnStatus:    nil,
+			expectedRequests: 1,
+			expectedStatus:   http.StatusRequestTimeout,
+		},
+		{
+			name:             "custom set retries 408",
+			retryOnStatus:    []int{http.StatusRequestTimeout, http.StatusServiceUnavailable},
+			expectedRequests: 2,
+			expectedStatus:   http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestCount := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestCount++
+				if requestCount == 1 {
+					w.WriteHeader(http.StatusRequestTimeout)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			client, err := New(Options{
+				HTTPClient:        &http.Client{},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 1000.0,
+				MaxRetries:        3,
+				InitialBackoff:    time.Millisecond,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        100 * time.Millisecond,
+				RetryOnStatus:     tt.retryOnStatus,
+			})
+			if err != nil {
+				t.Fatalf("New() returned error: %v", err)
+			}
+
+			req, err := http.NewRequest("GET", server.URL+"/test", nil)
+			if err != nil {
+				t.Fatalf("Failed to create request: %v", err)
+			}
+
+			resp, err := client.Do(t.Context(), req)
+			if err != nil {
+				t.Fatalf("Do() returned error: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.expectedStatus {
+				t.Errorf("Expected status %d, got %d", tt.expectedStatus, resp.StatusCode)
+			}
+			if requestCount != tt.expectedRequests {
+				t.Errorf("Expected %d requests, got %d", tt.expectedRequests, requestCount)
+			}
+		})
+	}
+}
+
+func TestClient_MakeFormRequest_RetriesOnlyIdempotentCommands(t *testing.T) {
+	tests := []struct {
+		name             string
+		command          string
+		get              bool
+		status           int
+		expectedRequests int
+		expectError      bool
+	}{
+		// A create whose response is lost may already have been applied
+		{name: "createHost is not retried", command: "createHost", expectedRequests: 1, expectError: true},
+		{name: "createHost is retried when rate limited", command: "createHost", status: http.StatusTooManyRequests, expectedRequests: 3},
+		{name: "getHostStatus is retried", command: "getHostStatus", get: true, expectedRequests: 3},
+		{name: "deleteHost is retried", command: "deleteHost", expectedRequests: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var hostIDs []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseForm(); err != nil {
+					t.Errorf("Failed to parse form: %v", err)
+				}
+				hostIDs = append(hostIDs, r.FormValue("hostid"))
+				if len(hostIDs) <= 2 {
+					w.WriteHeader(cmp.Or(tt.status, http.StatusInternalServerError))
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, `{"errorcode": 0}`)
+			}))
+			defer server.Close()
+
+			client, err := New(Options{
+				HTTPClient:        &http.Client{},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 1000.0,
+				MaxRetries:        3,
+				InitialBackoff:    time.Millisecond,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        10 * time.Millisecond,
+			})
+			if err != nil {
+				t.Fatalf("New() returned error: %v", err)
+			}
+
+			params := map[string]string{"hostid": "123"}
+			if tt.get {
+				err = client.makeFormRequestGET(t.Context(), tt.command, params, nil)
+			} else {
+				err = client.makeFormRequest(t.Context(), tt.command, params, nil)
+			}
+
+			if len(hostIDs) != tt.expectedRequests {
+				t.Errorf("Expected %d requests, got %d", tt.expectedRequests, len(hostIDs))
+			}
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), "HTTP 500") || !strings.Contains(err.Error(), "was not retried") {
+					t.Errorf("Expected the HTTP 500 to be returned without retrying, got: %v", err)
+				}
+			} else if err != nil {
+				t.Errorf("Expected the command to succeed after retries, got: %v", err)
+			}
+			// Every attempt carries the command parameters
+			for i, hostID := range hostIDs {
+				if hostID != "123" {
+					t.Errorf("Request %d: expected hostid 123, got %q", i, hostID)
+				}
+			}
+		})
+	}
+}
+
+func TestClient_MakeFormRequest_SummarizesExhaustedRetries(t *testing.T) {
+	statuses := []int{http.StatusInternalServerError, http.StatusServiceUnavailable, http.StatusInternalServerError, http.StatusBadGateway}
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(statuses[requests])
+		requests++
+	}))
+	defer server.Close()
+
+	client, err := New(Options{
+		HTTPClient:        &http.Client{},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 1000.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Millisecond,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	err = client.makeFormRequestGET(t.Context(), "getHostStatus", nil, nil)
+
+	if requests != len(statuses) {
+		t.Errorf("Expected %d requests, got %d", len(statuses), requests)
+	}
+	if err == nil {
+		t.Fatal("Expected an error once retries ran out")
+	}
+	if want := "request failed after 3 retries, attempt statuses [500, 503, 500, 502]: HTTP 502"; !strings.HasPrefix(err.Error(), want) {
+		t.Errorf("Expected error starting with %q, got: %v", want, err)
+	}
+	// The summary wraps the last attempt's error
+	if unwrapped := errors.Unwrap(err); unwrapped == nil || !strings.HasPrefix(unwrapped.Error(), "HTTP 502") {
+		t.Errorf("Expected errors.Unwrap to return the last HTTP 502 error, got: %v", unwrapped)
+	}
+}
+
+func TestClient_DoAndMakeFormRequest_SameRetries(t *testing.T) {
+	tests := []struct {
+		name             string
+		failures         int
+		expectedRequests int
+		expectError      bool
+	}{
+		{
+			name:             "succeeds after retries",
+			failures:         2,
+			expectedRequests: 3,
+		},
+		{
+			name:             "exhausts retries",
+			failures:         10,
+			expectedRequests: 4, // initial attempt + 3 retries
+			expectError:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// newServer returns a server failing with 500 the configured number of times and a request counter
+			newServer := func() (*httptest.Server, *int) {
+				requestCount := 0
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					requestCount++
+					if requestCount <= tt.failures {
+						w.WriteHeader(http.StatusInternalServerError)
+						return
+					}
+					w.Header().Set("Content-Type", "application/json")
+					fmt.Fprint(w, `{"errorcode": 0}`)
+				}))
+				return server, &requestCount
+			}
+
+			newClient := func(baseURL string) *Client {
+				client, err := New(Options{
+					HTTPClient:        &http.Client{},
+					APIKey:            "test-api-key",
+					BaseURL:           baseURL,
+					UserAgent:         "test-agent/1.0",
+					RequestsPerSecond: 1000.0,
+					MaxRetries:        3,
+					InitialBackoff:    time.Millisecond,
+					BackoffMultiplier: 2.0,
+					MaxBackoff:        10 * time.Millisecond,
+				})
+				if err != nil {
+					t.Fatalf("New() returned error: %v", err)
+				}
+				return client
+			}
+
+			doServer, doCount := newServer()
+			defer doServer.Close()
+			req, err := http.NewRequest("GET", doServer.URL, nil)
+			if err != nil {
+				t.Fatalf("Failed to create request: %v", err)
+			}
+			resp, doErr := newClient(doServer.URL).Do(t.Context(), req)
+			if resp != nil {
+				resp.Body.Close()
+			}
+
+			formServer, formCount := newServer()
+			defer formServer.Close()
+			formErr := newClient(formServer.URL).makeFormRequest(t.Context(), "deleteHost", nil, nil)
+
+			if *doCount != tt.expectedRequests {
+				t.Errorf("Do made %d requests, expected %d", *doCount, tt.expectedRequests)
+			}
+			if *formCount != *doCount {
+				t.Errorf("makeFormRequest made %d requests, Do made %d", *formCount, *doCount)
+			}
+			if (doErr != nil) != tt.expectError || (formErr != nil) != tt.expectError {
+				t.Errorf("Expected error: %v, got Do error %v and makeFormRequest error %v", tt.expectError, doErr, formErr)
+			}
+			if doErr != nil && formErr != nil && doErr.Error() != formErr.Error() {
+				t.Errorf("Expected identical errors, got %q and %q", doErr, formErr)
+			}
+		})
+	}
+}
+
+func TestClient_MakeFormRequest_LogsStructuredFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, "bad request")
+	}))
+	defer server.Close()
+
+	client, err := New(Options{
+		HTTPClient:        &http.Client{},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 1000.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Millisecond,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(t.Context(), &output)
+
+	err = client.makeFormRequest(ctx, "deleteHost", map[string]string{"hostid": "123"}, nil)
+	if err == nil {
+		t.Fatal("Expected error for failed request")
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("Failed to decode log output: %v", err)
+	}
+
+	var failure map[string]interface{}
+	requestIDs := make(map[interface{}]bool)
+	for _, entry := range entries {
+		if entry["@module"] != "provider.wormly" {
+			t.Errorf("Expected @module provider.wormly, got %v", entry["@module"])
+		}
+		if entry["command"] != "deleteHost" {
+			t.Errorf("Expected command deleteHost, got %v", entry["command"])
+		}
+		if entry["host_id"] != "123" {
+			t.Errorf("Expected host_id 123, got %v", entry["host_id"])
+		}
+		requestIDs[entry["request_id"]] = true
+		if _, ok := entry["status_code"]; ok {
+			failure = entry
+		}
+	}
+
+	if len(requestIDs) != 1 || requestIDs[nil] || requestIDs[""] {
+		t.Errorf("Expected a single non-empty request_id across entries, got %v", requestIDs)
+	}
+	if failure == nil {
+		t.Fatalf("Expected a log entry with status_code, got %v", entries)
+	}
+	if failure["status_code"] != float64(http.StatusBadRequest) {
+		t.Errorf("Expected status_code %d, got %v", http.StatusBadRequest, failure["status_code"])
+	}
+
+	attemptLogged := false
+	for _, entry := range entries {
+		if entry["attempt"] == float64(0) {
+			attemptLogged = true
+		}
+	}
+	if !attemptLogged {
+		t.Errorf("Expected a log entry with attempt 0, got %v", entries)
+	}
+}
+
+func TestClient_MakeFormRequest_HTMLResponse(t *testing.T) {
+	tests := []struct {
+		name        string
+		statusCode  int
+		contentType string
+	}{
+		{name: "web UI page", statusCode: http.StatusOK, contentType: "text/html; charset=UTF-8"},
+		{name: "web UI not found page", statusCode: http.StatusNotFound, contentType: "text/html"},
+		{name: "HTML without content type", statusCode: http.StatusOK, contentType: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", tt.contentType)
+				w.WriteHeader(tt.statusCode)
+				fmt.Fprint(w, "<!DOCTYPE html>\n<html><head><title>Wormly</title></head></html>")
+			}))
+			defer server.Close()
+
+			client, err := New(Options{
+				HTTPClient:        &http.Client{},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 1000.0,
+				MaxRetries:        0,
+				InitialBackoff:    time.Millisecond,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        10 * time.Millisecond,
+			})
+			if err != nil {
+				t.Fatalf("New() returned error: %v", err)
+			}
+
+			var result WormlyHostsResponse
+			err = client.makeFormRequestGET(t.Context(), "getHosts", nil, &result)
+			if err == nil {
+				t.Fatal("Expected error for HTML response")
+			}
+			if !strings.Contains(err.Error(), "doesn't look like the Wormly API") {
+				t.Errorf("Expected error to explain the response is not from the API, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestClient_MakeFormRequest_NonJSONResponse(t *testing.T) {
+	tests := []struct {
+		name             string
+		contentType      string
+		body             string
+		expectedContains []string
+		unexpected       []string
+	}{
+		{
+			name:        "gateway error page",
+			contentType: "text/html",
+			body:        "<html><body><h1>502 Bad Gateway</h1></body></html>",
+			expectedContains: []string{
+				`Content-Type "text/html"`,
+				"502 Bad Gateway",
+				"base_url",
+			},
+			unexpected: []string{"invalid character"},
+		},
+		{
+			name:        "plain text error",
+			contentType: "text/plain",
+			body:        "upstream connect error or disconnect/reset before headers",
+			expectedContains: []string{
+				"non-JSON response",
+				`Content-Type "text/plain"`,
+				"upstream connect error",
+				"base_url",
+			},
+		},
+		{
+			name:        "long body is truncated",
+			contentType: "",
+			body:        "<h1>Error</h1>" + strings.Repeat("x", 500) + "END",
+			expectedContains: []string{
+				"non-JSON response",
+				`Content-Type ""`,
+				"(truncated)",
+			},
+			unexpected: []string{"END"},
+		},
+		{
+			name:             "JSON with unexpected types",
+			contentType:      "application/json",
+			body:             `{"errorcode": "zero"}`,
+			expectedContains: []string{"failed to decode response"},
+			unexpected:       []string{"non-JSON response", "base_url"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", tt.contentType)
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprint(w, tt.body)
+			}))
+			defer server.Close()
+
+			client, err := New(Options{
+				HTTPClient:        &http.Client{},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 1000.0,
+				MaxRetries:        0,
+				InitialBackoff:    time.Millisecond,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        10 * time.Millisecond,
+			})
+			if err != nil {
+				t.Fatalf("New() returned error: %v", err)
+			}
+
+			var result WormlyHostsResponse
+			err = client.makeFormRequest(t.Context(), "getHosts", nil, &result)
+			if err == nil {
+				t.Fatal("Expected error for non-JSON response")
+			}
+			for _, expected := range tt.expectedContains {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("Expected error to contain %q, got: %v", expected, err)
+				}
+			}
+			for _, unexpected := range tt.unexpected {
+				if strings.Contains(err.Error(), unexpected) {
+					t.Errorf("Expected error not to contain %q, got: %v", unexpected, err)
+				}
+			}
+		})
+	}
+}
+
+func TestClient_MakeFormRequest_MaxResponseBytes(t *testing.T) {
+	const limit = 1024
+
+	tests := []struct {
+		name        string
+		padding     int
+		expectError bool
+	}{
+		{name: "within limit", padding: 100},
+		{name: "exceeds limit", padding: 64 * limit, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				flusher, ok := w.(http.Flusher)
+				if !ok {
+					t.Fatal("Expected the response writer to support flushing")
+				}
+
+				// Stream the body in chunks, as a huge getHostSensors payload would arrive
+				fmt.Fprint(w, `{"errorcode": 0, "message": "`)
+				chunk := strings.Repeat("x", 256)
+				for written := 0; written < tt.padding; written += len(chunk) {
+					fmt.Fprint(w, chunk)
+					flusher.Flush()
+				}
+				fmt.Fprint(w, `"}`)
+			}))
+			defer server.Close()
+
+			client, err := New(Options{
+				HTTPClient:        &http.Client{},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 1000.0,
+				MaxRetries:        0,
+				InitialBackoff:    time.Millisecond,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        10 * time.Millisecond,
+				MaxResponseBytes:  limit,
+			})
+			if err != nil {
+				t.Fatalf("New() returned error: %v", err)
+			}
+
+			var result WormlyHostsResponse
+			err = client.makeFormRequestGET(t.Context(), "getHosts", nil, &result)
+
+			if !tt.expectError {
+				if err != nil {
+					t.Fatalf("makeFormRequestGET() returned error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("Expected an error for a response over the limit")
+			}
+			if !strings.Contains(err.Error(), "exceeds the maximum of 1024 bytes") {
+				t.Errorf("Expected error to name the limit, got: %v", err)
+			}
+		})
+	}
+}
+
+// roundTripperFunc adapts a function to the http.RoundTripper interface.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestClient_AttemptFromContext(t *testing.T) {
+	if _, ok := AttemptFromContext(t.Context()); ok {
+		t.Error("Expected no attempt outside of a request")
+	}
+
+	tests := []struct {
+		name    string
+		request func(client *Client, serverURL string) error
+	}{
+		{
+			name: "Do",
+			request: func(client *Client, serverURL string) error {
+				req, err := http.NewRequest("GET", serverURL, nil)
+				if err != nil {
+					return err
+				}
+				resp, err := client.Do(t.Context(), req)
+				if err != nil {
+					return err
+				}
+				return resp.Body.Close()
+			},
+		},
+		{
+			name: "makeFormRequest",
+			request: func(client *Client, serverURL string) error {
+				return client.makeFormRequest(t.Context(), "deleteHost", nil, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if requests <= 2 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			var observed []int
+			httpClient := &http.Client{
+				Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					attempt, ok := AttemptFromContext(req.Context())
+					if !ok {
+						t.Error("Expected the request context to carry the attempt number")
+					}
+					observed = append(observed, attempt)
+					return http.DefaultTransport.RoundTrip(req)
+				}),
+			}
+
+			client, err := New(Options{
+				HTTPClient:        httpClient,
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 1000.0,
+				MaxRetries:        3,
+				InitialBackoff:    time.Millisecond,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        10 * time.Millisecond,
+			})
+			if err != nil {
+				t.Fatalf("New() returned error: %v", err)
+			}
+
+			if err := tt.request(client, server.URL); err != nil {
+				t.Fatalf("Request failed: %v", err)
+			}
+
+			expected := []int{0, 1, 2}
+			if fmt.Sprint(observed) != fmt.Sprint(expected) {
+				t.Errorf("Observed attempts %v, expected %v", observed, expected)
+			}
+		})
+	}
+}
+
+// closeIdleRecorder is a transport that records calls to CloseIdleConnections.
+type closeIdleRecorder struct {
+	http.RoundTripper
+	closed int
+}
+
+func (r *closeIdleRecorder) CloseIdleConnections() {
+	r.closed++
+}
+
+func TestClient_Close(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"errorcode": 0, "hosts": []}`)
+	}))
+	defer server.Close()
+
+	recorder := &closeIdleRecorder{RoundTripper: http.DefaultTransport}
+	tests := []struct {
+		name       string
+		httpClient *http.Client
+	}{
+		{name: "default client", httpClient: &http.Client{}},
+		{name: "transport without idle connections", httpClient: &http.Client{Transport: roundTripperFunc(http.DefaultTransport.RoundTrip)}},
+		{name: "pooling transport", httpClient: &http.Client{Transport: recorder}},
+		{name: "nil client", httpClient: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := New(Options{
+				HTTPClient:        tt.httpClient,
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 1000.0,
+				MaxRetries:        0,
+				InitialBackoff:    time.Millisecond,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        10 * time.Millisecond,
+			})
+			if err != nil {
+				t.Fatalf("New() returned error: %v", err)
+			}
+
+			client.Close()
+			client.Close()
+
+			if tt.httpClient == nil {
+				return
+			}
+
+			// The client stays usable after Close
+			if _, err := client.ListHosts(t.Context()); err != nil {
+				t.Errorf("ListHosts() after Close returned error: %v", err)
+			}
+		})
+	}
+
+	if recorder.closed != 2 {
+		t.Errorf("Expected CloseIdleConnections to be called twice, got %d", recorder.closed)
+	}
+}
+
+// countingMetrics records the events reported to a MetricsHook.
+type countingMetrics struct {
+	retries []string
+	waits   int
+}
+
+func (m *countingMetrics) OnRetry(command string, attempt int) {
+	m.retries = append(m.retries, fmt.Sprintf("%s#%d", command, attempt))
+}
+
+func (m *countingMetrics) OnRateLimitWait(time.Duration) {
+	m.waits++
+}
+
+func TestClient_MetricsHook(t *testing.T) {
+	tests := []struct {
+		name            string
+		failures        int
+		expectError     bool
+		expectedRetries []string
+	}{
+		{name: "no failures", failures: 0, expectedRetries: nil},
+		{name: "two failures", failures: 2, expectedRetries: []string{"getHostStatus#1", "getHostStatus#2"}},
+		{
+			name:            "retries exhausted",
+			failures:        5,
+			expectError:     true,
+			expectedRetries: []string{"getHostStatus#1", "getHostStatus#2", "getHostStatus#3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			failuresRemaining := tt.failures
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if failuresRemaining > 0 {
+					failuresRemaining--
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, `{"errorcode": 0}`)
+			}))
+			defer server.Close()
+
+			metrics := &countingMetrics{}
+			client, err := New(Options{
+				HTTPClient:        &http.Client{},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 1000.0,
+				MaxRetries:        3,
+				InitialBackoff:    time.Millisecond,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        10 * time.Millisecond,
+				Metrics:           metrics,
+			})
+			if err != nil {
+				t.Fatalf("New() returned error: %v", err)
+			}
+
+			err = client.makeFormRequest(t.Context(), "getHostStatus", nil, nil)
+			if tt.expectError && err == nil {
+				t.Fatal("Expected an error after exhausting retries")
+			}
+			if !tt.expectError && err != nil {
+				t.Fatalf("makeFormRequest() returned error: %v", err)
+			}
+
+			if fmt.Sprint(metrics.retries) != fmt.Sprint(tt.expectedRetries) {
+				t.Errorf("Expected retries %v, got %v", tt.expectedRetries, metrics.retries)
+			}
+			if metrics.waits != 1 {
+				t.Errorf("Expected one rate limiter wait per operation, got %d", metrics.waits)
+			}
+		})
+	}
+}
+
+func TestClient_CommandTimeout(t *testing.T) {
+	tests := []struct {
+		name         string
+		handlerDelay time.Duration
+		status       int
+	}{
+		// A single request outlives the command timeout
+		{name: "slow response", handlerDelay: time.Second, status: http.StatusOK},
+		// Each request is fast, but retries and backoff together outlive it
+		{name: "retries", status: http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			release := make(chan struct{})
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				select {
+				case <-time.After(tt.handlerDelay):
+				case <-release:
+					return
+				}
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, `{"errorcode": 0}`)
+			}))
+			defer server.Close()
+			defer close(release)
+
+			client, err := New(Options{
+				HTTPClient:        &http.Client{},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 1000.0,
+				MaxRetries:        10,
+				InitialBackoff:    50 * time.Millisecond,
+				BackoffMultiplier: 1.0,
+				MaxBackoff:        50 * time.Millisecond,
+				RetryStrategy:     RetryStrategyConstant,
+				CommandTimeout:    200 * time.Millisecond,
+			})
+			if err != nil {
+				t.Fatalf("New() returned error: %v", err)
+			}
+
+			start := time.Now()
+			err = client.makeFormRequest(t.Context(), "getHostSensors", nil, nil)
+			elapsed := time.Since(start)
+
+			if err == nil || !strings.Contains(err.Error(), "did not complete within the command timeout of 200ms") {
+				t.Errorf("Expected a command timeout error, got %v", err)
+			}
+			if elapsed < 200*time.Millisecond || elapsed > 400*time.Millisecond {
+				t.Errorf("Expected the command to stop at the 200ms timeout, took %v", elapsed)
+			}
+		})
+	}
+}
+
+func TestMapErrorCode(t *testing.T) {
+	tests := []struct {
+		name        string
+		code        int
+		message     string
+		expected    error
+		expectedMsg string
+	}{
+		{name: "invalid host", code: ErrorCodeInvalidHost, message: "Invalid hostid", expected: ErrInvalidHost, expectedMsg: "API returned error code 2: Invalid hostid"},
+		{name: "auth failed", code: ErrorCodeAuthFailed, message: "Invalid API key", expected: ErrAuthFailed, expectedMsg: "API returned error code 3: Invalid API key"},
+		{name: "quota exceeded", code: ErrorCodeQuotaExceeded, message: "Host limit reached", expected: ErrQuotaExceeded, expectedMsg: "API returned error code 4: Host limit reached"},
+		{name: "unknown command", code: ErrorCodeUnknownCommand, message: "Unknown command", expected: ErrUnknownCommand, expectedMsg: "API returned error code 5: Unknown command"},
+		{name: "generic", code: 1, message: "Internal error", expectedMsg: "API returned error code 1: Internal error"},
+		{name: "without message", code: 1, expectedMsg: "API returned error code 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := mapErrorCode(tt.code, tt.message)
+
+			if err.Error() != tt.expectedMsg {
+				t.Errorf("Expected error %q, got %q", tt.expectedMsg, err.Error())
+			}
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) || apiErr.Code != tt.code {
+				t.Errorf("Expected an *APIError with code %d, got %#v", tt.code, err)
+			}
+			for _, sentinel := range []error{ErrInvalidHost, ErrAuthFailed, ErrQuotaExceeded, ErrUnknownCommand} {
+				if got, want := errors.Is(err, sentinel), sentinel == tt.expected; got != want {
+					t.Errorf("errors.Is(err, %q) = %v, want %v", sentinel, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestClient_CreateHost_QuotaExceeded(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"errorcode": %d, "message": "Host limit reached"}`, ErrorCodeQuotaExceeded)
+	}))
+	defer server.Close()
+
+	client, err := New(Options{
+		HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 10.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	_, err = client.CreateHost(t.Context(), "test-host", 60, true)
+	if !errors.Is(err, ErrQuotaExceeded) {
+		t.Errorf("Expected ErrQuotaExceeded, got %v", err)
+	}
+}
+
+func TestClient_DebugLogsElapsedTime(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"errorcode": 0}`)
+	}))
+	defer server.Close()
+
+	logger := &recordingLogger{}
+	client, err := New(Options{
+		HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 10.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Millisecond,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+		Logger:            logger,
+		DebugEnabled:      true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	if err := client.makeFormRequest(t.Context(), "getHosts", nil, nil); err != nil {
+		t.Fatalf("makeFormRequest() returned unexpected error: %v", err)
+	}
+
+	output := logger.output.String()
+	for attempt, status := range []int{http.StatusServiceUnavailable, http.StatusOK} {
+		pattern := regexp.MustCompile(fmt.Sprintf(`Attempt %d: command getHosts returned status %d in (\S+)`, attempt, status))
+		match := pattern.FindStringSubmatch(output)
+		if match == nil {
+			t.Fatalf("Expected log line matching %q, got:\n%s", pattern, output)
+		}
+		if _, err := time.ParseDuration(match[1]); err != nil {
+			t.Errorf("Expected a duration in %q: %v", match[0], err)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Contact represents a Wormly notification contact (alert recipient).
+type Contact struct {
+	ID      int    `json:"contactid"`
+	Name    string `json:"name"`
+	Email   string `json:"email"`
+	SMS     string `json:"sms"`
+	Enabled bool   `json:"enabled"`
+}
+
+// WormlyContactResponse represents the API response for contact operations.
+type WormlyContactResponse struct {
+	ErrorCode int         `json:"errorcode"`
+	Message   string      `json:"message,omitempty"`
+	ContactID json.Number `json:"contactid,omitempty"` // Can be returned as string or number
+}
+
+// WormlyGetContactsResponse represents the API response for getContacts.
+type WormlyGetContactsResponse struct {
+	ErrorCode int    `json:"errorcode"`
+	Message   string `json:"message,omitempty"`
+	Contacts  []struct {
+		ContactID json.Number `json:"contactid"` // Can be returned as string or number
+		Name      string      `json:"name"`
+		Email     string      `json:"email"`
+		SMS       string      `json:"sms"`
+		Enabled   interface{} `json:"enabled"` // Can be returned as bool, number, or string
+	} `json:"contacts"`
+}
+
+// ContactAPI defines the interface for contact-related operations.
+type ContactAPI interface {
+	CreateContact(ctx context.Context, name, email, sms string, enabled bool) (*Contact, error)
+	GetContact(ctx context.Context, id int) (*Contact, error)
+	UpdateContact(ctx context.Context, id int, name, email, sms string, enabled bool) (*Contact, error)
+	DeleteContact(ctx context.Context, id int) error
+	ListContacts(ctx context.Context) ([]Contact, error)
+}
+
+// Ensure Client implements ContactAPI.
+var _ ContactAPI = (*Client)(nil)
+
+// CreateContact creates a new contact.
+func (c *Client) CreateContact(ctx context.Context, name, email, sms string, enabled bool) (*Contact, error) {
+	params := contactParams(name, email, sms, enabled)
+
+	var response WormlyContactResponse
+	if err := c.makeFormRequest(ctx, "addContact", params, &response); err != nil {
+		return nil, fmt.Errorf("failed to create contact: %w", err)
+	}
+
+	if response.ErrorCode != 0 {
+		c.DebugLog(ctx, "CreateContact API error response: %+v", response)
+		return nil, fmt.Errorf("API returned error code %d: %s", response.ErrorCode, response.Message)
+	}
+
+	id, err := strconv.Atoi(response.ContactID.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid contactid value: %s", response.ContactID)
+	}
+
+	return &Contact{
+		ID:      id,
+		Name:    name,
+		Email:   email,
+		SMS:     sms,
+		Enabled: enabled,
+	}, nil
+}
+
+// GetContact retrieves a contact by ID.
+func (c *Client) GetContact(ctx context.Context, id int) (*Contact, error) {
+	contacts, err := c.ListContacts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get contact: %w", err)
+	}
+
+	// Find the contact with the matching ID
+	for _, contact := range contacts {
+		if contact.ID == id {
+			return &contact, nil
+		}
+	}
+
+	return nil, fmt.Errorf("contact with ID %d %w", id, ErrNotFound)
+}
+
+// UpdateContact updates an existing contact.
+// Wormly updates a contact when addContact is called with an existing contactid.
+// An empty email or sms is sent to clear it, since an omitted one is left unchanged.
+func (c *Client) UpdateContact(ctx context.Context, id int, name, email, sms string, enabled bool) (*Contact, error) {
+	params := contactParams(name, email, sms, enabled)
+	params["contactid"] = strconv.Itoa(id)
+	params["email"] = email
+	params["sms"] = sms
+
+	var response WormlyContactResponse
+	if err := c.makeFormRequest(ctx, "addContact", params, &response); err != nil {
+		return nil, fmt.Errorf("failed to update contact: %w", err)
+	}
+
+	if response.ErrorCode != 0 {
+		c.DebugLog(ctx, "UpdateContact API error response: %+v", response)
+		return nil, fmt.Errorf("API returned error code %d: %s", response.ErrorCode, response.Message)
+	}
+
+	return &Contact{
+		ID:      id,
+		Name:    name,
+		Email:   email,
+		SMS:     sms,
+		Enabled: enabled,
+	}, nil
+}
+
+// DeleteContact deletes a contact by ID.
+func (c *Client) DeleteContact(ctx context.Context, id int) error {
+	params := map[string]string{
+		"contactid": strconv.Itoa(id),
+	}
+
+	var response WormlyContactResponse
+	if err := c.makeFormRequest(ctx, "deleteContact", params, &response); err != nil {
+		return fmt.Errorf("failed to delete contact: %w", err)
+	}
+
+	if response.ErrorCode != 0 {
+		return fmt.Errorf("API returned error code %d: %s", response.ErrorCode, response.Message)
+	}
+
+	return nil
+}
+
+// ListContacts retrieves all contacts on the account.
+func (c *Client) ListContacts(ctx context.Context) ([]Contact, error) {
+	var response WormlyGetContactsResponse
+	if err := c.makeFormRequestGET(ctx, "getContacts", nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to list contacts: %w", err)
+	}
+
+	if response.ErrorCode != 0 {
+		return nil, fmt.Errorf("API returned error code %d: %s", response.ErrorCode, response.Message)
+	}
+
+	contacts := make([]Contact, 0, len(response.Contacts))
+	for _, contact := range response.Contacts {
+		id, err := strconv.Atoi(contact.ContactID.String())
+		if err != nil {
+			return nil, fmt.Errorf("invalid contactid value: %s", contact.ContactID)
+		}
+
+		contacts = append(contacts, Contact{
+			ID:      id,
+			Name:    contact.Name,
+			Email:   contact.Email,
+			SMS:     contact.SMS,
+			Enabled: parseContactEnabled(contact.Enabled),
+		})
+	}
+
+	return contacts, nil
+}
+
+// contactParams builds the form parameters shared by contact create and update.
+func contactParams(name, email, sms string, enabled bool) map[string]string {
+	params := map[string]string{
+		"name":    name,
+		"enabled": "0",
+	}
+
+	if enabled {
+		params["enabled"] = "1"
+	}
+	if email != "" {
+		params["email"] = email
+	}
+	if sms != "" {
+		params["sms"] = sms
+	}
+
+	return params
+}
+
+// parseContactEnabled parses the enabled field, which the API may return as bool, number, or string.
+func parseContactEnabled(value interface{}) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case float64:
+		return v != 0
+	case string:
+		switch strings.ToLower(v) {
+		case "1", "true":
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,311 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newContactTestClient starts a test server that answers with responseBody and
+// records the form values of every request it receives.
+func newContactTestClient(t *testing.T, responseBody string, requests *[]url.Values) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse form: %v", err)
+		}
+		*requests = append(*requests, r.Form)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, responseBody)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := New(Options{
+		HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 10.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	return client
+}
+
+func TestClient_CreateContact(t *testing.T) {
+	tests := []struct {
+		name           string
+		responseBody   string
+		expectedError  bool
+		expectedResult *Contact
+	}{
+		{
+			name:         "successful creation",
+			responseBody: `{"errorcode": 0, "contactid": 42}`,
+			expectedResult: &Contact{
+				ID:      42,
+				Name:    "On-call",
+				Email:   "oncall@example.com",
+				SMS:     "+15555550100",
+				Enabled: true,
+			},
+		},
+		{
+			name:         "contact id returned as string",
+			responseBody: `{"errorcode": 0, "contactid": "43"}`,
+			expectedResult: &Contact{
+				ID:      43,
+				Name:    "On-call",
+				Email:   "oncall@example.com",
+				SMS:     "+15555550100",
+				Enabled: true,
+			},
+		},
+		{
+			name:          "API error",
+			responseBody:  `{"errorcode": 1, "message": "Invalid email"}`,
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			var requests []url.Values
+			client := newContactTestClient(t, tt.responseBody, &requests)
+
+			result, err := client.CreateContact(t.Context(), "On-call", "oncall@example.com", "+15555550100", true)
+
+			if tt.expectedError {
+				assert.Error(err, "Expected error but got none")
+				return
+			}
+
+			assert.NoError(err, "Unexpected error")
+			assert.Equal(tt.expectedResult, result)
+			assert.Len(requests, 1)
+			assert.Equal("addContact", requests[0].Get("cmd"))
+			assert.Equal("oncall@example.com", requests[0].Get("email"))
+			assert.Equal("1", requests[0].Get("enabled"))
+			assert.Empty(requests[0].Get("contactid"))
+		})
+	}
+}
+
+func TestClient_GetContact(t *testing.T) {
+	responseBody := `{
+		"errorcode": 0,
+		"contacts": [
+			{"contactid": "41", "name": "Ops", "email": "ops@example.com", "sms": "", "enabled": "1"},
+			{"contactid": 42, "name": "On-call", "email": "", "sms": "+15555550100", "enabled": false}
+		]
+	}`
+
+	tests := []struct {
+		name           string
+		id             int
+		expectedError  bool
+		expectedResult *Contact
+	}{
+		{
+			name: "contact found",
+			id:   42,
+			expectedResult: &Contact{
+				ID:      42,
+				Name:    "On-call",
+				SMS:     "+15555550100",
+				Enabled: false,
+			},
+		},
+		{
+			name:          "contact not found",
+			id:            99,
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			var requests []url.Values
+			client := newContactTestClient(t, responseBody, &requests)
+
+			result, err := client.GetContact(t.Context(), tt.id)
+
+			if tt.expectedError {
+				assert.ErrorIs(err, ErrNotFound)
+				return
+			}
+
+			assert.NoError(err, "Unexpected error")
+			assert.Equal(tt.expectedResult, result)
+			assert.Equal("getContacts", requests[0].Get("cmd"))
+		})
+	}
+}
+
+func TestClient_UpdateContact(t *testing.T) {
+	tests := []struct {
+		name          string
+		responseBody  string
+		expectedError bool
+	}{
+		{
+			name:         "successful update",
+			responseBody: `{"errorcode": 0, "contactid": 42}`,
+		},
+		{
+			name:          "API error",
+			responseBody:  `{"errorcode": 1, "message": "Contact not found"}`,
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			var requests []url.Values
+			client := newContactTestClient(t, tt.responseBody, &requests)
+
+			result, err := client.UpdateContact(t.Context(), 42, "On-call", "new@example.com", "", false)
+
+			if tt.expectedError {
+				assert.Error(err, "Expected error but got none")
+				return
+			}
+
+			assert.NoError(err, "Unexpected error")
+			assert.Equal(&Contact{ID: 42, Name: "On-call", Email: "new@example.com", Enabled: false}, result)
+			assert.Equal("addContact", requests[0].Get("cmd"))
+			assert.Equal("42", requests[0].Get("contactid"))
+			assert.Equal("0", requests[0].Get("enabled"))
+			assert.Equal("new@example.com", requests[0].Get("email"))
+			assert.True(requests[0].Has("sms"), "empty sms should be sent to clear it")
+			assert.Equal("", requests[0].Get("sms"))
+		})
+	}
+}
+
+func TestClient_UpdateContact_ClearsEmail(t *testing.T) {
+	var requests []url.Values
+	client := newContactTestClient(t, `{"errorcode": 0, "contactid": 42}`, &requests)
+
+	_, err := client.UpdateContact(t.Context(), 42, "On-call", "", "+15555550100", true)
+
+	assert.NoError(t, err)
+	assert.True(t, requests[0].Has("email"), "empty email should be sent to clear it")
+	assert.Equal(t, "", requests[0].Get("email"))
+	assert.Equal(t, "+15555550100", requests[0].Get("sms"))
+}
+
+func TestClient_DeleteContact(t *testing.T) {
+	tests := []struct {
+		name          string
+		responseBody  string
+		expectedError bool
+	}{
+		{
+			name:         "successful deletion",
+			responseBody: `{"errorcode": 0}`,
+		},
+		{
+			name:          "API error",
+			responseBody:  `{"errorcode": 1, "message": "Contact not found"}`,
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			var requests []url.Values
+			client := newContactTestClient(t, tt.responseBody, &requests)
+
+			err := client.DeleteContact(t.Context(), 42)
+
+			if tt.expectedError {
+				assert.Error(err, "Expected error but got none")
+				return
+			}
+
+			assert.NoError(err, "Unexpected error")
+			assert.Equal("deleteContact", requests[0].Get("cmd"))
+			assert.Equal("42", requests[0].Get("contactid"))
+		})
+	}
+}
+
+func TestClient_ListContacts(t *testing.T) {
+	tests := []struct {
+		name           string
+		responseBody   string
+		expectedError  bool
+		expectedResult []Contact
+	}{
+		{
+			name: "successful retrieval",
+			responseBody: `{
+				"errorcode": 0,
+				"contacts": [
+					{"contactid": "41", "name": "Ops", "email": "ops@example.com", "enabled": 1},
+					{"contactid": "42", "name": "On-call", "sms": "+15555550100", "enabled": "0"}
+				]
+			}`,
+			expectedResult: []Contact{
+				{ID: 41, Name: "Ops", Email: "ops@example.com", Enabled: true},
+				{ID: 42, Name: "On-call", SMS: "+15555550100", Enabled: false},
+			},
+		},
+		{
+			name:           "empty result",
+			responseBody:   `{"errorcode": 0, "contacts": []}`,
+			expectedResult: []Contact{},
+		},
+		{
+			name:          "invalid contact id",
+			responseBody:  `{"errorcode": 0, "contacts": [{"contactid": "abc"}]}`,
+			expectedError: true,
+		},
+		{
+			name:          "API error",
+			responseBody:  `{"errorcode": 1}`,
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			var requests []url.Values
+			client := newContactTestClient(t, tt.responseBody, &requests)
+
+			result, err := client.ListContacts(t.Context())
+
+			if tt.expectedError {
+				assert.Error(err, "Expected error but got none")
+				return
+			}
+
+			assert.NoError(err, "Unexpected error")
+			assert.Equal(tt.expectedResult, result)
+		})
+	}
+}
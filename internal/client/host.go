@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"time"
@@ -9,12 +10,30 @@ import (
 
 // Host represents a Wormly host.
 type Host struct {
-	ID           int       `json:"id"`
-	Name         string    `json:"name"`
-	TestInterval int       `json:"test_interval"`
-	Enabled      bool      `json:"enabled"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	TestInterval int    `json:"test_interval"`
+	Enabled      bool   `json:"enabled"`
+	// UptimeMonitored and HealthMonitored report each kind of monitoring separately; Enabled mirrors
+	// UptimeMonitored, as enabling a host only switches uptime monitoring on.
+	UptimeMonitored bool      `json:"uptime_monitored"`
+	HealthMonitored bool      `json:"health_monitored"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+	// LastUptimeCheck, LastHealthCheck and LastUptimeError are nil when the
+	// check never ran, or when the host was read without its status.
+	LastUptimeCheck *time.Time `json:"last_uptime_check,omitempty"`
+	LastHealthCheck *time.Time `json:"last_health_check,omitempty"`
+	LastUptimeError *time.Time `json:"last_uptime_error,omitempty"`
+}
+
+// HostMonitoring reports which kinds of monitoring are active on a host, as
+// read from getHostStatus alone.
+type HostMonitoring struct {
+	HostID          int    `json:"host_id"`
+	Name            string `json:"name"`
+	UptimeMonitored bool   `json:"uptime_monitored"`
+	HealthMonitored bool   `json:"health_monitored"`
 }
 
 // WormlyHostResponse represents the API response for host operations.
@@ -30,28 +49,73 @@ type WormlyHostResponse struct {
 }
 
 // WormlyHostStatusResponse represents the API response for getHostStatus.
+// It also decodes the XML form of the response, in which every host is a status element.
 type WormlyHostStatusResponse struct {
-	ErrorCode int `json:"errorcode"`
+	ErrorCode int    `json:"errorcode" xml:"errorcode"`
+	Message   string `json:"message,omitempty" xml:"message"`
 	Status    []struct {
-		HostID          int    `json:"hostid"`
-		Name            string `json:"name"`
-		UptimeMonitored bool   `json:"uptimemonitored"`
-		HealthMonitored bool   `json:"healthmonitored"`
-		UptimeErrors    bool   `json:"uptimeerrors"`
-		HealthErrors    bool   `json:"healtherrors"`
-		LastUptimeCheck *int64 `json:"lastuptimecheck"` // Can be null, -1, or timestamp
-		LastHealthCheck *int64 `json:"lasthealthcheck"` // Can be null, -1, or timestamp
-		LastUptimeError *int64 `json:"lastuptimeerror"` // Can be null, -1, or timestamp
-	} `json:"status"`
+		HostID          int    `json:"hostid" xml:"hostid"`
+		Name            string `json:"name" xml:"name"`
+		UptimeMonitored bool   `json:"uptimemonitored" xml:"uptimemonitored"`
+		HealthMonitored bool   `json:"healthmonitored" xml:"healthmonitored"`
+		UptimeErrors    bool   `json:"uptimeerrors" xml:"uptimeerrors"`
+		HealthErrors    bool   `json:"healtherrors" xml:"healtherrors"`
+		LastUptimeCheck *int64 `json:"lastuptimecheck" xml:"lastuptimecheck"` // Can be null, -1, or timestamp
+		LastHealthCheck *int64 `json:"lasthealthcheck" xml:"lasthealthcheck"` // Can be null, -1, or timestamp
+		LastUptimeError *int64 `json:"lastuptimeerror" xml:"lastuptimeerror"` // Can be null, -1, or timestamp
+	} `json:"status" xml:"status"`
+}
+
+// WormlyHostsResponse represents the API response for getHosts.
+type WormlyHostsResponse struct {
+	ErrorCode int    `json:"errorcode"`
+	Message   string `json:"message,omitempty"`
+	Hosts     []struct {
+		HostID json.Number `json:"hostid"` // Can be returned as string or number
+		Name   string      `json:"name"`
+	} `json:"hosts"`
+}
+
+// HostSettings represents the configurable settings of a Wormly host.
+type HostSettings struct {
+	HostID       int `json:"hostid"`
+	TestInterval int `json:"testinterval"`
+}
+
+// WormlyHostSettingsResponse represents the API response for getHostSettings.
+type WormlyHostSettingsResponse struct {
+	ErrorCode int    `json:"errorcode"`
+	Message   string `json:"message,omitempty"`
+	Settings  struct {
+		TestInterval json.Number `json:"testinterval"` // Can be returned as string or number
+	} `json:"settings"`
+}
+
+// WormlyHostAlertRecipientsResponse represents the API response for getHostAlertRecipients.
+type WormlyHostAlertRecipientsResponse struct {
+	ErrorCode  int    `json:"errorcode"`
+	Message    string `json:"message,omitempty"`
+	Recipients []struct {
+		ContactID json.Number `json:"contactid"` // Can be returned as string or number
+	} `json:"recipients"`
 }
 
 // HostAPI defines the interface for host-related operations.
 type HostAPI interface {
 	CreateHost(ctx context.Context, name string, testInterval int, enabled bool) (*Host, error)
 	GetHost(ctx context.Context, id int) (*Host, error)
+	GetHostMonitoring(ctx context.Context, id int) (*HostMonitoring, error)
+	ListHosts(ctx context.Context) ([]Host, error)
+	GetHostSettings(ctx context.Context, id int) (*HostSettings, error)
 	DeleteHost(ctx context.Context, id int) error
+	DeleteHostCascade(ctx context.Context, id int) error
+	CountHostSensors(ctx context.Context, id int) (int, error)
 	DisableHostUptimeMonitoring(ctx context.Context, hostID int) error
 	EnableHostUptimeMonitoring(ctx context.Context, hostID int) error
+	DisableHostHealthMonitoring(ctx context.Context, hostID int) error
+	EnableHostHealthMonitoring(ctx context.Context, hostID int) error
+	GetHostAlertRecipients(ctx context.Context, hostID int) ([]int, error)
+	ClearHostAlertRecipients(ctx context.Context, hostID int) error
 }
 
 // Ensure Client implements HostAPI.
@@ -73,8 +137,8 @@ func (c *Client) CreateHost(ctx context.Context, name string, testInterval int,
 	}
 
 	if response.ErrorCode != 0 {
-		c.DebugLog("CreateHost API error response: %+v", response)
-		return nil, fmt.Errorf("API returned error code %d: %s", response.ErrorCode, response.Message)
+		c.DebugLog(ctx, "CreateHost API error response: %+v", response)
+		return nil, mapErrorCode(response.ErrorCode, response.Message)
 	}
 
 	return &Host{
@@ -94,33 +158,183 @@ func (c *Client) GetHost(ctx context.Context, id int) (*Host, error) {
 	}
 
 	var response WormlyHostStatusResponse
-	if err := c.makeFormRequest(ctx, "getHostStatus", params, &response); err != nil {
+	if err := c.makeFormRequestGET(ctx, "getHostStatus", params, &response); err != nil {
 		return nil, fmt.Errorf("failed to get host: %w", err)
 	}
 
 	if response.ErrorCode != 0 {
-		return nil, fmt.Errorf("API returned error code %d", response.ErrorCode)
+		return nil, mapErrorCode(response.ErrorCode, response.Message)
 	}
 
-	if len(response.Status) == 0 {
-		return nil, fmt.Errorf("host with ID %d not found", id)
+	// Find the host with the matching ID
+	var host *Host
+	for _, status := range response.Status {
+		if status.HostID == id {
+			host = &Host{
+				ID:              status.HostID,
+				Name:            status.Name,
+				TestInterval:    60,                     // Wormly default, overridden by getHostSettings below
+				Enabled:         status.UptimeMonitored, // Health monitoring alone does not make the host enabled
+				UptimeMonitored: status.UptimeMonitored,
+				HealthMonitored: status.HealthMonitored,
+				CreatedAt:       time.Now(), // API doesn't return timestamps
+				UpdatedAt:       time.Now(), // API doesn't return timestamps
+				LastUptimeCheck: parseHostStatusTimestamp(status.LastUptimeCheck),
+				LastHealthCheck: parseHostStatusTimestamp(status.LastHealthCheck),
+				LastUptimeError: parseHostStatusTimestamp(status.LastUptimeError),
+			}
+			break
+		}
 	}
 
-	// Find the host with the matching ID
-	for _, host := range response.Status {
-		if host.HostID == id {
-			return &Host{
-				ID:           host.HostID,
-				Name:         host.Name,
-				TestInterval: 60,                                           // Default value, API doesn't return this in getHostStatus
-				Enabled:      host.UptimeMonitored || host.HealthMonitored, // Consider host enabled if either monitoring is active
-				CreatedAt:    time.Now(),                                   // API doesn't return timestamps
-				UpdatedAt:    time.Now(),                                   // API doesn't return timestamps
-			}, nil
+	// getHostStatus can omit hosts without active monitoring (e.g. right after creation),
+	// so confirm against the full host list before reporting the host as missing
+	if host == nil {
+		hosts, err := c.ListHosts(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get host: %w", err)
+		}
+
+		name, ok := "", false
+		for _, listed := range hosts {
+			if listed.ID == id {
+				name, ok = listed.Name, true
+				break
+			}
+		}
+		if !ok {
+			return nil, fmt.Errorf("host with ID %d %w", id, ErrNotFound)
+		}
+
+		host = &Host{
+			ID:           id,
+			Name:         name,
+			TestInterval: 60,         // Wormly default, overridden by getHostSettings below
+			Enabled:      false,      // No status means no monitoring is active
+			CreatedAt:    time.Now(), // API doesn't return timestamps
+			UpdatedAt:    time.Now(), // API doesn't return timestamps
+		}
+	}
+
+	// getHostStatus doesn't return the test interval, so merge it from getHostSettings
+	settings, err := c.GetHostSettings(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get host: %w", err)
+	}
+	applyHostSettings(host, settings)
+
+	return host, nil
+}
+
+// GetHostMonitoring reports which kinds of monitoring are active on a host. It
+// only calls getHostStatus, so it is cheaper than GetHost, and the result is
+// cached briefly to serve checks of the same host. A host getHostStatus omits
+// has no active monitoring; its existence is not confirmed.
+func (c *Client) GetHostMonitoring(ctx context.Context, id int) (*HostMonitoring, error) {
+	monitoring, err := c.hostMonitoring.get(ctx, id, func() (HostMonitoring, error) {
+		params := map[string]string{
+			"hostid": strconv.Itoa(id),
+		}
+
+		var response WormlyHostStatusResponse
+		if err := c.makeFormRequestGET(ctx, "getHostStatus", params, &response); err != nil {
+			return HostMonitoring{}, err
+		}
+
+		if response.ErrorCode != 0 {
+			return HostMonitoring{}, mapErrorCode(response.ErrorCode, response.Message)
+		}
+
+		for _, status := range response.Status {
+			if status.HostID == id {
+				return HostMonitoring{
+					HostID:          status.HostID,
+					Name:            status.Name,
+					UptimeMonitored: status.UptimeMonitored,
+					HealthMonitored: status.HealthMonitored,
+				}, nil
+			}
+		}
+		return HostMonitoring{HostID: id}, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get host monitoring: %w", err)
+	}
+
+	return &monitoring, nil
+}
+
+// parseHostStatusTimestamp converts a Unix timestamp from getHostStatus. The API
+// reports null or -1 for events that never happened, which become nil.
+func parseHostStatusTimestamp(value *int64) *time.Time {
+	if value == nil || *value == -1 {
+		return nil
+	}
+
+	timestamp := time.Unix(*value, 0).UTC()
+	return &timestamp
+}
+
+// ListHosts retrieves every host on the account.
+// getHosts only returns host IDs and names, so the other fields are left zero.
+func (c *Client) ListHosts(ctx context.Context) ([]Host, error) {
+	var response WormlyHostsResponse
+	if err := c.makeFormRequestGET(ctx, "getHosts", nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to list hosts: %w", err)
+	}
+
+	if response.ErrorCode != 0 {
+		return nil, fmt.Errorf("API returned error code %d: %s", response.ErrorCode, response.Message)
+	}
+
+	hosts := make([]Host, 0, len(response.Hosts))
+	for _, host := range response.Hosts {
+		id, err := strconv.Atoi(host.HostID.String())
+		if err != nil {
+			return nil, fmt.Errorf("invalid hostid value: %s", host.HostID)
+		}
+		hosts = append(hosts, Host{ID: id, Name: host.Name})
+	}
+
+	return hosts, nil
+}
+
+// GetHostSettings retrieves the settings of a host by ID.
+func (c *Client) GetHostSettings(ctx context.Context, id int) (*HostSettings, error) {
+	params := map[string]string{
+		"hostid": strconv.Itoa(id),
+	}
+
+	var response WormlyHostSettingsResponse
+	if err := c.makeFormRequestGET(ctx, "getHostSettings", params, &response); err != nil {
+		return nil, fmt.Errorf("failed to get host settings: %w", err)
+	}
+
+	if response.ErrorCode != 0 {
+		return nil, fmt.Errorf("API returned error code %d: %s", response.ErrorCode, response.Message)
+	}
+
+	settings := &HostSettings{HostID: id}
+	if response.Settings.TestInterval != "" {
+		testInterval, err := strconv.Atoi(response.Settings.TestInterval.String())
+		if err != nil {
+			return nil, fmt.Errorf("invalid testinterval value: %s", response.Settings.TestInterval)
 		}
+		settings.TestInterval = testInterval
 	}
 
-	return nil, fmt.Errorf("host with ID %d not found", id)
+	return settings, nil
+}
+
+// applyHostSettings merges host settings into a host, keeping the existing
+// test interval when the settings don't carry one.
+func applyHostSettings(host *Host, settings *HostSettings) {
+	if settings == nil {
+		return
+	}
+	if settings.TestInterval > 0 {
+		host.TestInterval = settings.TestInterval
+	}
 }
 
 // DeleteHost deletes a host by ID.
@@ -129,6 +343,8 @@ func (c *Client) DeleteHost(ctx context.Context, id int) error {
 		"hostid": strconv.Itoa(id),
 	}
 
+	defer c.hostMonitoring.invalidate(id)
+
 	var response WormlyHostResponse
 	if err := c.makeFormRequest(ctx, "deleteHost", params, &response); err != nil {
 		return fmt.Errorf("failed to delete host: %w", err)
@@ -141,12 +357,45 @@ func (c *Client) DeleteHost(ctx context.Context, id int) error {
 	return nil
 }
 
+// DeleteHostCascade deletes every sensor of a host, of any type, and then the
+// host itself, as Wormly rejects deleting a host that still has sensors. The
+// host is kept when a sensor cannot be deleted.
+func (c *Client) DeleteHostCascade(ctx context.Context, id int) error {
+	sensors, err := c.getHostSensors(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to list sensors of host %d: %w", id, err)
+	}
+
+	for _, sensor := range sensors {
+		hsid, err := strconv.Atoi(sensor.HSID)
+		if err != nil {
+			return fmt.Errorf("invalid HSID value: %s", sensor.HSID)
+		}
+		if err := c.deleteSensor(ctx, hsid); err != nil {
+			return fmt.Errorf("failed to delete sensor %d of host %d: %w", hsid, id, err)
+		}
+	}
+
+	return c.DeleteHost(ctx, id)
+}
+
+// CountHostSensors returns how many sensors a host has, of any type.
+func (c *Client) CountHostSensors(ctx context.Context, id int) (int, error) {
+	sensors, err := c.getHostSensors(ctx, id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list sensors of host %d: %w", id, err)
+	}
+	return len(sensors), nil
+}
+
 // DisableHostUptimeMonitoring disables uptime monitoring for a host.
 func (c *Client) DisableHostUptimeMonitoring(ctx context.Context, hostID int) error {
 	params := map[string]string{
 		"hostid": strconv.Itoa(hostID),
 	}
 
+	defer c.hostMonitoring.invalidate(hostID)
+
 	var response WormlyHostResponse
 	if err := c.makeFormRequest(ctx, "disableHostUptimeMonitoring", params, &response); err != nil {
 		return fmt.Errorf("failed to disable host uptime monitoring: %w", err)
@@ -165,6 +414,8 @@ func (c *Client) EnableHostUptimeMonitoring(ctx context.Context, hostID int) err
 		"hostid": strconv.Itoa(hostID),
 	}
 
+	defer c.hostMonitoring.invalidate(hostID)
+
 	var response WormlyHostResponse
 	if err := c.makeFormRequest(ctx, "enableHostUptimeMonitoring", params, &response); err != nil {
 		return fmt.Errorf("failed to enable host uptime monitoring: %w", err)
@@ -176,3 +427,89 @@ func (c *Client) EnableHostUptimeMonitoring(ctx context.Context, hostID int) err
 
 	return nil
 }
+
+// DisableHostHealthMonitoring disables health monitoring for a host.
+func (c *Client) DisableHostHealthMonitoring(ctx context.Context, hostID int) error {
+	params := map[string]string{
+		"hostid": strconv.Itoa(hostID),
+	}
+
+	defer c.hostMonitoring.invalidate(hostID)
+
+	var response WormlyHostResponse
+	if err := c.makeFormRequest(ctx, "disableHostHealthMonitoring", params, &response); err != nil {
+		return fmt.Errorf("failed to disable host health monitoring: %w", err)
+	}
+
+	if response.ErrorCode != 0 {
+		return fmt.Errorf("API returned error code %d: %s", response.ErrorCode, response.Message)
+	}
+
+	return nil
+}
+
+// EnableHostHealthMonitoring enables health monitoring for a host.
+func (c *Client) EnableHostHealthMonitoring(ctx context.Context, hostID int) error {
+	params := map[string]string{
+		"hostid": strconv.Itoa(hostID),
+	}
+
+	defer c.hostMonitoring.invalidate(hostID)
+
+	var response WormlyHostResponse
+	if err := c.makeFormRequest(ctx, "enableHostHealthMonitoring", params, &response); err != nil {
+		return fmt.Errorf("failed to enable host health monitoring: %w", err)
+	}
+
+	if response.ErrorCode != 0 {
+		return fmt.Errorf("API returned error code %d: %s", response.ErrorCode, response.Message)
+	}
+
+	return nil
+}
+
+// GetHostAlertRecipients retrieves the IDs of the contacts alerted about a host.
+func (c *Client) GetHostAlertRecipients(ctx context.Context, hostID int) ([]int, error) {
+	params := map[string]string{
+		"hostid": strconv.Itoa(hostID),
+	}
+
+	var response WormlyHostAlertRecipientsResponse
+	if err := c.makeFormRequestGET(ctx, "getHostAlertRecipients", params, &response); err != nil {
+		return nil, fmt.Errorf("failed to get host alert recipients: %w", err)
+	}
+
+	if response.ErrorCode != 0 {
+		return nil, fmt.Errorf("API returned error code %d: %s", response.ErrorCode, response.Message)
+	}
+
+	contactIDs := make([]int, 0, len(response.Recipients))
+	for _, recipient := range response.Recipients {
+		id, err := strconv.Atoi(recipient.ContactID.String())
+		if err != nil {
+			return nil, fmt.Errorf("invalid contactid value: %s", recipient.ContactID)
+		}
+		contactIDs = append(contactIDs, id)
+	}
+
+	return contactIDs, nil
+}
+
+// ClearHostAlertRecipients removes every alert recipient from a host.
+func (c *Client) ClearHostAlertRecipients(ctx context.Context, hostID int) error {
+	params := map[string]string{
+		"hostid":     strconv.Itoa(hostID),
+		"contactids": "",
+	}
+
+	var response WormlyHostResponse
+	if err := c.makeFormRequest(ctx, "setHostAlertRecipients", params, &response); err != nil {
+		return fmt.Errorf("failed to clear host alert recipients: %w", err)
+	}
+
+	if response.ErrorCode != 0 {
+		return fmt.Errorf("API returned error code %d: %s", response.ErrorCode, response.Message)
+	}
+
+	return nil
+}
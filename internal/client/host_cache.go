@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// hostCacheTTL is how long a value cached per host is reused. It only needs to
+// span the reads of one plan or apply, and is kept short so changes made outside
+// Terraform show up quickly.
+const hostCacheTTL = 5 * time.Second
+
+// hostCache holds recently fetched values keyed by host ID, such as a host's
+// scheduled downtime periods. Concurrent reads of the same host wait for a
+// single fetch. The zero value is ready to use.
+type hostCache[T any] struct {
+	mu      sync.Mutex
+	entries map[int]*hostCacheEntry[T]
+}
+
+type hostCacheEntry[T any] struct {
+	done      chan struct{}
+	value     T
+	err       error
+	fetchedAt time.Time
+}
+
+// get returns the cached value of hostID, calling fetch when there is no
+// fresh entry. Failed fetches are not cached.
+func (c *hostCache[T]) get(ctx context.Context, hostID int, fetch func() (T, error)) (T, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[hostID]
+	if ok {
+		select {
+		case <-entry.done:
+			if time.Since(entry.fetchedAt) > hostCacheTTL {
+				ok = false
+			}
+		default:
+		}
+	}
+	if !ok {
+		if c.entries == nil {
+			c.entries = make(map[int]*hostCacheEntry[T])
+		}
+		entry = &hostCacheEntry[T]{done: make(chan struct{})}
+		c.entries[hostID] = entry
+	}
+	c.mu.Unlock()
+
+	if !ok {
+		entry.value, entry.err = fetch()
+		entry.fetchedAt = time.Now()
+		close(entry.done)
+		if entry.err != nil {
+			c.remove(hostID, entry)
+		}
+	}
+
+	select {
+	case <-entry.done:
+		return entry.value, entry.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// invalidate drops the cached value of hostID after a write to it.
+func (c *hostCache[T]) invalidate(hostID int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, hostID)
+}
+
+// remove drops entry if it is still the one cached for hostID.
+func (c *hostCache[T]) remove(hostID int, entry *hostCacheEntry[T]) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries[hostID] == entry {
+		delete(c.entries, hostID)
+	}
+}
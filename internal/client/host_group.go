@@ -0,0 +1,197 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// HostGroup represents a Wormly host group used to organize hosts.
+type HostGroup struct {
+	ID      int
+	Name    string
+	HostIDs []int
+}
+
+// WormlyHostGroupResponse represents the API response for host group operations.
+type WormlyHostGroupResponse struct {
+	ErrorCode int         `json:"errorcode"`
+	Message   string      `json:"message,omitempty"`
+	GroupID   json.Number `json:"groupid,omitempty"` // Can be returned as string or number
+}
+
+// WormlyGetHostGroupsResponse represents the API response for getHostGroups.
+type WormlyGetHostGroupsResponse struct {
+	ErrorCode int    `json:"errorcode"`
+	Message   string `json:"message,omitempty"`
+	Groups    []struct {
+		GroupID json.Number   `json:"groupid"` // Can be returned as string or number
+		Name    string        `json:"name"`
+		HostIDs []json.Number `json:"hostids"` // Members can be returned as strings or numbers
+	} `json:"groups"`
+}
+
+// HostGroupAPI defines the interface for host group-related operations.
+type HostGroupAPI interface {
+	CreateHostGroup(ctx context.Context, name string) (*HostGroup, error)
+	GetHostGroup(ctx context.Context, id int) (*HostGroup, error)
+	UpdateHostGroup(ctx context.Context, id int, name string) (*HostGroup, error)
+	DeleteHostGroup(ctx context.Context, id int) error
+	ListHostGroups(ctx context.Context) ([]HostGroup, error)
+	AddHostToGroup(ctx context.Context, groupID, hostID int) error
+	RemoveHostFromGroup(ctx context.Context, groupID, hostID int) error
+}
+
+// Ensure Client implements HostGroupAPI.
+var _ HostGroupAPI = (*Client)(nil)
+
+// CreateHostGroup creates a new, empty host group.
+func (c *Client) CreateHostGroup(ctx context.Context, name string) (*HostGroup, error) {
+	params := map[string]string{
+		"name": name,
+	}
+
+	var response WormlyHostGroupResponse
+	if err := c.makeFormRequest(ctx, "addHostGroup", params, &response); err != nil {
+		return nil, fmt.Errorf("failed to create host group: %w", err)
+	}
+
+	if response.ErrorCode != 0 {
+		c.DebugLog(ctx, "CreateHostGroup API error response: %+v", response)
+		return nil, fmt.Errorf("API returned error code %d: %s", response.ErrorCode, response.Message)
+	}
+
+	id, err := strconv.Atoi(response.GroupID.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid groupid value: %s", response.GroupID)
+	}
+
+	return &HostGroup{
+		ID:      id,
+		Name:    name,
+		HostIDs: []int{},
+	}, nil
+}
+
+// GetHostGroup retrieves a host group and its members by ID.
+func (c *Client) GetHostGroup(ctx context.Context, id int) (*HostGroup, error) {
+	groups, err := c.ListHostGroups(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get host group: %w", err)
+	}
+
+	for _, group := range groups {
+		if group.ID == id {
+			return &group, nil
+		}
+	}
+
+	return nil, fmt.Errorf("host group with ID %d %w", id, ErrNotFound)
+}
+
+// UpdateHostGroup renames an existing host group. Membership is managed with
+// AddHostToGroup and RemoveHostFromGroup.
+// Wormly updates a host group when addHostGroup is called with an existing groupid.
+func (c *Client) UpdateHostGroup(ctx context.Context, id int, name string) (*HostGroup, error) {
+	params := map[string]string{
+		"groupid": strconv.Itoa(id),
+		"name":    name,
+	}
+
+	var response WormlyHostGroupResponse
+	if err := c.makeFormRequest(ctx, "addHostGroup", params, &response); err != nil {
+		return nil, fmt.Errorf("failed to update host group: %w", err)
+	}
+
+	if response.ErrorCode != 0 {
+		c.DebugLog(ctx, "UpdateHostGroup API error response: %+v", response)
+		return nil, fmt.Errorf("API returned error code %d: %s", response.ErrorCode, response.Message)
+	}
+
+	return c.GetHostGroup(ctx, id)
+}
+
+// DeleteHostGroup deletes a host group by ID. The hosts in the group are not deleted.
+func (c *Client) DeleteHostGroup(ctx context.Context, id int) error {
+	params := map[string]string{
+		"groupid": strconv.Itoa(id),
+	}
+
+	var response WormlyHostGroupResponse
+	if err := c.makeFormRequest(ctx, "deleteHostGroup", params, &response); err != nil {
+		return fmt.Errorf("failed to delete host group: %w", err)
+	}
+
+	if response.ErrorCode != 0 {
+		return fmt.Errorf("API returned error code %d: %s", response.ErrorCode, response.Message)
+	}
+
+	return nil
+}
+
+// ListHostGroups retrieves all host groups on the account.
+func (c *Client) ListHostGroups(ctx context.Context) ([]HostGroup, error) {
+	var response WormlyGetHostGroupsResponse
+	if err := c.makeFormRequestGET(ctx, "getHostGroups", nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to list host groups: %w", err)
+	}
+
+	if response.ErrorCode != 0 {
+		return nil, fmt.Errorf("API returned error code %d: %s", response.ErrorCode, response.Message)
+	}
+
+	groups := make([]HostGroup, 0, len(response.Groups))
+	for _, group := range response.Groups {
+		id, err := strconv.Atoi(group.GroupID.String())
+		if err != nil {
+			return nil, fmt.Errorf("invalid groupid value: %s", group.GroupID)
+		}
+
+		hostIDs := make([]int, 0, len(group.HostIDs))
+		for _, hostID := range group.HostIDs {
+			parsed, err := strconv.Atoi(hostID.String())
+			if err != nil {
+				return nil, fmt.Errorf("invalid hostid value in host group %d: %s", id, hostID)
+			}
+			hostIDs = append(hostIDs, parsed)
+		}
+
+		groups = append(groups, HostGroup{
+			ID:      id,
+			Name:    group.Name,
+			HostIDs: hostIDs,
+		})
+	}
+
+	return groups, nil
+}
+
+// AddHostToGroup adds a host to a host group.
+func (c *Client) AddHostToGroup(ctx context.Context, groupID, hostID int) error {
+	return c.setHostGroupMembership(ctx, "addHostToGroup", groupID, hostID)
+}
+
+// RemoveHostFromGroup removes a host from a host group. The host itself is not deleted.
+func (c *Client) RemoveHostFromGroup(ctx context.Context, groupID, hostID int) error {
+	return c.setHostGroupMembership(ctx, "removeHostFromGroup", groupID, hostID)
+}
+
+// setHostGroupMembership sends a command that changes whether a host belongs to a group.
+func (c *Client) setHostGroupMembership(ctx context.Context, command string, groupID, hostID int) error {
+	params := map[string]string{
+		"groupid": strconv.Itoa(groupID),
+		"hostid":  strconv.Itoa(hostID),
+	}
+
+	var response WormlyHostGroupResponse
+	if err := c.makeFormRequest(ctx, command, params, &response); err != nil {
+		return fmt.Errorf("failed to %s (group %d, host %d): %w", command, groupID, hostID, err)
+	}
+
+	if response.ErrorCode != 0 {
+		return fmt.Errorf("API returned error code %d: %s", response.ErrorCode, response.Message)
+	}
+
+	return nil
+}
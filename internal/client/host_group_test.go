@@ -0,0 +1,97 @@
+package client
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_CreateHostGroup(t *testing.T) {
+	var requests []url.Values
+	client := newContactTestClient(t, `{"errorcode": 0, "groupid": "7"}`, &requests)
+
+	group, err := client.CreateHostGroup(t.Context(), "web servers")
+
+	assert.NoError(t, err)
+	assert.Equal(t, &HostGroup{ID: 7, Name: "web servers", HostIDs: []int{}}, group)
+	if assert.Len(t, requests, 1) {
+		assert.Equal(t, "addHostGroup", requests[0].Get("cmd"))
+		assert.Equal(t, "web servers", requests[0].Get("name"))
+		assert.Empty(t, requests[0].Get("groupid"))
+	}
+}
+
+func TestClient_ListHostGroups(t *testing.T) {
+	tests := []struct {
+		name           string
+		responseBody   string
+		expectedError  string
+		expectedResult []HostGroup
+	}{
+		{
+			name: "members as strings and numbers",
+			responseBody: `{"errorcode": 0, "groups": [
+				{"groupid": "7", "name": "web", "hostids": ["1", 2]},
+				{"groupid": 8, "name": "empty", "hostids": []}
+			]}`,
+			expectedResult: []HostGroup{
+				{ID: 7, Name: "web", HostIDs: []int{1, 2}},
+				{ID: 8, Name: "empty", HostIDs: []int{}},
+			},
+		},
+		{
+			name:          "invalid member",
+			responseBody:  `{"errorcode": 0, "groups": [{"groupid": "7", "name": "web", "hostids": ["web-1"]}]}`,
+			expectedError: "failed to list host groups",
+		},
+		{
+			name:          "API error",
+			responseBody:  `{"errorcode": 1, "message": "Access denied"}`,
+			expectedError: "API returned error code 1: Access denied",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []url.Values
+			client := newContactTestClient(t, tt.responseBody, &requests)
+
+			groups, err := client.ListHostGroups(t.Context())
+
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, groups)
+			assert.Equal(t, "getHostGroups", requests[0].Get("cmd"))
+		})
+	}
+}
+
+func TestClient_GetHostGroup_NotFound(t *testing.T) {
+	var requests []url.Values
+	client := newContactTestClient(t, `{"errorcode": 0, "groups": [{"groupid": "7", "name": "web", "hostids": []}]}`, &requests)
+
+	_, err := client.GetHostGroup(t.Context(), 8)
+
+	assert.True(t, errors.Is(err, ErrNotFound), "Expected ErrNotFound, got: %v", err)
+}
+
+func TestClient_HostGroupMembership(t *testing.T) {
+	var requests []url.Values
+	client := newContactTestClient(t, `{"errorcode": 0}`, &requests)
+
+	assert.NoError(t, client.AddHostToGroup(t.Context(), 7, 123))
+	assert.NoError(t, client.RemoveHostFromGroup(t.Context(), 7, 456))
+
+	if assert.Len(t, requests, 2) {
+		assert.Equal(t, "addHostToGroup", requests[0].Get("cmd"))
+		assert.Equal(t, "7", requests[0].Get("groupid"))
+		assert.Equal(t, "123", requests[0].Get("hostid"))
+		assert.Equal(t, "removeHostFromGroup", requests[1].Get("cmd"))
+		assert.Equal(t, "456", requests[1].Get("hostid"))
+	}
+}
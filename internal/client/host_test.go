@@ -0,0 +1,682 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetHost_MergesSettings(t *testing.T) {
+	tests := []struct {
+		name                 string
+		settingsResponse     string
+		expectedTestInterval int
+		expectedError        bool
+	}{
+		{
+			name:                 "numeric test interval",
+			settingsResponse:     `{"errorcode": 0, "settings": {"hostid": 123, "testinterval": 300}}`,
+			expectedTestInterval: 300,
+		},
+		{
+			name:                 "string test interval",
+			settingsResponse:     `{"errorcode": 0, "settings": {"hostid": "123", "testinterval": "120"}}`,
+			expectedTestInterval: 120,
+		},
+		{
+			name:                 "missing test interval keeps default",
+			settingsResponse:     `{"errorcode": 0, "settings": {"hostid": 123}}`,
+			expectedTestInterval: 60,
+		},
+		{
+			name:             "settings API error",
+			settingsResponse: `{"errorcode": 1, "message": "Invalid host"}`,
+			expectedError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.FormValue("cmd") {
+				case "getHostStatus":
+					fmt.Fprint(w, `{"errorcode": 0, "status": [{"hostid": 123, "name": "test-host", "uptimemonitored": true}]}`)
+				case "getHostSettings":
+					fmt.Fprint(w, tt.settingsResponse)
+				default:
+					t.Errorf("Unexpected command %q", r.FormValue("cmd"))
+				}
+			}))
+			defer server.Close()
+
+			client, err := New(Options{
+				HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 10.0,
+				MaxRetries:        3,
+				InitialBackoff:    time.Second,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        30 * time.Second,
+			})
+			assert.NoError(err, "Failed to create client")
+
+			host, err := client.GetHost(t.Context(), 123)
+
+			if tt.expectedError {
+				assert.Error(err, "Expected error but got none")
+				return
+			}
+
+			assert.NoError(err, "Unexpected error")
+			assert.Equal(123, host.ID)
+			assert.Equal("test-host", host.Name)
+			assert.True(host.Enabled)
+			assert.Equal(tt.expectedTestInterval, host.TestInterval)
+		})
+	}
+}
+
+func TestClient_GetHost_StatusTimestamps(t *testing.T) {
+	checkedAt := time.Date(2025, 6, 1, 12, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		value    string
+		expected *time.Time
+	}{
+		{name: "timestamp", value: strconv.FormatInt(checkedAt.Unix(), 10), expected: &checkedAt},
+		{name: "null sentinel", value: "null"},
+		{name: "-1 sentinel", value: "-1"},
+		{name: "omitted", value: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			fields := ""
+			if tt.value != "" {
+				fields = fmt.Sprintf(`, "lastuptimecheck": %[1]s, "lasthealthcheck": %[1]s, "lastuptimeerror": %[1]s`, tt.value)
+			}
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.FormValue("cmd") {
+				case "getHostStatus":
+					fmt.Fprintf(w, `{"errorcode": 0, "status": [{"hostid": 123, "name": "test-host", "uptimemonitored": true%s}]}`, fields)
+				case "getHostSettings":
+					fmt.Fprint(w, `{"errorcode": 0, "settings": {"testinterval": 300}}`)
+				default:
+					t.Errorf("Unexpected command %q", r.FormValue("cmd"))
+				}
+			}))
+			defer server.Close()
+
+			client, err := New(Options{
+				HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 10.0,
+				MaxRetries:        3,
+				InitialBackoff:    time.Second,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        30 * time.Second,
+			})
+			assert.NoError(err, "Failed to create client")
+
+			host, err := client.GetHost(t.Context(), 123)
+
+			assert.NoError(err, "Unexpected error")
+			assert.Equal(tt.expected, host.LastUptimeCheck)
+			assert.Equal(tt.expected, host.LastHealthCheck)
+			assert.Equal(tt.expected, host.LastUptimeError)
+		})
+	}
+}
+
+func TestClient_GetHost_MonitoringFlags(t *testing.T) {
+	tests := []struct {
+		name            string
+		uptime, health  bool
+		expectedEnabled bool
+	}{
+		{name: "uptime and health", uptime: true, health: true, expectedEnabled: true},
+		{name: "uptime only", uptime: true, expectedEnabled: true},
+		{name: "health only", health: true, expectedEnabled: false},
+		{name: "neither", expectedEnabled: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.FormValue("cmd") {
+				case "getHostStatus":
+					fmt.Fprintf(w, `{"errorcode": 0, "status": [{"hostid": 123, "name": "test-host", "uptimemonitored": %t, "healthmonitored": %t}]}`, tt.uptime, tt.health)
+				case "getHostSettings":
+					fmt.Fprint(w, `{"errorcode": 0, "settings": {"testinterval": 300}}`)
+				default:
+					t.Errorf("Unexpected command %q", r.FormValue("cmd"))
+				}
+			}))
+			defer server.Close()
+
+			client, err := New(Options{
+				HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 10.0,
+				MaxRetries:        3,
+				InitialBackoff:    time.Second,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        30 * time.Second,
+			})
+			assert.NoError(err, "Failed to create client")
+
+			host, err := client.GetHost(t.Context(), 123)
+
+			assert.NoError(err, "Unexpected error")
+			assert.Equal(tt.expectedEnabled, host.Enabled)
+			assert.Equal(tt.uptime, host.UptimeMonitored)
+			assert.Equal(tt.health, host.HealthMonitored)
+		})
+	}
+}
+
+func TestClient_GetHost_EmptyStatusFallsBackToHostList(t *testing.T) {
+	tests := []struct {
+		name           string
+		hostsResponse  string
+		expectedName   string
+		expectedError  bool
+		expectNotFound bool
+	}{
+		{
+			name:          "unmonitored host exists",
+			hostsResponse: `{"errorcode": 0, "hosts": [{"hostid": "122", "name": "other-host"}, {"hostid": "123", "name": "new-host"}]}`,
+			expectedName:  "new-host",
+		},
+		{
+			name:           "host missing from list",
+			hostsResponse:  `{"errorcode": 0, "hosts": [{"hostid": 122, "name": "other-host"}]}`,
+			expectedError:  true,
+			expectNotFound: true,
+		},
+		{
+			name:          "host list API error",
+			hostsResponse: `{"errorcode": 1, "message": "Internal error"}`,
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.FormValue("cmd") {
+				case "getHostStatus":
+					fmt.Fprint(w, `{"errorcode": 0, "status": []}`)
+				case "getHosts":
+					fmt.Fprint(w, tt.hostsResponse)
+				case "getHostSettings":
+					fmt.Fprint(w, `{"errorcode": 0, "settings": {"testinterval": 300}}`)
+				default:
+					t.Errorf("Unexpected command %q", r.FormValue("cmd"))
+				}
+			}))
+			defer server.Close()
+
+			client, err := New(Options{
+				HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 10.0,
+				MaxRetries:        3,
+				InitialBackoff:    time.Second,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        30 * time.Second,
+			})
+			assert.NoError(err, "Failed to create client")
+
+			host, err := client.GetHost(t.Context(), 123)
+
+			if tt.expectedError {
+				assert.Error(err, "Expected error but got none")
+				assert.Equal(tt.expectNotFound, errors.Is(err, ErrNotFound), "Unexpected ErrNotFound match for %v", err)
+				return
+			}
+
+			assert.NoError(err, "Unexpected error")
+			assert.Equal(123, host.ID)
+			assert.Equal(tt.expectedName, host.Name)
+			assert.False(host.Enabled)
+			assert.Equal(300, host.TestInterval)
+		})
+	}
+}
+
+func TestClient_GetHost_StatusAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"errorcode": 3, "message": "Invalid API key"}`)
+	}))
+	defer server.Close()
+
+	client, err := New(Options{
+		HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 10.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+	})
+	assert.NoError(t, err, "Failed to create client")
+
+	_, err = client.GetHost(t.Context(), 123)
+
+	assert.ErrorIs(t, err, ErrAuthFailed)
+	assert.ErrorContains(t, err, "Invalid API key")
+}
+
+func TestClient_ListHosts(t *testing.T) {
+	assert := assert.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("getHosts", r.FormValue("cmd"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"errorcode": 0, "hosts": [{"hostid": "122", "name": "web"}, {"hostid": 123, "name": "db"}]}`)
+	}))
+	defer server.Close()
+
+	client, err := New(Options{
+		HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 10.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+	})
+	assert.NoError(err, "Failed to create client")
+
+	hosts, err := client.ListHosts(t.Context())
+	assert.NoError(err, "Unexpected error")
+	assert.Equal([]Host{{ID: 122, Name: "web"}, {ID: 123, Name: "db"}}, hosts)
+}
+
+func TestClient_HostAlertRecipients(t *testing.T) {
+	assert := assert.New(t)
+
+	var commands []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		commands = append(commands, r.FormValue("cmd"))
+		assert.Equal("123", r.FormValue("hostid"))
+		w.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("cmd") {
+		case "getHostAlertRecipients":
+			fmt.Fprint(w, `{"errorcode": 0, "recipients": [{"contactid": "41"}, {"contactid": 42}]}`)
+		case "setHostAlertRecipients":
+			assert.True(r.Form.Has("contactids"))
+			assert.Empty(r.FormValue("contactids"))
+			fmt.Fprint(w, `{"errorcode": 0}`)
+		default:
+			t.Errorf("Unexpected command %q", r.FormValue("cmd"))
+		}
+	}))
+	defer server.Close()
+
+	client, err := New(Options{
+		HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 10.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+	})
+	assert.NoError(err, "Failed to create client")
+
+	contactIDs, err := client.GetHostAlertRecipients(t.Context(), 123)
+	assert.NoError(err)
+	assert.Equal([]int{41, 42}, contactIDs)
+
+	assert.NoError(client.ClearHostAlertRecipients(t.Context(), 123))
+	assert.Equal([]string{"getHostAlertRecipients", "setHostAlertRecipients"}, commands)
+}
+
+func TestClient_HostHealthMonitoring(t *testing.T) {
+	assert := assert.New(t)
+
+	var commands []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		commands = append(commands, r.FormValue("cmd"))
+		assert.Equal("123", r.FormValue("hostid"))
+		w.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("cmd") {
+		case "enableHostHealthMonitoring":
+			fmt.Fprint(w, `{"errorcode": 0}`)
+		case "disableHostHealthMonitoring":
+			fmt.Fprint(w, `{"errorcode": 1, "message": "No agent installed"}`)
+		default:
+			t.Errorf("Unexpected command %q", r.FormValue("cmd"))
+		}
+	}))
+	defer server.Close()
+
+	client, err := New(Options{
+		HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 10.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+	})
+	assert.NoError(err, "Failed to create client")
+
+	assert.NoError(client.EnableHostHealthMonitoring(t.Context(), 123))
+
+	err = client.DisableHostHealthMonitoring(t.Context(), 123)
+	assert.ErrorContains(err, "No agent installed")
+	assert.Equal([]string{"enableHostHealthMonitoring", "disableHostHealthMonitoring"}, commands)
+}
+
+func TestClient_CountHostSensors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.Equal(t, "getHostSensors", r.FormValue("cmd"))
+		// Sensor 11 is not an HTTP sensor and is counted too
+		fmt.Fprint(w, `{"errorcode": 0, "sensors": [{"hsid": "10", "sensorid": "1"}, {"hsid": "11", "sensorid": "7"}]}`)
+	}))
+	defer server.Close()
+
+	client, err := New(Options{
+		HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 10.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+	})
+	assert.NoError(t, err, "Failed to create client")
+
+	count, err := client.CountHostSensors(t.Context(), 123)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestClient_GetHostMonitoring(t *testing.T) {
+	var commands []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		commands = append(commands, r.FormValue("cmd")+" "+r.FormValue("hostid"))
+		w.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("cmd") {
+		case "getHostStatus":
+			if r.FormValue("hostid") == "123" {
+				fmt.Fprint(w, `{"errorcode": 0, "status": [{"hostid": 123, "name": "web", "uptimemonitored": false, "healthmonitored": true}]}`)
+				return
+			}
+			// Hosts without active monitoring are left out of getHostStatus
+			fmt.Fprint(w, `{"errorcode": 0, "status": []}`)
+		case "enableHostUptimeMonitoring":
+			fmt.Fprint(w, `{"errorcode": 0}`)
+		default:
+			t.Errorf("Unexpected command %q", r.FormValue("cmd"))
+		}
+	}))
+	defer server.Close()
+
+	client, err := New(Options{
+		HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 10.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+	})
+	assert.NoError(t, err, "Failed to create client")
+
+	monitoring, err := client.GetHostMonitoring(t.Context(), 123)
+	assert.NoError(t, err)
+	assert.Equal(t, &HostMonitoring{HostID: 123, Name: "web", HealthMonitored: true}, monitoring)
+
+	_, err = client.GetHostMonitoring(t.Context(), 123)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"getHostStatus 123"}, commands, "Expected a second check of the host to be served from the cache")
+
+	monitoring, err = client.GetHostMonitoring(t.Context(), 456)
+	assert.NoError(t, err)
+	assert.Equal(t, &HostMonitoring{HostID: 456}, monitoring)
+
+	assert.NoError(t, client.EnableHostUptimeMonitoring(t.Context(), 123))
+	_, err = client.GetHostMonitoring(t.Context(), 123)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"getHostStatus 123",
+		"getHostStatus 456",
+		"enableHostUptimeMonitoring 123",
+		"getHostStatus 123",
+	}, commands, "Expected changing monitoring to invalidate the cached status")
+}
+
+func TestClient_DeleteHostCascade(t *testing.T) {
+	tests := []struct {
+		name             string
+		failSensor       string
+		expectError      string
+		expectedCommands []string
+	}{
+		{
+			name: "deletes sensors before the host",
+			expectedCommands: []string{
+				"getHostSensors",
+				"deleteSensor 10",
+				"deleteSensor 11",
+				"deleteHost",
+			},
+		},
+		{
+			name:        "sensor failure keeps the host",
+			failSensor:  "11",
+			expectError: "failed to delete sensor 11 of host 123",
+			expectedCommands: []string{
+				"getHostSensors",
+				"deleteSensor 10",
+				"deleteSensor 11",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			var commands []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.FormValue("cmd") {
+				case "getHostSensors":
+					commands = append(commands, "getHostSensors")
+					assert.Equal("123", r.FormValue("hostid"))
+					// Sensor 11 is not an HTTP sensor and must be deleted too
+					fmt.Fprint(w, `{"errorcode": 0, "sensors": [{"hsid": "10", "sensorid": "1"}, {"hsid": "11", "sensorid": "7"}]}`)
+				case "deleteSensor":
+					commands = append(commands, "deleteSensor "+r.FormValue("hsid"))
+					if r.FormValue("hsid") == tt.failSensor {
+						fmt.Fprint(w, `{"errorcode": 1, "message": "Sensor is locked"}`)
+						return
+					}
+					fmt.Fprint(w, `{"errorcode": 0}`)
+				case "deleteHost":
+					commands = append(commands, "deleteHost")
+					assert.Equal("123", r.FormValue("hostid"))
+					fmt.Fprint(w, `{"errorcode": 0}`)
+				default:
+					t.Errorf("Unexpected command %q", r.FormValue("cmd"))
+				}
+			}))
+			defer server.Close()
+
+			client, err := New(Options{
+				HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 10.0,
+				MaxRetries:        3,
+				InitialBackoff:    time.Second,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        30 * time.Second,
+			})
+			assert.NoError(err, "Failed to create client")
+
+			err = client.DeleteHostCascade(t.Context(), 123)
+			if tt.expectError != "" {
+				assert.ErrorContains(err, tt.expectError)
+			} else {
+				assert.NoError(err)
+			}
+			assert.Equal(tt.expectedCommands, commands)
+		})
+	}
+}
+
+func TestClient_ResponseFormatXML(t *testing.T) {
+	var responseFormat string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		responseFormat = r.FormValue("response")
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?>
+<response>
+	<errorcode>0</errorcode>
+	<status>
+		<hostid>12345</hostid>
+		<name>web-1</name>
+		<uptimemonitored>1</uptimemonitored>
+		<healthmonitored>0</healthmonitored>
+		<uptimeerrors>false</uptimeerrors>
+		<healtherrors>false</healtherrors>
+		<lastuptimecheck>1700000000</lastuptimecheck>
+		<lastuptimeerror>-1</lastuptimeerror>
+	</status>
+	<status>
+		<hostid>67890</hostid>
+		<name>web-2</name>
+		<uptimemonitored>0</uptimemonitored>
+		<healthmonitored>1</healthmonitored>
+		<uptimeerrors>false</uptimeerrors>
+		<healtherrors>true</healtherrors>
+	</status>
+</response>`)
+	}))
+	defer server.Close()
+
+	client, err := New(Options{
+		HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 10.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+		ResponseFormat:    ResponseFormatXML,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	var response WormlyHostStatusResponse
+	if err := client.makeFormRequestGET(t.Context(), "getHostStatus", nil, &response); err != nil {
+		t.Fatalf("makeFormRequestGET() returned unexpected error: %v", err)
+	}
+
+	if responseFormat != "xml" {
+		t.Errorf("Expected response=xml to be requested, got %q", responseFormat)
+	}
+	if len(response.Status) != 2 {
+		t.Fatalf("Expected 2 hosts, got %d", len(response.Status))
+	}
+
+	first := response.Status[0]
+	if first.HostID != 12345 || first.Name != "web-1" || !first.UptimeMonitored || first.HealthMonitored {
+		t.Errorf("Unexpected first host: %+v", first)
+	}
+	if first.LastUptimeCheck == nil || *first.LastUptimeCheck != 1700000000 {
+		t.Errorf("Expected lastuptimecheck 1700000000, got %v", first.LastUptimeCheck)
+	}
+	if parseHostStatusTimestamp(first.LastUptimeError) != nil {
+		t.Errorf("Expected lastuptimeerror -1 to mean never, got %v", *first.LastUptimeError)
+	}
+	if first.LastHealthCheck != nil {
+		t.Errorf("Expected a missing lasthealthcheck to decode as nil, got %v", *first.LastHealthCheck)
+	}
+
+	second := response.Status[1]
+	if second.HostID != 67890 || second.UptimeMonitored || !second.HealthMonitored || !second.HealthErrors {
+		t.Errorf("Unexpected second host: %+v", second)
+	}
+}
+
+func TestClient_ResponseFormatXML_InvalidResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"errorcode": 0, "status": []}`)
+	}))
+	defer server.Close()
+
+	client, err := New(Options{
+		HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 10.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+		ResponseFormat:    ResponseFormatXML,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	var response WormlyHostStatusResponse
+	err = client.makeFormRequestGET(t.Context(), "getHostStatus", nil, &response)
+	assert.ErrorContains(t, err, "failed to decode XML response")
+}
@@ -0,0 +1,35 @@
+package client
+
+import "slices"
+
+// NOTE: These test location codes are not officially documented in the Wormly API documentation.
+// They have been determined from the locations offered when configuring a sensor.
+const (
+	TestLocationDallas       = "dal"
+	TestLocationNewYork      = "nyc"
+	TestLocationSanFrancisco = "sfo"
+	TestLocationSaoPaulo     = "sao"
+	TestLocationLondon       = "lon"
+	TestLocationFrankfurt    = "fra"
+	TestLocationSingapore    = "sin"
+	TestLocationTokyo        = "tyo"
+	TestLocationSydney       = "syd"
+)
+
+// TestLocations lists the known test location codes a sensor can be checked from.
+var TestLocations = []string{
+	TestLocationDallas,
+	TestLocationNewYork,
+	TestLocationSanFrancisco,
+	TestLocationSaoPaulo,
+	TestLocationLondon,
+	TestLocationFrankfurt,
+	TestLocationSingapore,
+	TestLocationTokyo,
+	TestLocationSydney,
+}
+
+// IsValidTestLocation reports whether code is a known test location code.
+func IsValidTestLocation(code string) bool {
+	return slices.Contains(TestLocations, code)
+}
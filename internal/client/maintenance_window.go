@@ -0,0 +1,162 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// maintenanceWindowTimezone is the timezone maintenance windows are scheduled
+// in. RFC3339 timestamps only carry a UTC offset, not a zone name, so both ends
+// of a window are converted to UTC.
+const maintenanceWindowTimezone = "UTC"
+
+// maintenanceWindowRecurrence is the recurrence of the downtime period backing a maintenance window.
+const maintenanceWindowRecurrence = "ONCEONLY"
+
+// Layouts of the clock times and date of a ONCEONLY downtime period.
+const (
+	downtimeClockLayout = "15:04"
+	downtimeDateLayout  = time.DateOnly
+)
+
+// MaxMaintenanceWindowDuration is the longest maintenance window a single
+// ONCEONLY downtime period can represent: its end clock time must fall before
+// its start clock time on the following day.
+const MaxMaintenanceWindowDuration = 24 * time.Hour
+
+// MaintenanceWindow represents a one-off maintenance window, stored by Wormly
+// as a ONCEONLY scheduled downtime period.
+type MaintenanceWindow struct {
+	ID       int
+	HostID   int
+	StartsAt time.Time
+	EndsAt   time.Time
+}
+
+// MaintenanceWindowAPI defines the interface for maintenance window-related operations.
+type MaintenanceWindowAPI interface {
+	CreateMaintenanceWindow(ctx context.Context, hostID int, startsAt, endsAt time.Time) (*MaintenanceWindow, error)
+	GetMaintenanceWindow(ctx context.Context, hostID, periodID int) (*MaintenanceWindow, error)
+	UpdateMaintenanceWindow(ctx context.Context, hostID, periodID int, startsAt, endsAt time.Time) (*MaintenanceWindow, error)
+	DeleteMaintenanceWindow(ctx context.Context, hostID, periodID int) error
+}
+
+// Ensure Client implements MaintenanceWindowAPI.
+var _ MaintenanceWindowAPI = (*Client)(nil)
+
+// CreateMaintenanceWindow creates a ONCEONLY scheduled downtime period covering startsAt to endsAt.
+func (c *Client) CreateMaintenanceWindow(ctx context.Context, hostID int, startsAt, endsAt time.Time) (*MaintenanceWindow, error) {
+	start, end, on, err := MaintenanceWindowSchedule(startsAt, endsAt)
+	if err != nil {
+		return nil, err
+	}
+
+	period, err := c.CreateScheduledDowntimePeriod(ctx, hostID, start, end, maintenanceWindowTimezone, maintenanceWindowRecurrence, on)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create maintenance window: %w", err)
+	}
+
+	return &MaintenanceWindow{
+		ID:       period.ID,
+		HostID:   hostID,
+		StartsAt: startsAt,
+		EndsAt:   endsAt,
+	}, nil
+}
+
+// GetMaintenanceWindow retrieves a maintenance window by host ID and period ID.
+func (c *Client) GetMaintenanceWindow(ctx context.Context, hostID, periodID int) (*MaintenanceWindow, error) {
+	period, err := c.GetScheduledDowntimePeriod(ctx, hostID, periodID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get maintenance window: %w", err)
+	}
+
+	startsAt, endsAt, err := maintenanceWindowTimes(period)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get maintenance window: %w", err)
+	}
+
+	return &MaintenanceWindow{
+		ID:       period.ID,
+		HostID:   hostID,
+		StartsAt: startsAt,
+		EndsAt:   endsAt,
+	}, nil
+}
+
+// UpdateMaintenanceWindow reschedules a maintenance window to cover startsAt to endsAt.
+func (c *Client) UpdateMaintenanceWindow(ctx context.Context, hostID, periodID int, startsAt, endsAt time.Time) (*MaintenanceWindow, error) {
+	start, end, on, err := MaintenanceWindowSchedule(startsAt, endsAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := c.UpdateScheduledDowntimePeriod(ctx, hostID, periodID, start, end, maintenanceWindowTimezone, maintenanceWindowRecurrence, on); err != nil {
+		return nil, fmt.Errorf("failed to update maintenance window: %w", err)
+	}
+
+	return &MaintenanceWindow{
+		ID:       periodID,
+		HostID:   hostID,
+		StartsAt: startsAt,
+		EndsAt:   endsAt,
+	}, nil
+}
+
+// DeleteMaintenanceWindow deletes a maintenance window.
+func (c *Client) DeleteMaintenanceWindow(ctx context.Context, hostID, periodID int) error {
+	if err := c.DeleteScheduledDowntimePeriod(ctx, hostID, periodID); err != nil {
+		return fmt.Errorf("failed to delete maintenance window: %w", err)
+	}
+	return nil
+}
+
+// MaintenanceWindowSchedule converts the bounds of a maintenance window into the
+// start and end clock times and the date of a ONCEONLY downtime period in UTC.
+// An end clock time before the start one ends the period on the following day.
+func MaintenanceWindowSchedule(startsAt, endsAt time.Time) (start, end, on string, err error) {
+	if !endsAt.After(startsAt) {
+		return "", "", "", fmt.Errorf("maintenance window must end after it starts, got %s to %s",
+			startsAt.Format(time.RFC3339), endsAt.Format(time.RFC3339))
+	}
+	if endsAt.Sub(startsAt) >= MaxMaintenanceWindowDuration {
+		return "", "", "", fmt.Errorf("maintenance window must be shorter than %s, got %s", MaxMaintenanceWindowDuration, endsAt.Sub(startsAt))
+	}
+	if startsAt.Second() != 0 || startsAt.Nanosecond() != 0 || endsAt.Second() != 0 || endsAt.Nanosecond() != 0 {
+		return "", "", "", fmt.Errorf("maintenance window bounds must be whole minutes, got %s to %s",
+			startsAt.Format(time.RFC3339Nano), endsAt.Format(time.RFC3339Nano))
+	}
+
+	startsAt = startsAt.UTC()
+	endsAt = endsAt.UTC()
+
+	return startsAt.Format(downtimeClockLayout), endsAt.Format(downtimeClockLayout), startsAt.Format(downtimeDateLayout), nil
+}
+
+// maintenanceWindowTimes converts a ONCEONLY downtime period back into the bounds of a maintenance window.
+func maintenanceWindowTimes(period *ScheduledDowntimePeriod) (startsAt, endsAt time.Time, err error) {
+	if period.Recurrence != maintenanceWindowRecurrence {
+		return time.Time{}, time.Time{}, fmt.Errorf("scheduled downtime period %d recurs %s and is not a maintenance window", period.ID, period.Recurrence)
+	}
+
+	location, err := time.LoadLocation(period.Timezone)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("unsupported timezone %q: %w", period.Timezone, err)
+	}
+
+	layout := downtimeDateLayout + " " + downtimeClockLayout
+	startsAt, err = time.ParseInLocation(layout, period.On+" "+period.Start, location)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid start of scheduled downtime period %d: %w", period.ID, err)
+	}
+	endsAt, err = time.ParseInLocation(layout, period.On+" "+period.End, location)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid end of scheduled downtime period %d: %w", period.ID, err)
+	}
+	if !endsAt.After(startsAt) {
+		endsAt = endsAt.AddDate(0, 0, 1)
+	}
+
+	return startsAt, endsAt, nil
+}
@@ -0,0 +1,177 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaintenanceWindowSchedule(t *testing.T) {
+	tests := []struct {
+		name          string
+		startsAt      string
+		endsAt        string
+		expectedStart string
+		expectedEnd   string
+		expectedOn    string
+		expectedError string
+	}{
+		{
+			name:          "utc",
+			startsAt:      "2025-12-25T10:00:00Z",
+			endsAt:        "2025-12-25T11:30:00Z",
+			expectedStart: "10:00",
+			expectedEnd:   "11:30",
+			expectedOn:    "2025-12-25",
+		},
+		{
+			name:          "offset converted to utc",
+			startsAt:      "2025-12-25T10:00:00+02:00",
+			endsAt:        "2025-12-25T12:00:00+02:00",
+			expectedStart: "08:00",
+			expectedEnd:   "10:00",
+			expectedOn:    "2025-12-25",
+		},
+		{
+			name:          "offset moves the date",
+			startsAt:      "2025-12-25T01:00:00+02:00",
+			endsAt:        "2025-12-25T03:00:00+02:00",
+			expectedStart: "23:00",
+			expectedEnd:   "01:00",
+			expectedOn:    "2025-12-24",
+		},
+		{
+			name:          "crosses midnight",
+			startsAt:      "2025-12-31T22:00:00Z",
+			endsAt:        "2026-01-01T02:00:00Z",
+			expectedStart: "22:00",
+			expectedEnd:   "02:00",
+			expectedOn:    "2025-12-31",
+		},
+		{
+			name:          "ends before it starts",
+			startsAt:      "2025-12-25T11:00:00Z",
+			endsAt:        "2025-12-25T10:00:00Z",
+			expectedError: "must end after it starts",
+		},
+		{
+			name:          "empty window",
+			startsAt:      "2025-12-25T10:00:00Z",
+			endsAt:        "2025-12-25T10:00:00Z",
+			expectedError: "must end after it starts",
+		},
+		{
+			name:          "a day or longer",
+			startsAt:      "2025-12-25T10:00:00Z",
+			endsAt:        "2025-12-26T10:00:00Z",
+			expectedError: "must be shorter than 24h0m0s",
+		},
+		{
+			name:          "seconds",
+			startsAt:      "2025-12-25T10:00:30Z",
+			endsAt:        "2025-12-25T11:00:00Z",
+			expectedError: "must be whole minutes",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			startsAt, err := time.Parse(time.RFC3339, tt.startsAt)
+			assert.NoError(err)
+			endsAt, err := time.Parse(time.RFC3339, tt.endsAt)
+			assert.NoError(err)
+
+			start, end, on, err := MaintenanceWindowSchedule(startsAt, endsAt)
+			if tt.expectedError != "" {
+				assert.ErrorContains(err, tt.expectedError)
+				return
+			}
+
+			assert.NoError(err)
+			assert.Equal(tt.expectedStart, start)
+			assert.Equal(tt.expectedEnd, end)
+			assert.Equal(tt.expectedOn, on)
+
+			// Converting the period back yields the same window
+			gotStartsAt, gotEndsAt, err := maintenanceWindowTimes(&ScheduledDowntimePeriod{
+				Start:      start,
+				End:        end,
+				On:         on,
+				Timezone:   maintenanceWindowTimezone,
+				Recurrence: maintenanceWindowRecurrence,
+			})
+			assert.NoError(err)
+			assert.True(startsAt.Equal(gotStartsAt), "expected %s, got %s", startsAt, gotStartsAt)
+			assert.True(endsAt.Equal(gotEndsAt), "expected %s, got %s", endsAt, gotEndsAt)
+		})
+	}
+}
+
+func TestMaintenanceWindowTimes_Recurring(t *testing.T) {
+	_, _, err := maintenanceWindowTimes(&ScheduledDowntimePeriod{
+		ID:         123,
+		Start:      "22:00",
+		End:        "06:00",
+		Timezone:   "UTC",
+		Recurrence: "DAILY",
+	})
+	assert.ErrorContains(t, err, "is not a maintenance window")
+}
+
+func TestClient_MaintenanceWindow(t *testing.T) {
+	assert := assert.New(t)
+
+	var commands []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		commands = append(commands, r.FormValue("cmd"))
+		w.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("cmd") {
+		case "setScheduledDowntimePeriod":
+			assert.Equal("12345", r.FormValue("hostid"))
+			assert.Equal("22:00", r.FormValue("start"))
+			assert.Equal("02:00", r.FormValue("end"))
+			assert.Equal("UTC", r.FormValue("timezone"))
+			assert.Equal("ONCEONLY", r.FormValue("recurrence"))
+			assert.Equal("2025-12-31", r.FormValue("on"))
+			fmt.Fprint(w, `{"errorcode": 0, "periodid": 123}`)
+		case "getScheduledDowntimePeriods":
+			fmt.Fprint(w, `{"errorcode": 0, "periods": [{"periodid": "123", "start": "22:00", "end": "02:00", "timezone": "UTC", "recurrence": "ONCEONLY", "on": "2025-12-31"}]}`)
+		default:
+			t.Errorf("Unexpected command %q", r.FormValue("cmd"))
+		}
+	}))
+	defer server.Close()
+
+	client, err := New(Options{
+		HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 10.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+	})
+	assert.NoError(err, "Failed to create client")
+
+	startsAt := time.Date(2025, 12, 31, 23, 0, 0, 0, time.FixedZone("CET", 3600))
+	endsAt := startsAt.Add(4 * time.Hour)
+
+	window, err := client.CreateMaintenanceWindow(t.Context(), 12345, startsAt, endsAt)
+	assert.NoError(err)
+	assert.Equal(123, window.ID)
+
+	window, err = client.GetMaintenanceWindow(t.Context(), 12345, 123)
+	assert.NoError(err)
+	assert.True(startsAt.Equal(window.StartsAt), "expected %s, got %s", startsAt, window.StartsAt)
+	assert.True(endsAt.Equal(window.EndsAt), "expected %s, got %s", endsAt, window.EndsAt)
+
+	assert.Equal([]string{"setScheduledDowntimePeriod", "getScheduledDowntimePeriods"}, commands)
+}
@@ -0,0 +1,42 @@
+package client
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAccountAPI is a mock implementation of the AccountAPI interface.
+type MockAccountAPI struct {
+	mock.Mock
+}
+
+// GetAccountInfo mocks the GetAccountInfo method.
+func (m *MockAccountAPI) GetAccountInfo(ctx context.Context) (*AccountInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if account, ok := args.Get(0).(*AccountInfo); ok {
+		return account, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// GetAccount mocks the GetAccount method.
+func (m *MockAccountAPI) GetAccount(ctx context.Context) (*Account, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if account, ok := args.Get(0).(*Account); ok {
+		return account, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// Ping mocks the Ping method.
+func (m *MockAccountAPI) Ping(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
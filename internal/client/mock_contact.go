@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockContactAPI is a mock implementation of the ContactAPI interface.
+type MockContactAPI struct {
+	mock.Mock
+}
+
+// CreateContact mocks the CreateContact method.
+func (m *MockContactAPI) CreateContact(ctx context.Context, name, email, sms string, enabled bool) (*Contact, error) {
+	args := m.Called(ctx, name, email, sms, enabled)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if contact, ok := args.Get(0).(*Contact); ok {
+		return contact, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// GetContact mocks the GetContact method.
+func (m *MockContactAPI) GetContact(ctx context.Context, id int) (*Contact, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if contact, ok := args.Get(0).(*Contact); ok {
+		return contact, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// UpdateContact mocks the UpdateContact method.
+func (m *MockContactAPI) UpdateContact(ctx context.Context, id int, name, email, sms string, enabled bool) (*Contact, error) {
+	args := m.Called(ctx, id, name, email, sms, enabled)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if contact, ok := args.Get(0).(*Contact); ok {
+		return contact, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// DeleteContact mocks the DeleteContact method.
+func (m *MockContactAPI) DeleteContact(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// ListContacts mocks the ListContacts method.
+func (m *MockContactAPI) ListContacts(ctx context.Context) ([]Contact, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if contacts, ok := args.Get(0).([]Contact); ok {
+		return contacts, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -35,12 +35,60 @@ func (m *MockHostAPI) GetHost(ctx context.Context, id int) (*Host, error) {
 	return nil, args.Error(1)
 }
 
+// GetHostMonitoring mocks the GetHostMonitoring method.
+func (m *MockHostAPI) GetHostMonitoring(ctx context.Context, id int) (*HostMonitoring, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if monitoring, ok := args.Get(0).(*HostMonitoring); ok {
+		return monitoring, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// ListHosts mocks the ListHosts method.
+func (m *MockHostAPI) ListHosts(ctx context.Context) ([]Host, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if hosts, ok := args.Get(0).([]Host); ok {
+		return hosts, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// GetHostSettings mocks the GetHostSettings method.
+func (m *MockHostAPI) GetHostSettings(ctx context.Context, id int) (*HostSettings, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if settings, ok := args.Get(0).(*HostSettings); ok {
+		return settings, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
 // DeleteHost mocks the DeleteHost method.
 func (m *MockHostAPI) DeleteHost(ctx context.Context, id int) error {
 	args := m.Called(ctx, id)
 	return args.Error(0)
 }
 
+// DeleteHostCascade mocks the DeleteHostCascade method.
+func (m *MockHostAPI) DeleteHostCascade(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// CountHostSensors mocks the CountHostSensors method.
+func (m *MockHostAPI) CountHostSensors(ctx context.Context, id int) (int, error) {
+	args := m.Called(ctx, id)
+	return args.Int(0), args.Error(1)
+}
+
 // DisableHostUptimeMonitoring mocks the DisableHostUptimeMonitoring method.
 func (m *MockHostAPI) DisableHostUptimeMonitoring(ctx context.Context, hostID int) error {
 	args := m.Called(ctx, hostID)
@@ -52,3 +100,33 @@ func (m *MockHostAPI) EnableHostUptimeMonitoring(ctx context.Context, hostID int
 	args := m.Called(ctx, hostID)
 	return args.Error(0)
 }
+
+// DisableHostHealthMonitoring mocks the DisableHostHealthMonitoring method.
+func (m *MockHostAPI) DisableHostHealthMonitoring(ctx context.Context, hostID int) error {
+	args := m.Called(ctx, hostID)
+	return args.Error(0)
+}
+
+// EnableHostHealthMonitoring mocks the EnableHostHealthMonitoring method.
+func (m *MockHostAPI) EnableHostHealthMonitoring(ctx context.Context, hostID int) error {
+	args := m.Called(ctx, hostID)
+	return args.Error(0)
+}
+
+// GetHostAlertRecipients mocks the GetHostAlertRecipients method.
+func (m *MockHostAPI) GetHostAlertRecipients(ctx context.Context, hostID int) ([]int, error) {
+	args := m.Called(ctx, hostID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if contactIDs, ok := args.Get(0).([]int); ok {
+		return contactIDs, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// ClearHostAlertRecipients mocks the ClearHostAlertRecipients method.
+func (m *MockHostAPI) ClearHostAlertRecipients(ctx context.Context, hostID int) error {
+	args := m.Called(ctx, hostID)
+	return args.Error(0)
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockHostGroupAPI is a mock implementation of the HostGroupAPI interface.
+type MockHostGroupAPI struct {
+	mock.Mock
+}
+
+// CreateHostGroup mocks the CreateHostGroup method.
+func (m *MockHostGroupAPI) CreateHostGroup(ctx context.Context, name string) (*HostGroup, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if group, ok := args.Get(0).(*HostGroup); ok {
+		return group, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// GetHostGroup mocks the GetHostGroup method.
+func (m *MockHostGroupAPI) GetHostGroup(ctx context.Context, id int) (*HostGroup, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if group, ok := args.Get(0).(*HostGroup); ok {
+		return group, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// UpdateHostGroup mocks the UpdateHostGroup method.
+func (m *MockHostGroupAPI) UpdateHostGroup(ctx context.Context, id int, name string) (*HostGroup, error) {
+	args := m.Called(ctx, id, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if group, ok := args.Get(0).(*HostGroup); ok {
+		return group, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// DeleteHostGroup mocks the DeleteHostGroup method.
+func (m *MockHostGroupAPI) DeleteHostGroup(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// ListHostGroups mocks the ListHostGroups method.
+func (m *MockHostGroupAPI) ListHostGroups(ctx context.Context) ([]HostGroup, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if groups, ok := args.Get(0).([]HostGroup); ok {
+		return groups, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// AddHostToGroup mocks the AddHostToGroup method.
+func (m *MockHostGroupAPI) AddHostToGroup(ctx context.Context, groupID, hostID int) error {
+	args := m.Called(ctx, groupID, hostID)
+	return args.Error(0)
+}
+
+// RemoveHostFromGroup mocks the RemoveHostFromGroup method.
+func (m *MockHostGroupAPI) RemoveHostFromGroup(ctx context.Context, groupID, hostID int) error {
+	args := m.Called(ctx, groupID, hostID)
+	return args.Error(0)
+}
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockMaintenanceWindowAPI is a mock implementation of the MaintenanceWindowAPI interface.
+type MockMaintenanceWindowAPI struct {
+	mock.Mock
+}
+
+// CreateMaintenanceWindow mocks the CreateMaintenanceWindow method.
+func (m *MockMaintenanceWindowAPI) CreateMaintenanceWindow(ctx context.Context, hostID int, startsAt, endsAt time.Time) (*MaintenanceWindow, error) {
+	args := m.Called(ctx, hostID, startsAt, endsAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if window, ok := args.Get(0).(*MaintenanceWindow); ok {
+		return window, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// GetMaintenanceWindow mocks the GetMaintenanceWindow method.
+func (m *MockMaintenanceWindowAPI) GetMaintenanceWindow(ctx context.Context, hostID, periodID int) (*MaintenanceWindow, error) {
+	args := m.Called(ctx, hostID, periodID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if window, ok := args.Get(0).(*MaintenanceWindow); ok {
+		return window, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// UpdateMaintenanceWindow mocks the UpdateMaintenanceWindow method.
+func (m *MockMaintenanceWindowAPI) UpdateMaintenanceWindow(ctx context.Context, hostID, periodID int, startsAt, endsAt time.Time) (*MaintenanceWindow, error) {
+	args := m.Called(ctx, hostID, periodID, startsAt, endsAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if window, ok := args.Get(0).(*MaintenanceWindow); ok {
+		return window, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// DeleteMaintenanceWindow mocks the DeleteMaintenanceWindow method.
+func (m *MockMaintenanceWindowAPI) DeleteMaintenanceWindow(ctx context.Context, hostID, periodID int) error {
+	args := m.Called(ctx, hostID, periodID)
+	return args.Error(0)
+}
@@ -0,0 +1,30 @@
+package client
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockSensorAPI is a mock implementation of the SensorAPI interface.
+type MockSensorAPI struct {
+	mock.Mock
+}
+
+// EnableSensor mocks the EnableSensor method.
+func (m *MockSensorAPI) EnableSensor(ctx context.Context, hsid int) error {
+	args := m.Called(ctx, hsid)
+	return args.Error(0)
+}
+
+// DisableSensor mocks the DisableSensor method.
+func (m *MockSensorAPI) DisableSensor(ctx context.Context, hsid int) error {
+	args := m.Called(ctx, hsid)
+	return args.Error(0)
+}
+
+// AcknowledgeSensor mocks the AcknowledgeSensor method.
+func (m *MockSensorAPI) AcknowledgeSensor(ctx context.Context, hsid int) error {
+	args := m.Called(ctx, hsid)
+	return args.Error(0)
+}
@@ -22,6 +22,17 @@ func (m *MockSensorHTTPAPI) CreateSensorHTTP(ctx context.Context, req *SensorHTT
 	return nil, args.Error(1)
 }
 
+func (m *MockSensorHTTPAPI) CreateSensorsHTTPBatch(ctx context.Context, reqs []*SensorHTTPCreateRequest) ([]*SensorHTTP, error) {
+	args := m.Called(ctx, reqs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if sensors, ok := args.Get(0).([]*SensorHTTP); ok {
+		return sensors, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
 func (m *MockSensorHTTPAPI) GetSensorHTTP(ctx context.Context, hostID, sensorID int) (*SensorHTTP, error) {
 	args := m.Called(ctx, hostID, sensorID)
 	if args.Get(0) == nil {
@@ -38,6 +49,11 @@ func (m *MockSensorHTTPAPI) DeleteSensorHTTP(ctx context.Context, sensorID int)
 	return args.Error(0)
 }
 
+func (m *MockSensorHTTPAPI) FindSensorHost(ctx context.Context, sensorID int) (int, error) {
+	args := m.Called(ctx, sensorID)
+	return args.Int(0), args.Error(1)
+}
+
 func (m *MockSensorHTTPAPI) ListSensorHTTP(ctx context.Context, hostID int) ([]*SensorHTTP, error) {
 	args := m.Called(ctx, hostID)
 	if args.Get(0) == nil {
@@ -49,6 +65,17 @@ func (m *MockSensorHTTPAPI) ListSensorHTTP(ctx context.Context, hostID int) ([]*
 	return nil, args.Error(1)
 }
 
+func (m *MockSensorHTTPAPI) ListSensorsHTTPBatch(ctx context.Context, hostIDs []int) ([][]*SensorHTTP, error) {
+	args := m.Called(ctx, hostIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if sensors, ok := args.Get(0).([][]*SensorHTTP); ok {
+		return sensors, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
 func (m *MockSensorHTTPAPI) EnableSensorHTTP(ctx context.Context, hsid int) error {
 	args := m.Called(ctx, hsid)
 	return args.Error(0)
@@ -58,3 +85,19 @@ func (m *MockSensorHTTPAPI) DisableSensorHTTP(ctx context.Context, hsid int) err
 	args := m.Called(ctx, hsid)
 	return args.Error(0)
 }
+
+func (m *MockSensorHTTPAPI) SetSensorHTTPVerifySSL(ctx context.Context, hsid int, verify bool) error {
+	args := m.Called(ctx, hsid, verify)
+	return args.Error(0)
+}
+
+func (m *MockSensorHTTPAPI) GetSensorHTTPLatestResult(ctx context.Context, hsid int) (*SensorHTTPResult, error) {
+	args := m.Called(ctx, hsid)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if result, ok := args.Get(0).(*SensorHTTPResult); ok {
+		return result, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockStatusPageAPI is a mock implementation of the StatusPageAPI interface.
+type MockStatusPageAPI struct {
+	mock.Mock
+}
+
+// CreateStatusPage mocks the CreateStatusPage method.
+func (m *MockStatusPageAPI) CreateStatusPage(ctx context.Context, title string, hostIDs []int) (*StatusPage, error) {
+	args := m.Called(ctx, title, hostIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if page, ok := args.Get(0).(*StatusPage); ok {
+		return page, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// GetStatusPage mocks the GetStatusPage method.
+func (m *MockStatusPageAPI) GetStatusPage(ctx context.Context, id int) (*StatusPage, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if page, ok := args.Get(0).(*StatusPage); ok {
+		return page, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// UpdateStatusPage mocks the UpdateStatusPage method.
+func (m *MockStatusPageAPI) UpdateStatusPage(ctx context.Context, id int, title string, hostIDs []int) (*StatusPage, error) {
+	args := m.Called(ctx, id, title, hostIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	if page, ok := args.Get(0).(*StatusPage); ok {
+		return page, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// DeleteStatusPage mocks the DeleteStatusPage method.
+func (m *MockStatusPageAPI) DeleteStatusPage(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// PublishStatusPage mocks the PublishStatusPage method.
+func (m *MockStatusPageAPI) PublishStatusPage(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// UnpublishStatusPage mocks the UnpublishStatusPage method.
+func (m *MockStatusPageAPI) UnpublishStatusPage(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
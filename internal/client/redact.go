@@ -0,0 +1,56 @@
+package client
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Redacted replaces credentials removed from logs and error messages.
+const Redacted = "[REDACTED]"
+
+// redactPatterns match credentials that can appear in request URLs, form bodies,
+// dumped headers and API responses.
+var redactPatterns = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	// The key form value, in query strings and form-encoded bodies
+	{regexp.MustCompile(`(?i)((?:^|[?&\s"'])key=)[^&\s"']*`), "${1}" + Redacted},
+	// Authorization headers, as written on the wire or formatted from an http.Header
+	{regexp.MustCompile(`(?i)(authorization"?\s*[:=]\s*\[?"?)(?:(?:bearer|basic)\s+)?[^\s"\],]+`), "${1}" + Redacted},
+	// Bearer and basic credentials outside of an Authorization header
+	{regexp.MustCompile(`(?i)\b(bearer|basic)\s+[A-Za-z0-9\-._~+/]+=*`), "${1} " + Redacted},
+	// HTTP sensor basic auth passwords, as form values, JSON fields and formatted maps
+	{regexp.MustCompile(`(?i)(httppassword"?\s*[:=]\s*"?)[^&\s"',}\]]+`), "${1}" + Redacted},
+}
+
+// Redact masks the key form value, Authorization header values and HTTP sensor
+// passwords in s.
+func Redact(s string) string {
+	for _, p := range redactPatterns {
+		s = p.pattern.ReplaceAllString(s, p.replacement)
+	}
+	return s
+}
+
+// redact masks credentials in s, including any verbatim occurrence of the client's API key.
+func (c *Client) redact(s string) string {
+	s = Redact(s)
+	if c.apiKey != "" {
+		s = strings.ReplaceAll(s, c.apiKey, Redacted)
+	}
+	return s
+}
+
+// redactError masks credentials in the URL of a *url.Error, which the HTTP
+// client returns for transport failures and which includes the query string of
+// GET requests. The error is modified in place so callers can still unwrap it.
+func (c *Client) redactError(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		urlErr.URL = c.redact(urlErr.URL)
+	}
+	return err
+}
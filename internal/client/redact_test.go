@@ -0,0 +1,192 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "form body",
+			input:    "cmd=getHosts&key=secret-key&response=json",
+			expected: "cmd=getHosts&key=[REDACTED]&response=json",
+		},
+		{
+			name:     "query string",
+			input:    "https://api.wormly.com/?cmd=getHosts&key=secret-key",
+			expected: "https://api.wormly.com/?cmd=getHosts&key=[REDACTED]",
+		},
+		{
+			name:     "key at start",
+			input:    "key=secret-key&cmd=getHosts",
+			expected: "key=[REDACTED]&cmd=getHosts",
+		},
+		{
+			name:     "authorization header",
+			input:    "Authorization: Bearer secret-key",
+			expected: "Authorization: [REDACTED]",
+		},
+		{
+			name:     "formatted header map",
+			input:    "map[Authorization:[Bearer secret-key] User-Agent:[test]]",
+			expected: "map[Authorization:[[REDACTED]] User-Agent:[test]]",
+		},
+		{
+			name:     "bearer token",
+			input:    "token Bearer secret-key rejected",
+			expected: "token Bearer [REDACTED] rejected",
+		},
+		{
+			name:     "sensor password form value",
+			input:    "cmd=addHostSensor_HTTP&httpusername=monitor&httppassword=s3cret&url=x",
+			expected: "cmd=addHostSensor_HTTP&httpusername=monitor&httppassword=[REDACTED]&url=x",
+		},
+		{
+			name:     "sensor password json field",
+			input:    `{"httpusername": "monitor", "httppassword": "s3cret"}`,
+			expected: `{"httpusername": "monitor", "httppassword": "[REDACTED]"}`,
+		},
+		{
+			name:     "sensor password formatted map",
+			input:    "map[httppassword:s3cret httpusername:monitor]",
+			expected: "map[httppassword:[REDACTED] httpusername:monitor]",
+		},
+		{
+			name:     "unrelated parameters",
+			input:    "cmd=getHosts&hostkey=abc&monkey=1",
+			expected: "cmd=getHosts&hostkey=abc&monkey=1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Redact(tt.input); got != tt.expected {
+				t.Errorf("Redact(%q) = %q, expected %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+// recordingLogger captures everything written through the Logger interface.
+type recordingLogger struct {
+	output strings.Builder
+}
+
+func (l *recordingLogger) Printf(format string, v ...interface{}) {
+	fmt.Fprintf(&l.output, format+"\n", v...)
+}
+
+func TestClient_APIKeyNeverExposed(t *testing.T) {
+	const apiKey = "known-secret-api-key"
+
+	// The server echoes the request back, as some error pages do
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "invalid request %s?%s body=%s headers=%v", r.URL.Path, r.URL.RawQuery, body, r.Header)
+	}))
+	defer server.Close()
+
+	closedServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	closedServer.Close()
+
+	tests := []struct {
+		name    string
+		baseURL string
+		request func(ctx context.Context, c *Client) error
+	}{
+		{
+			name:    "POST error body",
+			baseURL: server.URL,
+			request: func(ctx context.Context, c *Client) error {
+				return c.makeFormRequest(ctx, "deleteHost", map[string]string{"hostid": "1"}, nil)
+			},
+		},
+		{
+			name:    "GET error body",
+			baseURL: server.URL,
+			request: func(ctx context.Context, c *Client) error {
+				return c.makeFormRequestGET(ctx, "getHosts", nil, nil)
+			},
+		},
+		{
+			name:    "GET network error",
+			baseURL: closedServer.URL,
+			request: func(ctx context.Context, c *Client) error {
+				return c.makeFormRequestGET(ctx, "getHosts", nil, nil)
+			},
+		},
+		{
+			name:    "Do error body",
+			baseURL: server.URL,
+			request: func(ctx context.Context, c *Client) error {
+				req, err := http.NewRequest("GET", server.URL, nil)
+				if err != nil {
+					return err
+				}
+				resp, err := c.Do(ctx, req)
+				if err != nil {
+					return err
+				}
+				defer resp.Body.Close()
+				body, _ := io.ReadAll(resp.Body)
+				c.DebugLog(ctx, "response: %s", body)
+				return fmt.Errorf("status %d", resp.StatusCode)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := &recordingLogger{}
+			client, err := New(Options{
+				HTTPClient:        &http.Client{},
+				APIKey:            apiKey,
+				BaseURL:           tt.baseURL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 1000.0,
+				MaxRetries:        1,
+				InitialBackoff:    time.Millisecond,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        10 * time.Millisecond,
+				Logger:            logger,
+				DebugEnabled:      true,
+			})
+			if err != nil {
+				t.Fatalf("New() returned error: %v", err)
+			}
+
+			var tflogOutput bytes.Buffer
+			ctx := tflogtest.RootLogger(t.Context(), &tflogOutput)
+
+			err = tt.request(ctx, client)
+			if err == nil {
+				t.Fatal("Expected request to fail")
+			}
+
+			if strings.Contains(err.Error(), apiKey) {
+				t.Errorf("API key exposed in error: %v", err)
+			}
+			if strings.Contains(logger.output.String(), apiKey) {
+				t.Errorf("API key exposed in log output: %s", logger.output.String())
+			}
+			if strings.Contains(tflogOutput.String(), apiKey) {
+				t.Errorf("API key exposed in tflog output: %s", tflogOutput.String())
+			}
+		})
+	}
+}
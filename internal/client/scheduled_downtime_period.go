@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // ScheduledDowntimePeriod represents a Wormly scheduled downtime period.
@@ -66,6 +68,7 @@ type WormlyScheduledDowntimePeriodResponse struct {
 // WormlyGetScheduledDowntimePeriodsResponse represents the API response for getScheduledDowntimePeriods.
 type WormlyGetScheduledDowntimePeriodsResponse struct {
 	ErrorCode int                       `json:"errorcode"`
+	Message   string                    `json:"message,omitempty"`
 	Periods   []ScheduledDowntimePeriod `json:"periods"`
 }
 
@@ -96,14 +99,16 @@ func (c *Client) CreateScheduledDowntimePeriod(ctx context.Context, hostID int,
 		params["on"] = on
 	}
 
+	defer c.downtimePeriods.invalidate(hostID)
+
 	var response WormlyScheduledDowntimePeriodResponse
 	if err := c.makeFormRequest(ctx, "setScheduledDowntimePeriod", params, &response); err != nil {
 		return nil, fmt.Errorf("failed to create scheduled downtime period: %w", err)
 	}
 
 	if response.ErrorCode != 0 {
-		c.DebugLog("CreateScheduledDowntimePeriod API error response: %+v", response)
-		return nil, fmt.Errorf("API returned error code %d: %s", response.ErrorCode, response.Message)
+		c.DebugLog(ctx, "CreateScheduledDowntimePeriod API error response: %+v", response)
+		return nil, mapErrorCode(response.ErrorCode, response.Message)
 	}
 
 	return &ScheduledDowntimePeriod{
@@ -118,8 +123,13 @@ func (c *Client) CreateScheduledDowntimePeriod(ctx context.Context, hostID int,
 }
 
 // GetScheduledDowntimePeriod retrieves a scheduled downtime period by host ID and period ID.
+// Wormly has no command to fetch a single period, so it looks the period up in
+// the host's period list, which is cached briefly to serve reads of other
+// periods on the same host.
 func (c *Client) GetScheduledDowntimePeriod(ctx context.Context, hostID, periodID int) (*ScheduledDowntimePeriod, error) {
-	periods, err := c.GetScheduledDowntimePeriods(ctx, hostID)
+	periods, err := c.downtimePeriods.get(ctx, hostID, func() ([]ScheduledDowntimePeriod, error) {
+		return c.GetScheduledDowntimePeriods(ctx, hostID)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get scheduled downtime periods: %w", err)
 	}
@@ -131,7 +141,11 @@ func (c *Client) GetScheduledDowntimePeriod(ctx context.Context, hostID, periodI
 		}
 	}
 
-	return nil, fmt.Errorf("scheduled downtime period with ID %d not found", periodID)
+	// The period may have been created after the list was cached, so the
+	// next read, such as an eventual consistency retry, fetches it again.
+	c.downtimePeriods.invalidate(hostID)
+
+	return nil, fmt.Errorf("scheduled downtime period with ID %d %w", periodID, ErrNotFound)
 }
 
 // UpdateScheduledDowntimePeriod updates an existing scheduled downtime period.
@@ -150,14 +164,16 @@ func (c *Client) UpdateScheduledDowntimePeriod(ctx context.Context, hostID, peri
 		params["on"] = on
 	}
 
+	defer c.downtimePeriods.invalidate(hostID)
+
 	var response WormlyScheduledDowntimePeriodResponse
 	if err := c.makeFormRequest(ctx, "setScheduledDowntimePeriod", params, &response); err != nil {
 		return nil, fmt.Errorf("failed to update scheduled downtime period: %w", err)
 	}
 
 	if response.ErrorCode != 0 {
-		c.DebugLog("UpdateScheduledDowntimePeriod API error response: %+v", response)
-		return nil, fmt.Errorf("API returned error code %d: %s", response.ErrorCode, response.Message)
+		c.DebugLog(ctx, "UpdateScheduledDowntimePeriod API error response: %+v", response)
+		return nil, mapErrorCode(response.ErrorCode, response.Message)
 	}
 
 	return &ScheduledDowntimePeriod{
@@ -178,13 +194,15 @@ func (c *Client) DeleteScheduledDowntimePeriod(ctx context.Context, hostID, peri
 		"periodid": strconv.Itoa(periodID),
 	}
 
+	defer c.downtimePeriods.invalidate(hostID)
+
 	var response WormlyScheduledDowntimePeriodResponse
 	if err := c.makeFormRequest(ctx, "deleteScheduledDowntimePeriod", params, &response); err != nil {
 		return fmt.Errorf("failed to delete scheduled downtime period: %w", err)
 	}
 
 	if response.ErrorCode != 0 {
-		return fmt.Errorf("API returned error code %d: %s", response.ErrorCode, response.Message)
+		return mapErrorCode(response.ErrorCode, response.Message)
 	}
 
 	return nil
@@ -197,18 +215,47 @@ func (c *Client) GetScheduledDowntimePeriods(ctx context.Context, hostID int) ([
 	}
 
 	var response WormlyGetScheduledDowntimePeriodsResponse
-	if err := c.makeFormRequest(ctx, "getScheduledDowntimePeriods", params, &response); err != nil {
+	if err := c.makeFormRequestGET(ctx, "getScheduledDowntimePeriods", params, &response); err != nil {
 		return nil, fmt.Errorf("failed to get scheduled downtime periods: %w", err)
 	}
 
 	if response.ErrorCode != 0 {
-		return nil, fmt.Errorf("API returned error code %d", response.ErrorCode)
+		return nil, mapErrorCode(response.ErrorCode, response.Message)
 	}
 
 	// Set the HostID for all periods since the API response doesn't include it
 	for i := range response.Periods {
 		response.Periods[i].HostID = hostID
+		response.Periods[i].Recurrence = strings.ToUpper(response.Periods[i].Recurrence)
+		response.Periods[i].On = normalizeDowntimeOn(response.Periods[i].Recurrence, response.Periods[i].On)
 	}
 
 	return response.Periods, nil
 }
+
+// normalizeDowntimeOn canonicalizes the "on" value of a period as returned by
+// the API to the form it is configured in: a capitalized weekday such as
+// "Sunday" for WEEKLY periods, and a day number without leading zeros or
+// "LASTDAY" for MONTHLY ones. Values it does not recognize are returned as is.
+func normalizeDowntimeOn(recurrence, on string) string {
+	trimmed := strings.TrimSpace(on)
+
+	switch recurrence {
+	case "WEEKLY":
+		for day := time.Sunday; day <= time.Saturday; day++ {
+			name := day.String()
+			if strings.EqualFold(trimmed, name) || strings.EqualFold(trimmed, name[:3]) {
+				return name
+			}
+		}
+	case "MONTHLY":
+		if strings.EqualFold(trimmed, "LASTDAY") {
+			return "LASTDAY"
+		}
+		if day, err := strconv.Atoi(trimmed); err == nil && day >= 1 && day <= 31 {
+			return strconv.Itoa(day)
+		}
+	}
+
+	return on
+}
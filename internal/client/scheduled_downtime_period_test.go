@@ -86,14 +86,17 @@ func TestClient_CreateScheduledDowntimePeriod(t *testing.T) {
 			}))
 			defer server.Close()
 
-			client, err := New(
-				&http.Client{Timeout: 30 * time.Second},
-				"test-api-key",
-				server.URL,
-				"test-agent/1.0",
-				10.0, 3, time.Second, 2.0, 30*time.Second,
-				NoOpLogger{}, false,
-			)
+			client, err := New(Options{
+				HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 10.0,
+				MaxRetries:        3,
+				InitialBackoff:    time.Second,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        30 * time.Second,
+			})
 			assert.NoError(err, "Failed to create client")
 
 			result, err := client.CreateScheduledDowntimePeriod(
@@ -128,7 +131,7 @@ func TestClient_GetScheduledDowntimePeriods(t *testing.T) {
 		name           string
 		hostID         int
 		responseBody   string
-		expectedError  bool
+		expectedError  string
 		expectedResult []ScheduledDowntimePeriod
 	}{
 		{
@@ -176,6 +179,29 @@ func TestClient_GetScheduledDowntimePeriods(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:   "normalizes on",
+			hostID: 12345,
+			responseBody: `{
+				"errorcode": 0,
+				"periods": [
+					{"periodid": 1, "start": "01:00", "end": "02:00", "timezone": "GMT", "recurrence": "WEEKLY", "on": "sunday"},
+					{"periodid": 2, "start": "01:00", "end": "02:00", "timezone": "GMT", "recurrence": "weekly", "on": "SAT"},
+					{"periodid": 3, "start": "01:00", "end": "02:00", "timezone": "GMT", "recurrence": "MONTHLY", "on": "05"},
+					{"periodid": 4, "start": "01:00", "end": "02:00", "timezone": "GMT", "recurrence": "MONTHLY", "on": "lastday"},
+					{"periodid": 5, "start": "01:00", "end": "02:00", "timezone": "GMT", "recurrence": "MONTHLY", "on": "32"},
+					{"periodid": 6, "start": "01:00", "end": "02:00", "timezone": "GMT", "recurrence": "ONCEONLY", "on": "2025-12-25"}
+				]
+			}`,
+			expectedResult: []ScheduledDowntimePeriod{
+				{ID: 1, HostID: 12345, Start: "01:00", End: "02:00", Timezone: "GMT", Recurrence: "WEEKLY", On: "Sunday"},
+				{ID: 2, HostID: 12345, Start: "01:00", End: "02:00", Timezone: "GMT", Recurrence: "WEEKLY", On: "Saturday"},
+				{ID: 3, HostID: 12345, Start: "01:00", End: "02:00", Timezone: "GMT", Recurrence: "MONTHLY", On: "5"},
+				{ID: 4, HostID: 12345, Start: "01:00", End: "02:00", Timezone: "GMT", Recurrence: "MONTHLY", On: "LASTDAY"},
+				{ID: 5, HostID: 12345, Start: "01:00", End: "02:00", Timezone: "GMT", Recurrence: "MONTHLY", On: "32"},
+				{ID: 6, HostID: 12345, Start: "01:00", End: "02:00", Timezone: "GMT", Recurrence: "ONCEONLY", On: "2025-12-25"},
+			},
+		},
 		{
 			name:           "empty result",
 			hostID:         12345,
@@ -185,8 +211,8 @@ func TestClient_GetScheduledDowntimePeriods(t *testing.T) {
 		{
 			name:          "API error",
 			hostID:        12345,
-			responseBody:  `{"errorcode": 1}`,
-			expectedError: true,
+			responseBody:  `{"errorcode": 1, "message": "Invalid hostid"}`,
+			expectedError: "API returned error code 1: Invalid hostid",
 		},
 	}
 
@@ -201,20 +227,23 @@ func TestClient_GetScheduledDowntimePeriods(t *testing.T) {
 			}))
 			defer server.Close()
 
-			client, err := New(
-				&http.Client{Timeout: 30 * time.Second},
-				"test-api-key",
-				server.URL,
-				"test-agent/1.0",
-				10.0, 3, time.Second, 2.0, 30*time.Second,
-				NoOpLogger{}, false,
-			)
+			client, err := New(Options{
+				HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 10.0,
+				MaxRetries:        3,
+				InitialBackoff:    time.Second,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        30 * time.Second,
+			})
 			assert.NoError(err, "Failed to create client")
 
 			result, err := client.GetScheduledDowntimePeriods(t.Context(), tt.hostID)
 
-			if tt.expectedError {
-				assert.Error(err, "Expected error but got none")
+			if tt.expectedError != "" {
+				assert.ErrorContains(err, tt.expectedError)
 				return
 			}
 
@@ -235,6 +264,99 @@ func TestClient_GetScheduledDowntimePeriods(t *testing.T) {
 	}
 }
 
+func TestClient_GetScheduledDowntimePeriod_NotFound(t *testing.T) {
+	assert := assert.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("getScheduledDowntimePeriods", r.FormValue("cmd"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"errorcode": 0, "periods": [{"periodid": 123, "start": "22:00", "end": "06:00", "timezone": "GMT", "recurrence": "DAILY", "on": null}]}`)
+	}))
+	defer server.Close()
+
+	client, err := New(Options{
+		HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 10.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+	})
+	assert.NoError(err, "Failed to create client")
+
+	period, err := client.GetScheduledDowntimePeriod(t.Context(), 12345, 456)
+
+	assert.Nil(period)
+	assert.ErrorIs(err, ErrNotFound)
+	assert.EqualError(err, "scheduled downtime period with ID 456 not found")
+}
+
+func TestClient_GetScheduledDowntimePeriod_CachesListPerHost(t *testing.T) {
+	assert := assert.New(t)
+
+	listRequests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("cmd") {
+		case "getScheduledDowntimePeriods":
+			listRequests[r.FormValue("hostid")]++
+			fmt.Fprint(w, `{"errorcode": 0, "periods": [
+				{"periodid": 123, "start": "22:00", "end": "06:00", "timezone": "GMT", "recurrence": "DAILY", "on": null},
+				{"periodid": 456, "start": "01:00", "end": "02:00", "timezone": "GMT", "recurrence": "WEEKLY", "on": "SUN"}
+			]}`)
+		case "deleteScheduledDowntimePeriod":
+			fmt.Fprint(w, `{"errorcode": 0}`)
+		default:
+			t.Errorf("Unexpected command %q", r.FormValue("cmd"))
+		}
+	}))
+	defer server.Close()
+
+	client, err := New(Options{
+		HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 10.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+	})
+	assert.NoError(err, "Failed to create client")
+
+	first, err := client.GetScheduledDowntimePeriod(t.Context(), 12345, 123)
+	assert.NoError(err)
+	second, err := client.GetScheduledDowntimePeriod(t.Context(), 12345, 456)
+	assert.NoError(err)
+	assert.Equal(123, first.ID)
+	assert.Equal(456, second.ID)
+	assert.Equal(1, listRequests["12345"], "Expected both reads to share one list fetch")
+
+	_, err = client.GetScheduledDowntimePeriod(t.Context(), 67890, 123)
+	assert.NoError(err)
+	assert.Equal(1, listRequests["67890"], "Expected another host to be fetched separately")
+
+	assert.NoError(client.DeleteScheduledDowntimePeriod(t.Context(), 12345, 456))
+	_, err = client.GetScheduledDowntimePeriod(t.Context(), 12345, 123)
+	assert.NoError(err)
+	assert.Equal(2, listRequests["12345"], "Expected a write to invalidate the cached list")
+
+	_, err = client.GetScheduledDowntimePeriod(t.Context(), 12345, 789)
+	assert.ErrorIs(err, ErrNotFound)
+	_, err = client.GetScheduledDowntimePeriod(t.Context(), 12345, 123)
+	assert.NoError(err)
+	assert.Equal(3, listRequests["12345"], "Expected a missing period to invalidate the cached list")
+
+	client.downtimePeriods.entries[12345].fetchedAt = time.Now().Add(-hostCacheTTL - time.Second)
+	_, err = client.GetScheduledDowntimePeriod(t.Context(), 12345, 123)
+	assert.NoError(err)
+	assert.Equal(4, listRequests["12345"], "Expected an expired list to be fetched again")
+}
+
 func TestClient_DeleteScheduledDowntimePeriod(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -269,14 +391,17 @@ func TestClient_DeleteScheduledDowntimePeriod(t *testing.T) {
 			}))
 			defer server.Close()
 
-			client, err := New(
-				&http.Client{Timeout: 30 * time.Second},
-				"test-api-key",
-				server.URL,
-				"test-agent/1.0",
-				10.0, 3, time.Second, 2.0, 30*time.Second,
-				NoOpLogger{}, false,
-			)
+			client, err := New(Options{
+				HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 10.0,
+				MaxRetries:        3,
+				InitialBackoff:    time.Second,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        30 * time.Second,
+			})
 			assert.NoError(err, "Failed to create client")
 
 			err = client.DeleteScheduledDowntimePeriod(t.Context(), tt.hostID, tt.periodID)
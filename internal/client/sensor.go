@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// WormlySensorResponse represents the API response for commands that act on a
+// sensor of any type.
+type WormlySensorResponse struct {
+	ErrorCode int    `json:"errorcode"`
+	Message   string `json:"message,omitempty"`
+}
+
+// SensorAPI defines the interface for commands that act on a sensor of any type.
+type SensorAPI interface {
+	EnableSensor(ctx context.Context, hsid int) error
+	DisableSensor(ctx context.Context, hsid int) error
+	AcknowledgeSensor(ctx context.Context, hsid int) error
+}
+
+// Ensure Client implements SensorAPI.
+var _ SensorAPI = (*Client)(nil)
+
+// EnableSensor enables a sensor of any type by HSID (HostSensorID).
+func (c *Client) EnableSensor(ctx context.Context, hsid int) error {
+	return c.sensorCommand(ctx, "enableSensor", "enable", hsid)
+}
+
+// DisableSensor disables a sensor of any type by HSID (HostSensorID).
+func (c *Client) DisableSensor(ctx context.Context, hsid int) error {
+	return c.sensorCommand(ctx, "disableSensor", "disable", hsid)
+}
+
+// AcknowledgeSensor acknowledges the current error of a sensor of any type by
+// HSID, which stops further alerts for it until the sensor recovers. It has no
+// effect on a sensor that is not failing.
+func (c *Client) AcknowledgeSensor(ctx context.Context, hsid int) error {
+	return c.sensorCommand(ctx, "acknowledgeSensorError", "acknowledge", hsid)
+}
+
+// sensorCommand sends a command that acts on a single sensor; action describes
+// it in errors.
+func (c *Client) sensorCommand(ctx context.Context, command, action string, hsid int) error {
+	params := map[string]string{
+		"hsid": strconv.Itoa(hsid),
+	}
+
+	var response WormlySensorResponse
+	if err := c.makeFormRequest(ctx, command, params, &response); err != nil {
+		return fmt.Errorf("failed to %s sensor %d: %w", action, hsid, err)
+	}
+
+	if response.ErrorCode != 0 {
+		return mapErrorCode(response.ErrorCode, response.Message)
+	}
+
+	return nil
+}
@@ -3,52 +3,99 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
+// SensorHTTPMethods lists the HTTP methods an HTTP sensor can check with.
+var SensorHTTPMethods = []string{http.MethodGet, http.MethodPost, http.MethodHead, http.MethodPut}
+
+// Content types the body of a POST check can be sent as.
+const (
+	// SensorHTTPPostContentTypeForm sends post params form-encoded. It is the API default.
+	SensorHTTPPostContentTypeForm = "application/x-www-form-urlencoded"
+	// SensorHTTPPostContentTypeJSON sends post params as a raw JSON body.
+	SensorHTTPPostContentTypeJSON = "application/json"
+)
+
+// SensorHTTPPostContentTypes lists the content types a POST body can be sent as.
+var SensorHTTPPostContentTypes = []string{SensorHTTPPostContentTypeForm, SensorHTTPPostContentTypeJSON}
+
 // SensorHTTP represents a Wormly HTTP sensor.
 type SensorHTTP struct {
-	ID                   int       `json:"id"`
-	HostID               int       `json:"hostid"`
-	URL                  string    `json:"url"`
-	NiceName             string    `json:"nicename"`
-	Enabled              bool      `json:"enabled"`
-	Timeout              int       `json:"timeout"`
-	ResponseCode         string    `json:"responsecode"`
-	VerifySSLCert        bool      `json:"verifysslcert"`
-	SearchHeaders        bool      `json:"searchheaders"`
-	ExpectedText         string    `json:"expectedtext"`
-	UnwantedText         string    `json:"unwantedtext"`
-	SSLValidity          int       `json:"sslvalidity"`
-	Cookies              string    `json:"cookies"`
-	PostParams           string    `json:"postparams"`
-	CustomRequestHeaders string    `json:"customrequestheaders"`
-	UserAgent            string    `json:"useragent"`
-	ForceResolve         string    `json:"forceresolve"`
-	CreatedAt            time.Time `json:"created_at"`
-	UpdatedAt            time.Time `json:"updated_at"`
+	ID            int    `json:"id"`
+	HostID        int    `json:"hostid"`
+	URL           string `json:"url"`
+	NiceName      string `json:"nicename"`
+	Enabled       bool   `json:"enabled"`
+	Timeout       int    `json:"timeout"`
+	ResponseCode  string `json:"responsecode"`
+	VerifySSLCert bool   `json:"verifysslcert"`
+	SearchHeaders bool   `json:"searchheaders"`
+	ExpectedText  string `json:"expectedtext"`
+	// ExpectedTextIsRegex is whether ExpectedText is matched as a regular expression rather than literally.
+	ExpectedTextIsRegex  bool   `json:"expectedtextregex"`
+	UnwantedText         string `json:"unwantedtext"`
+	SSLValidity          int    `json:"sslvalidity"`
+	Cookies              string `json:"cookies"`
+	PostParams           string `json:"postparams"`
+	PostContentType      string `json:"postcontenttype"` // Empty when the API default (form-encoded) applies
+	CustomRequestHeaders string `json:"customrequestheaders"`
+	UserAgent            string `json:"useragent"`
+	ForceResolve         string `json:"forceresolve"`
+	// HTTPMethod is the method checks are made with; empty when Wormly chooses,
+	// which is GET unless PostParams is set.
+	HTTPMethod string `json:"method"`
+	// AuthUsername and AuthPassword are the HTTP basic auth credentials sent with each check.
+	AuthUsername string `json:"httpusername"`
+	AuthPassword string `json:"httppassword"`
+	// TestLocations are the codes of the locations the sensor is checked from; empty when Wormly chooses.
+	TestLocations []string `json:"locations"`
+	// FollowRedirects is whether checks follow 3xx redirects; nil when the API default applies.
+	FollowRedirects *bool `json:"followredirects"`
+	// AlertAfterFailures is how many consecutive failures trigger an alert; 0 when the API default applies.
+	AlertAfterFailures int       `json:"failsbeforenotify"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+
+	// ReturnedParams records which parameters getHostSensors included for the
+	// sensor, keyed by request parameter name (e.g. "cookies"). It tells a value
+	// the API cleared apart from one it never echoes back, and is nil when the
+	// sensor was not read from getHostSensors.
+	ReturnedParams map[string]bool `json:"-"`
 }
 
 // SensorHTTPCreateRequest represents the request payload for creating an HTTP sensor.
 type SensorHTTPCreateRequest struct {
-	HostID               int    `json:"hostid"`
-	URL                  string `json:"url"`
-	NiceName             string `json:"nicename,omitempty"`
-	Timeout              int    `json:"timeout,omitempty"`
-	ResponseCode         string `json:"responsecode,omitempty"`
-	VerifySSLCert        bool   `json:"verifysslcert,omitempty"`
-	SearchHeaders        bool   `json:"searchheaders,omitempty"`
-	ExpectedText         string `json:"expectedtext,omitempty"`
-	UnwantedText         string `json:"unwantedtext,omitempty"`
-	SSLValidity          int    `json:"sslvalidity,omitempty"`
-	Cookies              string `json:"cookies,omitempty"`
-	PostParams           string `json:"postparams,omitempty"`
-	CustomRequestHeaders string `json:"customrequestheaders,omitempty"`
-	UserAgent            string `json:"useragent,omitempty"`
-	ForceResolve         string `json:"forceresolve,omitempty"`
+	HostID               int      `json:"hostid"`
+	URL                  string   `json:"url"`
+	NiceName             string   `json:"nicename,omitempty"`
+	Timeout              int      `json:"timeout,omitempty"`
+	ResponseCode         string   `json:"responsecode,omitempty"`
+	VerifySSLCert        bool     `json:"verifysslcert,omitempty"`
+	SearchHeaders        bool     `json:"searchheaders,omitempty"`
+	ExpectedText         string   `json:"expectedtext,omitempty"`
+	ExpectedTextIsRegex  bool     `json:"expectedtextregex,omitempty"`
+	UnwantedText         string   `json:"unwantedtext,omitempty"`
+	SSLValidity          int      `json:"sslvalidity,omitempty"`
+	Cookies              string   `json:"cookies,omitempty"`
+	PostParams           string   `json:"postparams,omitempty"`
+	PostContentType      string   `json:"postcontenttype,omitempty"`
+	CustomRequestHeaders string   `json:"customrequestheaders,omitempty"`
+	UserAgent            string   `json:"useragent,omitempty"`
+	ForceResolve         string   `json:"forceresolve,omitempty"`
+	HTTPMethod           string   `json:"method,omitempty"`
+	AuthUsername         string   `json:"httpusername,omitempty"`
+	AuthPassword         string   `json:"httppassword,omitempty"`
+	TestLocations        []string `json:"locations,omitempty"`
+	FollowRedirects      *bool    `json:"followredirects,omitempty"`
+	AlertAfterFailures   int      `json:"failsbeforenotify,omitempty"`
 }
 
 // WormlyHTTPSensorResponse represents the API response for HTTP sensor operations.
@@ -60,24 +107,69 @@ type WormlyHTTPSensorResponse struct {
 
 // WormlyHTTPSensorListResponse represents the API response for getHostSensors.
 type WormlyHTTPSensorListResponse struct {
-	ErrorCode int `json:"errorcode"`
-	Sensors   []struct {
-		HSID     string      `json:"hsid"`     // The HostSensorID of the sensor (returned as string)
-		SensorID string      `json:"sensorid"` // The ID of the sensor type (returned as string)
-		Enabled  string      `json:"enabled"`  // Whether this sensor is enabled for testing (returned as string)
-		NiceName string      `json:"nicename"` // The (optional) nicename for this sensor (API docs incorrectly say "nickname", actual response uses "nicename")
-		Params   interface{} `json:"params"`   // Sensor parameters (can be object or string)
-	} `json:"sensors"`
+	ErrorCode int                `json:"errorcode"`
+	Message   string             `json:"message,omitempty"`
+	Sensors   []WormlyHostSensor `json:"sensors"`
+	NextPage  interface{}        `json:"nextpage,omitempty"` // Page to request next (string or number); absent, empty or 0 on the last page
+}
+
+// WormlyHostSensorResponse represents the API response for getHostSensor.
+type WormlyHostSensorResponse struct {
+	ErrorCode int               `json:"errorcode"`
+	Message   string            `json:"message,omitempty"`
+	Sensor    *WormlyHostSensor `json:"sensor"`
+}
+
+// WormlyHostSensor represents a sensor in the getHostSensors response.
+type WormlyHostSensor struct {
+	HSID     string      `json:"hsid"`     // The HostSensorID of the sensor (returned as string)
+	SensorID string      `json:"sensorid"` // The ID of the sensor type (returned as string)
+	Enabled  string      `json:"enabled"`  // Whether this sensor is enabled for testing (returned as string)
+	NiceName string      `json:"nicename"` // The (optional) nicename for this sensor (API docs incorrectly say "nickname", actual response uses "nicename")
+	Params   interface{} `json:"params"`   // Sensor parameters (can be object or string)
+}
+
+// WormlyHTTPSensorParamsResponse represents the API response for getSensorParams.
+type WormlyHTTPSensorParamsResponse struct {
+	ErrorCode int         `json:"errorcode"`
+	Message   string      `json:"message,omitempty"`
+	Params    interface{} `json:"params"` // Sensor parameters (can be object or string)
+}
+
+// SensorHTTPResult represents the outcome of the latest check performed by an HTTP sensor.
+type SensorHTTPResult struct {
+	HSID      int        `json:"hsid"`
+	CheckedAt time.Time  `json:"checked_at"`
+	SSLIssuer string     `json:"ssl_issuer"`
+	SSLExpiry *time.Time `json:"ssl_expiry"` // Nil when the check didn't negotiate TLS
+}
+
+// WormlySensorResultsResponse represents the API response for getSensorResults.
+type WormlySensorResultsResponse struct {
+	ErrorCode int    `json:"errorcode"`
+	Message   string `json:"message,omitempty"`
+	Results   []struct {
+		Time int64 `json:"time"` // Unix timestamp of the check
+		SSL  *struct {
+			Issuer  string `json:"issuer"`
+			Expires int64  `json:"expires"` // Unix timestamp of the certificate expiry
+		} `json:"ssl"`
+	} `json:"results"`
 }
 
 // SensorHTTPAPI defines the interface for HTTP sensor-related operations.
 type SensorHTTPAPI interface {
 	CreateSensorHTTP(ctx context.Context, req *SensorHTTPCreateRequest) (*SensorHTTP, error)
+	CreateSensorsHTTPBatch(ctx context.Context, reqs []*SensorHTTPCreateRequest) ([]*SensorHTTP, error)
 	GetSensorHTTP(ctx context.Context, hostID, sensorID int) (*SensorHTTP, error)
 	DeleteSensorHTTP(ctx context.Context, sensorID int) error
 	ListSensorHTTP(ctx context.Context, hostID int) ([]*SensorHTTP, error)
+	ListSensorsHTTPBatch(ctx context.Context, hostIDs []int) ([][]*SensorHTTP, error)
+	FindSensorHost(ctx context.Context, sensorID int) (int, error)
 	EnableSensorHTTP(ctx context.Context, hsid int) error
 	DisableSensorHTTP(ctx context.Context, hsid int) error
+	SetSensorHTTPVerifySSL(ctx context.Context, hsid int, verify bool) error
+	GetSensorHTTPLatestResult(ctx context.Context, hsid int) (*SensorHTTPResult, error)
 }
 
 // Ensure Client implements SensorHTTPAPI.
@@ -113,6 +205,11 @@ func (c *Client) CreateSensorHTTP(ctx context.Context, req *SensorHTTPCreateRequ
 	if req.ExpectedText != "" {
 		params["expectedtext"] = req.ExpectedText
 	}
+	if req.ExpectedTextIsRegex {
+		params["expectedtextregex"] = "1"
+	} else {
+		params["expectedtextregex"] = "0"
+	}
 	if req.UnwantedText != "" {
 		params["unwantedtext"] = req.UnwantedText
 	}
@@ -125,6 +222,9 @@ func (c *Client) CreateSensorHTTP(ctx context.Context, req *SensorHTTPCreateRequ
 	if req.PostParams != "" {
 		params["postparams"] = req.PostParams
 	}
+	if req.PostContentType != "" {
+		params["postcontenttype"] = req.PostContentType
+	}
 	if req.CustomRequestHeaders != "" {
 		params["customrequestheaders"] = req.CustomRequestHeaders
 	}
@@ -134,6 +234,27 @@ func (c *Client) CreateSensorHTTP(ctx context.Context, req *SensorHTTPCreateRequ
 	if req.ForceResolve != "" {
 		params["forceresolve"] = req.ForceResolve
 	}
+	if req.HTTPMethod != "" {
+		params["method"] = req.HTTPMethod
+	}
+	if req.AuthUsername != "" {
+		params["httpusername"] = req.AuthUsername
+	}
+	if req.AuthPassword != "" {
+		params["httppassword"] = req.AuthPassword
+	}
+	if len(req.TestLocations) > 0 {
+		params["locations"] = strings.Join(req.TestLocations, ",")
+	}
+	if req.FollowRedirects != nil {
+		params["followredirects"] = "0"
+		if *req.FollowRedirects {
+			params["followredirects"] = "1"
+		}
+	}
+	if req.AlertAfterFailures > 0 {
+		params["failsbeforenotify"] = strconv.Itoa(req.AlertAfterFailures)
+	}
 
 	var response WormlyHTTPSensorResponse
 	if err := c.makeFormRequest(ctx, "addHostSensor_HTTP", params, &response); err != nil {
@@ -141,7 +262,7 @@ func (c *Client) CreateSensorHTTP(ctx context.Context, req *SensorHTTPCreateRequ
 	}
 
 	if response.ErrorCode != 0 {
-		return nil, fmt.Errorf("API returned error code %d: %s", response.ErrorCode, response.Message)
+		return nil, mapErrorCode(response.ErrorCode, response.Message)
 	}
 
 	return &SensorHTTP{
@@ -155,58 +276,206 @@ func (c *Client) CreateSensorHTTP(ctx context.Context, req *SensorHTTPCreateRequ
 		VerifySSLCert:        req.VerifySSLCert,
 		SearchHeaders:        req.SearchHeaders,
 		ExpectedText:         req.ExpectedText,
+		ExpectedTextIsRegex:  req.ExpectedTextIsRegex,
 		UnwantedText:         req.UnwantedText,
 		SSLValidity:          req.SSLValidity,
 		Cookies:              req.Cookies,
 		PostParams:           req.PostParams,
+		PostContentType:      req.PostContentType,
 		CustomRequestHeaders: req.CustomRequestHeaders,
 		UserAgent:            req.UserAgent,
 		ForceResolve:         req.ForceResolve,
+		HTTPMethod:           req.HTTPMethod,
+		AuthUsername:         req.AuthUsername,
+		AuthPassword:         req.AuthPassword,
+		TestLocations:        req.TestLocations,
+		FollowRedirects:      req.FollowRedirects,
+		AlertAfterFailures:   req.AlertAfterFailures,
 		CreatedAt:            time.Now(),
 		UpdatedAt:            time.Now(),
 	}, nil
 }
 
+// CreateSensorsHTTPBatch creates several HTTP sensors, keeping up to the rate
+// limiter's burst of creates in flight so the batch runs as fast as the limiter allows.
+//
+// The returned slice is aligned with reqs and holds nil for every sensor that was
+// not created. Once a create fails no further creates are started, but creates
+// already in flight are allowed to finish so their sensors are still reported.
+// The error joins every failure, plus one for the creates that were skipped.
+func (c *Client) CreateSensorsHTTPBatch(ctx context.Context, reqs []*SensorHTTPCreateRequest) ([]*SensorHTTP, error) {
+	sensors := make([]*SensorHTTP, len(reqs))
+	errs := make([]error, len(reqs), len(reqs)+1)
+
+	var (
+		wg      sync.WaitGroup
+		failed  atomic.Bool
+		started int
+	)
+	slots := make(chan struct{}, max(c.limiter.Burst(), 1))
+	for i, req := range reqs {
+		slots <- struct{}{}
+		if failed.Load() || ctx.Err() != nil {
+			<-slots
+			break
+		}
+
+		started++
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			sensor, err := c.CreateSensorHTTP(ctx, req)
+			if err != nil {
+				errs[i] = fmt.Errorf("sensor %d (%s): %w", i, req.URL, err)
+				failed.Store(true)
+				return
+			}
+			sensors[i] = sensor
+		}()
+	}
+	wg.Wait()
+
+	if skipped := len(reqs) - started; skipped > 0 {
+		errs = append(errs, fmt.Errorf("%d of %d HTTP sensors were not attempted after an earlier failure", skipped, len(reqs)))
+	}
+
+	return sensors, errors.Join(errs...)
+}
+
+// ListSensorsHTTPBatch lists the HTTP sensors of several hosts, keeping up to
+// the rate limiter's burst of lists in flight like CreateSensorsHTTPBatch.
+//
+// The returned slice is aligned with hostIDs. Once a list fails no further
+// lists are started, and the error joins every failure.
+func (c *Client) ListSensorsHTTPBatch(ctx context.Context, hostIDs []int) ([][]*SensorHTTP, error) {
+	sensors := make([][]*SensorHTTP, len(hostIDs))
+	errs := make([]error, len(hostIDs), len(hostIDs)+1)
+
+	var (
+		wg      sync.WaitGroup
+		failed  atomic.Bool
+		started int
+	)
+	slots := make(chan struct{}, max(c.limiter.Burst(), 1))
+	for i, hostID := range hostIDs {
+		slots <- struct{}{}
+		if failed.Load() || ctx.Err() != nil {
+			<-slots
+			break
+		}
+
+		started++
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			hostSensors, err := c.ListSensorHTTP(ctx, hostID)
+			if err != nil {
+				errs[i] = fmt.Errorf("host %d: %w", hostID, err)
+				failed.Store(true)
+				return
+			}
+			sensors[i] = hostSensors
+		}()
+	}
+	wg.Wait()
+
+	if skipped := len(hostIDs) - started; skipped > 0 {
+		errs = append(errs, fmt.Errorf("%d of %d hosts were not listed after an earlier failure", skipped, len(hostIDs)))
+	}
+
+	return sensors, errors.Join(errs...)
+}
+
 // GetSensorHTTP retrieves an HTTP sensor by host ID and sensor ID.
+//
+// The sensor is fetched on its own with getHostSensor. When that request fails
+// for any reason other than the context ending, the sensor is looked up in the
+// getHostSensors list instead, so a missing sensor is still reported as
+// ErrNotFound. When getHostSensor fails other than with an API errorcode about
+// the sensor, such as an unknown command or an HTTP 404, it is treated as
+// unavailable and later reads go straight to the list. A sensor of another type
+// is reported as ErrNotFound.
 func (c *Client) GetSensorHTTP(ctx context.Context, hostID, sensorID int) (*SensorHTTP, error) {
-	params := map[string]string{
-		"hostid": strconv.Itoa(hostID),
+	if !c.singleSensorFetchUnavailable.Load() {
+		sensor, err := c.getHostSensor(ctx, hostID, sensorID)
+		var apiErr *APIError
+		switch {
+		case err == nil:
+			if sensor.SensorID != SensorTypeHTTP {
+				return nil, fmt.Errorf("HTTP sensor with ID %d %w for host %d (sensor type %s)", sensorID, ErrNotFound, hostID, sensor.SensorID)
+			}
+			return c.convertHostSensorToHTTP(ctx, *sensor, hostID)
+		case ctx.Err() != nil:
+			return nil, fmt.Errorf("failed to get HTTP sensor: %w", err)
+		case errors.Is(err, ErrUnknownCommand):
+			c.DebugLog(ctx, "getHostSensor is not available, falling back to getHostSensors")
+			c.singleSensorFetchUnavailable.Store(true)
+		case errors.As(err, &apiErr), errors.Is(err, ErrNotFound):
+			c.DebugLog(ctx, "getHostSensor failed for HSID %d, falling back to getHostSensors: %v", sensorID, err)
+		default:
+			c.DebugLog(ctx, "getHostSensor failed for HSID %d, treating it as unavailable and falling back to getHostSensors: %v", sensorID, err)
+			c.singleSensorFetchUnavailable.Store(true)
+		}
 	}
 
-	var response WormlyHTTPSensorListResponse
-	if err := c.makeFormRequest(ctx, "getHostSensors", params, &response); err != nil {
+	sensors, err := c.getHostSensors(ctx, hostID)
+	if err != nil {
 		return nil, fmt.Errorf("failed to get HTTP sensor: %w", err)
 	}
 
-	if response.ErrorCode != 0 {
-		return nil, fmt.Errorf("API returned error code %d", response.ErrorCode)
-	}
-
 	// Find the specific sensor by HSID (HostSensorID)
-	for _, sensor := range response.Sensors {
+	for _, sensor := range sensors {
+		if sensor.SensorID != SensorTypeHTTP {
+			continue
+		}
 		// Convert string HSID to int for comparison
 		hsid, err := strconv.Atoi(sensor.HSID)
 		if err != nil {
 			continue // Skip sensors with invalid HSID
 		}
 		if hsid == sensorID {
-			return convertBasicSensorToHTTP(sensor, hostID)
+			return c.convertHostSensorToHTTP(ctx, sensor, hostID)
 		}
 	}
 
-	return nil, fmt.Errorf("HTTP sensor with ID %d not found for host %d", sensorID, hostID)
+	return nil, fmt.Errorf("HTTP sensor with ID %d %w for host %d", sensorID, ErrNotFound, hostID)
+}
+
+// convertHostSensorToHTTP converts a sensor returned by the API to a SensorHTTP,
+// fetching its params separately when the response left them out.
+func (c *Client) convertHostSensorToHTTP(ctx context.Context, sensor WormlyHostSensor, hostID int) (*SensorHTTP, error) {
+	if sensorParamsMissing(sensor.Params) {
+		params, err := c.getSensorParams(ctx, sensor.HSID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get HTTP sensor params (HSID: %s): %w", sensor.HSID, err)
+		}
+		sensor.Params = params
+	}
+	return convertBasicSensorToHTTP(sensor, hostID)
 }
 
 // DeleteSensorHTTP deletes an HTTP sensor by ID.
 // Note: The sensorID parameter should be the HSID (HostSensorID) value.
 func (c *Client) DeleteSensorHTTP(ctx context.Context, sensorID int) error {
+	if err := c.deleteSensor(ctx, sensorID); err != nil {
+		return fmt.Errorf("failed to delete HTTP sensor: %w", err)
+	}
+	return nil
+}
+
+// deleteSensor deletes a sensor of any type by HSID.
+func (c *Client) deleteSensor(ctx context.Context, hsid int) error {
 	params := map[string]string{
-		"hsid": strconv.Itoa(sensorID), // API expects hsid (HostSensorID)
+		"hsid": strconv.Itoa(hsid), // API expects hsid (HostSensorID)
 	}
 
 	var response WormlyHTTPSensorResponse
 	if err := c.makeFormRequest(ctx, "deleteSensor", params, &response); err != nil {
-		return fmt.Errorf("failed to delete HTTP sensor: %w", err)
+		return err
 	}
 
 	if response.ErrorCode != 0 {
@@ -216,27 +485,59 @@ func (c *Client) DeleteSensorHTTP(ctx context.Context, sensorID int) error {
 	return nil
 }
 
-// ListSensorHTTP lists all HTTP sensors for a given host ID.
-func (c *Client) ListSensorHTTP(ctx context.Context, hostID int) ([]*SensorHTTP, error) {
-	params := map[string]string{
-		"hostid": strconv.Itoa(hostID),
+// FindSensorHost returns the ID of the host an HTTP sensor belongs to. The
+// sensorID is the HSID (HostSensorID). Every host is searched, so this costs
+// one request per host.
+func (c *Client) FindSensorHost(ctx context.Context, sensorID int) (int, error) {
+	hosts, err := c.ListHosts(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find host of sensor %d: %w", sensorID, err)
 	}
 
-	var response WormlyHTTPSensorListResponse
-	if err := c.makeFormRequest(ctx, "getHostSensors", params, &response); err != nil {
-		return nil, fmt.Errorf("failed to list HTTP sensors: %w", err)
+	hsid := strconv.Itoa(sensorID)
+	for _, host := range hosts {
+		sensors, err := c.getHostSensors(ctx, host.ID)
+		if err != nil {
+			return 0, fmt.Errorf("failed to list sensors of host %d: %w", host.ID, err)
+		}
+
+		for _, sensor := range sensors {
+			if sensor.HSID != hsid {
+				continue
+			}
+			if sensor.SensorID != SensorTypeHTTP {
+				return 0, fmt.Errorf("sensor %d on host %d is not an HTTP sensor (sensor type %s)", sensorID, host.ID, sensor.SensorID)
+			}
+			return host.ID, nil
+		}
 	}
 
-	if response.ErrorCode != 0 {
-		return nil, fmt.Errorf("API returned error code %d", response.ErrorCode)
+	return 0, fmt.Errorf("HTTP sensor with ID %d %w on any of %d hosts", sensorID, ErrNotFound, len(hosts))
+}
+
+// ListSensorHTTP lists all HTTP sensors for a given host ID.
+func (c *Client) ListSensorHTTP(ctx context.Context, hostID int) ([]*SensorHTTP, error) {
+	sensors, err := c.getHostSensors(ctx, hostID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list HTTP sensors: %w", err)
 	}
 
 	var httpSensors []*SensorHTTP
-	for _, sensor := range response.Sensors {
+	for _, sensor := range sensors {
 		if sensor.SensorID != SensorTypeHTTP {
 			continue
 		}
 
+		// getHostSensors omits params for some sensors (typically disabled ones),
+		// so fetch them individually to avoid surfacing empty settings.
+		if sensorParamsMissing(sensor.Params) {
+			params, err := c.getSensorParams(ctx, sensor.HSID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get params for sensor (HSID: %s): %w", sensor.HSID, err)
+			}
+			sensor.Params = params
+		}
+
 		httpSensor, err := convertBasicSensorToHTTP(sensor, hostID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to convert sensor (HSID: %s): %w", sensor.HSID, err)
@@ -247,15 +548,69 @@ func (c *Client) ListSensorHTTP(ctx context.Context, hostID int) ([]*SensorHTTP,
 	return httpSensors, nil
 }
 
+// getHostSensors returns every sensor of a host, of any type. Hosts with many
+// sensors are paginated, so pages are requested until the API stops returning
+// a next page.
+func (c *Client) getHostSensors(ctx context.Context, hostID int) ([]WormlyHostSensor, error) {
+	params := map[string]string{
+		"hostid": strconv.Itoa(hostID),
+	}
+
+	var sensors []WormlyHostSensor
+	requested := make(map[string]bool)
+	for {
+		var response WormlyHTTPSensorListResponse
+		if err := c.makeFormRequestGET(ctx, "getHostSensors", params, &response); err != nil {
+			return nil, err
+		}
+
+		if response.ErrorCode != 0 {
+			return nil, fmt.Errorf("API returned error code %d: %s", response.ErrorCode, response.Message)
+		}
+
+		sensors = append(sensors, response.Sensors...)
+
+		nextPage, _ := paramString(response.NextPage)
+		if nextPage == "" || nextPage == "0" {
+			return sensors, nil
+		}
+		// Guard against a cursor that never advances
+		if requested[nextPage] {
+			return nil, fmt.Errorf("getHostSensors returned page %s more than once", nextPage)
+		}
+		requested[nextPage] = true
+
+		params = map[string]string{
+			"hostid": strconv.Itoa(hostID),
+			"page":   nextPage,
+		}
+	}
+}
+
 // EnableSensorHTTP enables an HTTP sensor by HSID.
 func (c *Client) EnableSensorHTTP(ctx context.Context, hsid int) error {
+	return c.EnableSensor(ctx, hsid)
+}
+
+// DisableSensorHTTP disables an HTTP sensor by HSID.
+func (c *Client) DisableSensorHTTP(ctx context.Context, hsid int) error {
+	return c.DisableSensor(ctx, hsid)
+}
+
+// SetSensorHTTPVerifySSL turns SSL certificate verification of an HTTP sensor on
+// or off by HSID. It is the only setting that can change without recreating the sensor.
+func (c *Client) SetSensorHTTPVerifySSL(ctx context.Context, hsid int, verify bool) error {
 	params := map[string]string{
-		"hsid": strconv.Itoa(hsid),
+		"hsid":          strconv.Itoa(hsid),
+		"verifysslcert": "0",
+	}
+	if verify {
+		params["verifysslcert"] = "1"
 	}
 
 	var response WormlyHTTPSensorResponse
-	if err := c.makeFormRequest(ctx, "enableSensor", params, &response); err != nil {
-		return fmt.Errorf("failed to enable HTTP sensor: %w", err)
+	if err := c.makeFormRequest(ctx, "setSensorParams", params, &response); err != nil {
+		return fmt.Errorf("failed to set SSL verification of HTTP sensor: %w", err)
 	}
 
 	if response.ErrorCode != 0 {
@@ -265,194 +620,361 @@ func (c *Client) EnableSensorHTTP(ctx context.Context, hsid int) error {
 	return nil
 }
 
-// DisableSensorHTTP disables an HTTP sensor by HSID.
-func (c *Client) DisableSensorHTTP(ctx context.Context, hsid int) error {
+// GetSensorHTTPLatestResult retrieves the latest check result of an HTTP sensor by HSID.
+// It returns nil without error when the sensor has never been checked.
+func (c *Client) GetSensorHTTPLatestResult(ctx context.Context, hsid int) (*SensorHTTPResult, error) {
 	params := map[string]string{
-		"hsid": strconv.Itoa(hsid),
+		"hsid":  strconv.Itoa(hsid),
+		"limit": "1",
 	}
 
-	var response WormlyHTTPSensorResponse
-	if err := c.makeFormRequest(ctx, "disableSensor", params, &response); err != nil {
-		return fmt.Errorf("failed to disable HTTP sensor: %w", err)
+	var response WormlySensorResultsResponse
+	if err := c.makeFormRequestGET(ctx, "getSensorResults", params, &response); err != nil {
+		return nil, fmt.Errorf("failed to get HTTP sensor results: %w", err)
 	}
 
 	if response.ErrorCode != 0 {
-		return fmt.Errorf("API returned error code %d: %s", response.ErrorCode, response.Message)
+		return nil, fmt.Errorf("API returned error code %d: %s", response.ErrorCode, response.Message)
 	}
 
-	return nil
+	if len(response.Results) == 0 {
+		return nil, nil
+	}
+
+	latest := response.Results[0]
+	result := &SensorHTTPResult{
+		HSID:      hsid,
+		CheckedAt: time.Unix(latest.Time, 0).UTC(),
+	}
+	if latest.SSL != nil && latest.SSL.Expires > 0 {
+		expiry := time.Unix(latest.SSL.Expires, 0).UTC()
+		result.SSLIssuer = latest.SSL.Issuer
+		result.SSLExpiry = &expiry
+	}
+
+	return result, nil
+}
+
+// getHostSensor retrieves a single sensor of a host by HSID. It returns an error
+// wrapping ErrUnknownCommand when the API does not offer getHostSensor.
+func (c *Client) getHostSensor(ctx context.Context, hostID, hsid int) (*WormlyHostSensor, error) {
+	params := map[string]string{
+		"hostid": strconv.Itoa(hostID),
+		"hsid":   strconv.Itoa(hsid),
+	}
+
+	var response WormlyHostSensorResponse
+	if err := c.makeFormRequestGET(ctx, "getHostSensor", params, &response); err != nil {
+		return nil, err
+	}
+
+	if response.ErrorCode != 0 {
+		return nil, mapErrorCode(response.ErrorCode, response.Message)
+	}
+
+	if response.Sensor == nil {
+		return nil, fmt.Errorf("HTTP sensor with ID %d %w for host %d", hsid, ErrNotFound, hostID)
+	}
+
+	return response.Sensor, nil
+}
+
+// getSensorParams retrieves the parameters of a single sensor by HSID.
+func (c *Client) getSensorParams(ctx context.Context, hsid string) (interface{}, error) {
+	params := map[string]string{
+		"hsid": hsid,
+	}
+
+	var response WormlyHTTPSensorParamsResponse
+	if err := c.makeFormRequestGET(ctx, "getSensorParams", params, &response); err != nil {
+		return nil, err
+	}
+
+	if response.ErrorCode != 0 {
+		return nil, fmt.Errorf("API returned error code %d: %s", response.ErrorCode, response.Message)
+	}
+
+	return response.Params, nil
+}
+
+// sensorParamsMissing reports whether a getHostSensors params value carries no settings.
+// The API encodes an empty params set as null, an empty string, or an empty array/object.
+func sensorParamsMissing(params interface{}) bool {
+	switch p := params.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(p) == ""
+	case map[string]interface{}:
+		return len(p) == 0
+	case []interface{}:
+		return len(p) == 0
+	}
+	return false
 }
 
 // HTTPSensorParams represents the parsed parameters from the sensor params field.
 type HTTPSensorParams struct {
-	URL                  string `json:"url"`
-	Timeout              int    `json:"timeout"`
-	ResponseCode         string `json:"responsecode"`
-	VerifySSLCert        bool   `json:"verifysslcert"`
-	SearchHeaders        bool   `json:"searchheaders"`
-	ExpectedText         string `json:"expectedtext"`
-	UnwantedText         string `json:"unwantedtext"`
-	SSLValidity          int    `json:"sslvalidity"`
-	Cookies              string `json:"cookies"`
-	PostParams           string `json:"postparams"`
-	CustomRequestHeaders string `json:"customrequestheaders"`
-	UserAgent            string `json:"useragent"`
-	ForceResolve         string `json:"forceresolve"`
+	URL                  string   `json:"url"`
+	Timeout              int      `json:"timeout"`
+	ResponseCode         string   `json:"responsecode"`
+	VerifySSLCert        bool     `json:"verifysslcert"`
+	SearchHeaders        bool     `json:"searchheaders"`
+	ExpectedText         string   `json:"expectedtext"`
+	ExpectedTextIsRegex  bool     `json:"expectedtextregex"`
+	UnwantedText         string   `json:"unwantedtext"`
+	SSLValidity          int      `json:"sslvalidity"`
+	Cookies              string   `json:"cookies"`
+	PostParams           string   `json:"postparams"`
+	PostContentType      string   `json:"postcontenttype"`
+	CustomRequestHeaders string   `json:"customrequestheaders"`
+	UserAgent            string   `json:"useragent"`
+	ForceResolve         string   `json:"forceresolve"`
+	HTTPMethod           string   `json:"method"`
+	AuthUsername         string   `json:"httpusername"`
+	AuthPassword         string   `json:"httppassword"`
+	TestLocations        []string `json:"locations"`
+	FollowRedirects      *bool    `json:"followredirects"`
+	AlertAfterFailures   int      `json:"failsbeforenotify"`
+
+	// Returned records which parameters were present, keyed by request parameter name.
+	Returned map[string]bool `json:"-"`
+}
+
+// httpSensorParamResponseNames maps request parameter names to the different
+// names getHostSensors reports them under.
+var httpSensorParamResponseNames = map[string]string{
+	"verifysslcert": "ssl_strict",
+	"expectedtext":  "wantedstring",
+	"unwantedtext":  "unwantedstring",
+	"sslvalidity":   "ssl_min_expiry_in",
 }
 
 // parseHTTPSensorParams parses the params string to extract HTTP sensor configuration.
 func parseHTTPSensorParams(paramsStr string) *HTTPSensorParams {
 	// The params field might be JSON or key-value pairs
 	// Try JSON first
-	var params HTTPSensorParams
-	if err := json.Unmarshal([]byte(paramsStr), &params); err == nil {
-		return &params
+	var paramsMap map[string]interface{}
+	if err := json.Unmarshal([]byte(paramsStr), &paramsMap); err == nil {
+		return parseHTTPSensorParamsFromMap(paramsMap)
 	}
 
 	// If JSON parsing fails, try parsing as key-value pairs
 	// This assumes params are in format "key1=value1&key2=value2" or similar
-	params = HTTPSensorParams{}
+	paramsMap = make(map[string]interface{})
 	pairs := strings.Split(paramsStr, "&")
 	for _, pair := range pairs {
 		if kv := strings.SplitN(pair, "=", 2); len(kv) == 2 {
-			key := strings.TrimSpace(kv[0])
-			value := strings.TrimSpace(kv[1])
-
-			switch key {
-			case "url":
-				params.URL = value
-			case "timeout":
-				if timeout, err := strconv.Atoi(value); err == nil {
-					params.Timeout = timeout
-				}
-			case "responsecode":
-				params.ResponseCode = value
-			case "verifysslcert":
-				params.VerifySSLCert = value == "1" || strings.ToLower(value) == "true"
-			case "searchheaders":
-				params.SearchHeaders = value == "1" || strings.ToLower(value) == "true"
-			case "expectedtext":
-				params.ExpectedText = value
-			case "unwantedtext":
-				params.UnwantedText = value
-			case "sslvalidity":
-				if validity, err := strconv.Atoi(value); err == nil {
-					params.SSLValidity = validity
-				}
-			case "cookies":
-				params.Cookies = value
-			case "postparams":
-				params.PostParams = value
-			case "customrequestheaders":
-				params.CustomRequestHeaders = value
-			case "useragent":
-				params.UserAgent = value
-			case "forceresolve":
-				params.ForceResolve = value
-			}
+			paramsMap[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
 		}
 	}
 
-	return &params
+	return parseHTTPSensorParamsFromMap(paramsMap)
 }
 
 // parseHTTPSensorParamsFromMap parses HTTP sensor parameters from a map.
+//
+// getHostSensors reports several parameters under different names than the
+// ones addHostSensor_HTTP accepts, so each field is looked up under the name
+// the API returns first and the name used to set it second.
 func parseHTTPSensorParamsFromMap(paramsMap map[string]interface{}) *HTTPSensorParams {
-	params := &HTTPSensorParams{}
+	params := &HTTPSensorParams{Returned: make(map[string]bool)}
 
-	if url, ok := paramsMap["url"].(string); ok {
-		params.URL = url
+	// lookup finds a parameter under its response name first and its request name
+	// second, and records that the API returned it.
+	lookup := func(param string) (interface{}, bool) {
+		keys := []string{param}
+		if responseName, ok := httpSensorParamResponseNames[param]; ok {
+			keys = []string{responseName, param}
+		}
+		value, ok := lookupParam(paramsMap, keys...)
+		if ok {
+			params.Returned[param] = true
+		}
+		return value, ok
 	}
 
-	if timeout, ok := paramsMap["timeout"].(string); ok {
-		if t, err := strconv.Atoi(timeout); err == nil {
-			params.Timeout = t
-		}
-	} else if timeout, ok := paramsMap["timeout"].(float64); ok {
-		params.Timeout = int(timeout)
-	} else if timeout, ok := paramsMap["timeout"].(int); ok {
-		params.Timeout = timeout
+	if value, ok := lookup("url"); ok {
+		params.URL, _ = paramString(value)
 	}
 
-	if responseCode, ok := paramsMap["responsecode"].(string); ok {
-		params.ResponseCode = responseCode
+	if value, ok := lookup("timeout"); ok {
+		params.Timeout, _ = paramInt(value)
 	}
 
-	// API uses "ssl_strict" instead of "verifysslcert"
-	if sslStrict, ok := paramsMap["ssl_strict"].(string); ok {
-		params.VerifySSLCert = sslStrict == "1" || strings.ToLower(sslStrict) == "true"
-	} else if verifySsl, ok := paramsMap["verifysslcert"].(bool); ok {
-		params.VerifySSLCert = verifySsl
-	} else if verifySsl, ok := paramsMap["verifysslcert"].(string); ok {
-		params.VerifySSLCert = verifySsl == "1" || strings.ToLower(verifySsl) == "true"
+	if value, ok := lookup("responsecode"); ok {
+		params.ResponseCode, _ = paramString(value)
 	}
 
-	if searchHeaders, ok := paramsMap["searchheaders"].(string); ok {
-		params.SearchHeaders = searchHeaders == "1" || strings.ToLower(searchHeaders) == "true"
-	} else if searchHeaders, ok := paramsMap["searchheaders"].(bool); ok {
-		params.SearchHeaders = searchHeaders
+	if value, ok := lookup("verifysslcert"); ok {
+		params.VerifySSLCert, _ = paramBool(value)
 	}
 
-	// API uses "wantedstring" instead of "expectedtext"
-	if wantedString, ok := paramsMap["wantedstring"].(string); ok {
-		params.ExpectedText = wantedString
-	} else if expectedText, ok := paramsMap["expectedtext"].(string); ok {
-		params.ExpectedText = expectedText
+	if value, ok := lookup("searchheaders"); ok {
+		params.SearchHeaders, _ = paramBool(value)
 	}
 
-	if unwantedText, ok := paramsMap["unwantedtext"].(string); ok {
-		params.UnwantedText = unwantedText
+	if value, ok := lookup("expectedtext"); ok {
+		params.ExpectedText, _ = paramString(value)
 	}
 
-	if sslMinExpiry, ok := paramsMap["ssl_min_expiry_in"].(string); ok {
-		if s, err := strconv.Atoi(sslMinExpiry); err == nil {
-			params.SSLValidity = s
-		}
-	} else if sslMinExpiry, ok := paramsMap["ssl_min_expiry_in"].(float64); ok {
-		params.SSLValidity = int(sslMinExpiry)
-	} else if sslMinExpiry, ok := paramsMap["ssl_min_expiry_in"].(int); ok {
-		params.SSLValidity = sslMinExpiry
-	} else if sslValidity, ok := paramsMap["sslvalidity"].(string); ok {
-		if s, err := strconv.Atoi(sslValidity); err == nil {
-			params.SSLValidity = s
-		}
-	} else if sslValidity, ok := paramsMap["sslvalidity"].(float64); ok {
-		params.SSLValidity = int(sslValidity)
-	} else if sslValidity, ok := paramsMap["sslvalidity"].(int); ok {
-		params.SSLValidity = sslValidity
+	if value, ok := lookup("expectedtextregex"); ok {
+		params.ExpectedTextIsRegex, _ = paramBool(value)
+	}
+
+	if value, ok := lookup("unwantedtext"); ok {
+		params.UnwantedText, _ = paramString(value)
+	}
+
+	if value, ok := lookup("sslvalidity"); ok {
+		params.SSLValidity, _ = paramInt(value)
+	}
+
+	if value, ok := lookup("cookies"); ok {
+		params.Cookies, _ = paramString(value)
+	}
+
+	if value, ok := lookup("postparams"); ok {
+		params.PostParams, _ = paramString(value)
+	}
+
+	if value, ok := lookup("postcontenttype"); ok {
+		params.PostContentType, _ = paramString(value)
+	}
+
+	if value, ok := lookup("customrequestheaders"); ok {
+		params.CustomRequestHeaders, _ = paramString(value)
 	}
 
-	if cookies, ok := paramsMap["cookies"].(string); ok {
-		params.Cookies = cookies
+	if value, ok := lookup("useragent"); ok {
+		params.UserAgent, _ = paramString(value)
 	}
 
-	if postParams, ok := paramsMap["postparams"].(string); ok {
-		params.PostParams = postParams
+	if value, ok := lookup("forceresolve"); ok {
+		params.ForceResolve, _ = paramString(value)
 	}
 
-	if customHeaders, ok := paramsMap["customrequestheaders"].(string); ok {
-		params.CustomRequestHeaders = customHeaders
+	if value, ok := lookup("method"); ok {
+		method, _ := paramString(value)
+		params.HTTPMethod = strings.ToUpper(method)
 	}
 
-	if userAgent, ok := paramsMap["useragent"].(string); ok {
-		params.UserAgent = userAgent
+	if value, ok := lookup("httpusername"); ok {
+		params.AuthUsername, _ = paramString(value)
+	}
+
+	if value, ok := lookup("httppassword"); ok {
+		params.AuthPassword, _ = paramString(value)
+	}
+
+	if value, ok := lookup("locations"); ok {
+		params.TestLocations, _ = paramStringList(value)
+	}
+
+	if value, ok := lookup("followredirects"); ok {
+		if followRedirects, ok := paramBool(value); ok {
+			params.FollowRedirects = &followRedirects
+		}
 	}
 
-	if forceResolve, ok := paramsMap["forceresolve"].(string); ok {
-		params.ForceResolve = forceResolve
+	if value, ok := lookup("failsbeforenotify"); ok {
+		params.AlertAfterFailures, _ = paramInt(value)
 	}
 
 	return params
 }
 
+// lookupParam returns the value of the first of keys present in paramsMap.
+func lookupParam(paramsMap map[string]interface{}, keys ...string) (interface{}, bool) {
+	for _, key := range keys {
+		if value, ok := paramsMap[key]; ok && value != nil {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+// paramString returns a sensor parameter as a string. Numbers are formatted
+// without a fractional part, as the API returns some string fields unquoted.
+func paramString(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case int:
+		return strconv.Itoa(v), true
+	case bool:
+		return strconv.FormatBool(v), true
+	}
+	return "", false
+}
+
+// paramStringList returns a sensor parameter as a list of strings, accepting
+// both a JSON array and a comma-separated string.
+func paramStringList(value interface{}) ([]string, bool) {
+	var items []interface{}
+	switch v := value.(type) {
+	case []interface{}:
+		items = v
+	case string:
+		for _, item := range strings.Split(v, ",") {
+			items = append(items, strings.TrimSpace(item))
+		}
+	default:
+		return nil, false
+	}
+
+	list := make([]string, 0, len(items))
+	for _, item := range items {
+		s, ok := paramString(item)
+		if !ok {
+			return nil, false
+		}
+		if s != "" {
+			list = append(list, s)
+		}
+	}
+	return list, true
+}
+
+// paramInt returns a sensor parameter as an int, accepting numeric strings.
+func paramInt(value interface{}) (int, bool) {
+	switch v := value.(type) {
+	case string:
+		i, err := strconv.Atoi(v)
+		return i, err == nil
+	case float64:
+		return int(v), true
+	case int:
+		return v, true
+	}
+	return 0, false
+}
+
+// paramBool returns a sensor parameter as a bool, accepting "1", "0", "true"
+// and "false" in any case as well as numbers.
+func paramBool(value interface{}) (bool, bool) {
+	switch v := value.(type) {
+	case bool:
+		return v, true
+	case string:
+		switch strings.ToLower(v) {
+		case "1", "true":
+			return true, true
+		case "0", "false", "":
+			return false, true
+		}
+	case float64:
+		return v != 0, true
+	case int:
+		return v != 0, true
+	}
+	return false, false
+}
+
 // convertBasicSensorToHTTP converts a basic sensor from getHostSensors to a full SensorHTTP struct.
-func convertBasicSensorToHTTP(sensor struct {
-	HSID     string      `json:"hsid"`
-	SensorID string      `json:"sensorid"`
-	Enabled  string      `json:"enabled"`
-	NiceName string      `json:"nicename"` // API docs incorrectly say "nickname", actual response uses "nicename"
-	Params   interface{} `json:"params"`
-}, hostID int) (*SensorHTTP, error) {
+func convertBasicSensorToHTTP(sensor WormlyHostSensor, hostID int) (*SensorHTTP, error) {
 	// Convert HSID from string to int
 	hsid, hsidErr := strconv.Atoi(sensor.HSID)
 	if hsidErr != nil {
@@ -499,14 +1021,23 @@ func convertBasicSensorToHTTP(sensor struct {
 		VerifySSLCert:        httpParams.VerifySSLCert,
 		SearchHeaders:        httpParams.SearchHeaders,
 		ExpectedText:         httpParams.ExpectedText,
+		ExpectedTextIsRegex:  httpParams.ExpectedTextIsRegex,
 		UnwantedText:         httpParams.UnwantedText,
 		SSLValidity:          httpParams.SSLValidity,
 		Cookies:              httpParams.Cookies,
 		PostParams:           httpParams.PostParams,
+		PostContentType:      httpParams.PostContentType,
 		CustomRequestHeaders: httpParams.CustomRequestHeaders,
 		UserAgent:            httpParams.UserAgent,
 		ForceResolve:         httpParams.ForceResolve,
+		HTTPMethod:           httpParams.HTTPMethod,
+		AuthUsername:         httpParams.AuthUsername,
+		AuthPassword:         httpParams.AuthPassword,
+		TestLocations:        httpParams.TestLocations,
+		FollowRedirects:      httpParams.FollowRedirects,
+		AlertAfterFailures:   httpParams.AlertAfterFailures,
 		CreatedAt:            time.Now(),
 		UpdatedAt:            time.Now(),
+		ReturnedParams:       httpParams.Returned,
 	}, nil
 }
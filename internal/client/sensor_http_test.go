@@ -1,7 +1,17 @@
 package client
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestParseHTTPSensorParams(t *testing.T) {
@@ -244,6 +254,7 @@ func TestParseHTTPSensorParamsFromMap(t *testing.T) {
 		"customrequestheaders": "X-Custom: value",
 		"useragent":            "TestAgent/1.0",
 		"forceresolve":         "192.168.1.1",
+		"failsbeforenotify":    "3",
 	}
 
 	params := parseHTTPSensorParamsFromMap(paramsMap)
@@ -287,6 +298,9 @@ func TestParseHTTPSensorParamsFromMap(t *testing.T) {
 	if params.ForceResolve != "192.168.1.1" {
 		t.Errorf("Expected ForceResolve '192.168.1.1', got %q", params.ForceResolve)
 	}
+	if params.AlertAfterFailures != 3 {
+		t.Errorf("Expected AlertAfterFailures 3, got %d", params.AlertAfterFailures)
+	}
 }
 
 func TestParseHTTPSensorParamsFromMap_SSLMinExpiryIn(t *testing.T) {
@@ -306,6 +320,115 @@ func TestParseHTTPSensorParamsFromMap_SSLMinExpiryIn(t *testing.T) {
 	}
 }
 
+func TestParseHTTPSensorParams_APIFieldNames(t *testing.T) {
+	testCases := []struct {
+		name   string
+		params interface{}
+	}{
+		{
+			name: "map",
+			params: map[string]interface{}{
+				"url":               "https://example.com",
+				"responsecode":      float64(200),
+				"ssl_strict":        float64(1),
+				"wantedstring":      "Welcome",
+				"unwantedstring":    "Error",
+				"ssl_min_expiry_in": "14",
+			},
+		},
+		{
+			name:   "JSON string",
+			params: `{"url": "https://example.com", "responsecode": "200", "ssl_strict": true, "wantedstring": "Welcome", "unwantedstring": "Error", "ssl_min_expiry_in": 14}`,
+		},
+		{
+			name:   "key-value string",
+			params: "url=https://example.com&responsecode=200&ssl_strict=1&wantedstring=Welcome&unwantedstring=Error&ssl_min_expiry_in=14",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var params *HTTPSensorParams
+			switch p := tc.params.(type) {
+			case string:
+				params = parseHTTPSensorParams(p)
+			case map[string]interface{}:
+				params = parseHTTPSensorParamsFromMap(p)
+			}
+
+			if params.ResponseCode != "200" {
+				t.Errorf("Expected ResponseCode '200', got %q", params.ResponseCode)
+			}
+			if !params.VerifySSLCert {
+				t.Error("Expected VerifySSLCert to be true")
+			}
+			if params.ExpectedText != "Welcome" {
+				t.Errorf("Expected ExpectedText 'Welcome', got %q", params.ExpectedText)
+			}
+			if params.UnwantedText != "Error" {
+				t.Errorf("Expected UnwantedText 'Error', got %q", params.UnwantedText)
+			}
+			if params.SSLValidity != 14 {
+				t.Errorf("Expected SSLValidity 14, got %d", params.SSLValidity)
+			}
+		})
+	}
+}
+
+func TestConvertBasicSensorToHTTP_KeyValueAPIFieldNames(t *testing.T) {
+	basicSensor := struct {
+		HSID     string      `json:"hsid"`
+		SensorID string      `json:"sensorid"`
+		Enabled  string      `json:"enabled"`
+		NiceName string      `json:"nicename"`
+		Params   interface{} `json:"params"`
+	}{
+		HSID:     "123",
+		SensorID: SensorTypeHTTP,
+		Enabled:  "1",
+		Params:   "url=https://example.com&ssl_strict=1&wantedstring=hello&ssl_min_expiry_in=14",
+	}
+
+	sensor, err := convertBasicSensorToHTTP(basicSensor, 456)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !sensor.VerifySSLCert {
+		t.Error("Expected VerifySSLCert to be true from ssl_strict")
+	}
+	if sensor.ExpectedText != "hello" {
+		t.Errorf("Expected ExpectedText 'hello' from wantedstring, got %q", sensor.ExpectedText)
+	}
+	if sensor.SSLValidity != 14 {
+		t.Errorf("Expected SSLValidity 14 from ssl_min_expiry_in, got %d", sensor.SSLValidity)
+	}
+}
+
+func TestParseHTTPSensorParamsFromMap_Returned(t *testing.T) {
+	params := parseHTTPSensorParamsFromMap(map[string]interface{}{
+		"url":               "https://example.com",
+		"cookies":           "",
+		"ssl_min_expiry_in": "14",
+	})
+
+	expected := map[string]bool{"url": true, "cookies": true, "sslvalidity": true}
+	if fmt.Sprint(params.Returned) != fmt.Sprint(expected) {
+		t.Errorf("Expected returned params %v, got %v", expected, params.Returned)
+	}
+}
+
+func TestParseHTTPSensorParamsFromMap_SSLStrictTakesPrecedence(t *testing.T) {
+	params := parseHTTPSensorParamsFromMap(map[string]interface{}{
+		"ssl_strict":    "0",
+		"verifysslcert": true,
+	})
+
+	if params.VerifySSLCert {
+		t.Error("Expected VerifySSLCert to follow ssl_strict")
+	}
+}
+
 func TestConvertBasicSensorToHTTP_EnabledField(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -347,3 +470,1375 @@ func TestConvertBasicSensorToHTTP_EnabledField(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_ListSensorHTTP_FetchesMissingParams(t *testing.T) {
+	var paramsRequests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("Failed to parse form: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.FormValue("cmd") {
+		case "getHostSensors":
+			fmt.Fprint(w, `{
+				"errorcode": 0,
+				"sensors": [
+					{"hsid": "10", "sensorid": "2", "enabled": "1", "nicename": "Enabled", "params": {"url": "https://enabled.example.com"}},
+					{"hsid": "11", "sensorid": "2", "enabled": "0", "nicename": "Disabled", "params": []}
+				]
+			}`)
+		case "getSensorParams":
+			paramsRequests = append(paramsRequests, r.FormValue("hsid"))
+			fmt.Fprint(w, `{"errorcode": 0, "params": {"url": "https://disabled.example.com", "timeout": "15"}}`)
+		default:
+			t.Errorf("Unexpected command %q", r.FormValue("cmd"))
+		}
+	}))
+	defer server.Close()
+
+	client, err := New(Options{
+		HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 10.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	sensors, err := client.ListSensorHTTP(t.Context(), 456)
+	if err != nil {
+		t.Fatalf("ListSensorHTTP() returned error: %v", err)
+	}
+
+	if len(sensors) != 2 {
+		t.Fatalf("Expected 2 sensors, got %d", len(sensors))
+	}
+	if len(paramsRequests) != 1 || paramsRequests[0] != "11" {
+		t.Errorf("Expected a single params fetch for HSID 11, got %v", paramsRequests)
+	}
+	if sensors[0].URL != "https://enabled.example.com" {
+		t.Errorf("Expected URL 'https://enabled.example.com', got %q", sensors[0].URL)
+	}
+	if sensors[1].Enabled {
+		t.Error("Expected second sensor to be disabled")
+	}
+	if sensors[1].URL != "https://disabled.example.com" {
+		t.Errorf("Expected URL 'https://disabled.example.com', got %q", sensors[1].URL)
+	}
+	if sensors[1].Timeout != 15 {
+		t.Errorf("Expected timeout 15, got %d", sensors[1].Timeout)
+	}
+}
+
+func TestClient_SensorHTTP_AlertAfterFailures(t *testing.T) {
+	tests := []struct {
+		name               string
+		alertAfterFailures int
+		expectedParam      string
+	}{
+		{name: "set", alertAfterFailures: 3, expectedParam: "3"},
+		{name: "unset uses the API default", alertAfterFailures: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseForm(); err != nil {
+					t.Fatalf("Failed to parse form: %v", err)
+				}
+				w.Header().Set("Content-Type", "application/json")
+
+				switch r.FormValue("cmd") {
+				case "addHostSensor_HTTP":
+					if r.Form.Has("failsbeforenotify") != (tt.expectedParam != "") {
+						t.Errorf("Expected failsbeforenotify to be sent: %t, got form %v", tt.expectedParam != "", r.Form)
+					}
+					if got := r.FormValue("failsbeforenotify"); got != tt.expectedParam {
+						t.Errorf("Expected failsbeforenotify %q, got %q", tt.expectedParam, got)
+					}
+					fmt.Fprint(w, `{"errorcode": 0, "hostsensorid": 10}`)
+				case "getHostSensor":
+					// getHostSensor is unavailable, so the sensor is read from the getHostSensors list
+					fmt.Fprint(w, `{"errorcode": 5, "message": "Unknown command"}`)
+				case "getHostSensors":
+					fmt.Fprintf(w, `{
+						"errorcode": 0,
+						"sensors": [
+							{"hsid": "10", "sensorid": "2", "enabled": "1", "params": {"url": "https://example.com", "failsbeforenotify": %q}}
+						]
+					}`, tt.expectedParam)
+				default:
+					t.Errorf("Unexpected command %q", r.FormValue("cmd"))
+				}
+			}))
+			defer server.Close()
+
+			client, err := New(Options{
+				HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 10.0,
+				MaxRetries:        3,
+				InitialBackoff:    time.Second,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        30 * time.Second,
+			})
+			if err != nil {
+				t.Fatalf("New() returned error: %v", err)
+			}
+
+			created, err := client.CreateSensorHTTP(t.Context(), &SensorHTTPCreateRequest{
+				HostID:             456,
+				URL:                "https://example.com",
+				AlertAfterFailures: tt.alertAfterFailures,
+			})
+			if err != nil {
+				t.Fatalf("CreateSensorHTTP() returned error: %v", err)
+			}
+			if created.AlertAfterFailures != tt.alertAfterFailures {
+				t.Errorf("Expected created AlertAfterFailures %d, got %d", tt.alertAfterFailures, created.AlertAfterFailures)
+			}
+
+			sensor, err := client.GetSensorHTTP(t.Context(), 456, 10)
+			if err != nil {
+				t.Fatalf("GetSensorHTTP() returned error: %v", err)
+			}
+			if sensor.AlertAfterFailures != tt.alertAfterFailures {
+				t.Errorf("Expected AlertAfterFailures %d after read, got %d", tt.alertAfterFailures, sensor.AlertAfterFailures)
+			}
+		})
+	}
+}
+
+func TestClient_SensorHTTP_FollowRedirects(t *testing.T) {
+	enabled, disabled := true, false
+	tests := []struct {
+		name          string
+		follow        *bool
+		expectedParam string
+		returnedParam string
+	}{
+		{name: "enabled", follow: &enabled, expectedParam: "1", returnedParam: `"followredirects": "1"`},
+		{name: "disabled", follow: &disabled, expectedParam: "0", returnedParam: `"followredirects": false`},
+		{name: "unset uses the API default"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseForm(); err != nil {
+					t.Fatalf("Failed to parse form: %v", err)
+				}
+				w.Header().Set("Content-Type", "application/json")
+
+				switch r.FormValue("cmd") {
+				case "addHostSensor_HTTP":
+					if r.Form.Has("followredirects") != (tt.expectedParam != "") {
+						t.Errorf("Expected followredirects to be sent: %t, got form %v", tt.expectedParam != "", r.Form)
+					}
+					if got := r.FormValue("followredirects"); got != tt.expectedParam {
+						t.Errorf("Expected followredirects %q, got %q", tt.expectedParam, got)
+					}
+					fmt.Fprint(w, `{"errorcode": 0, "hostsensorid": 10}`)
+				case "getHostSensor":
+					params := `"url": "https://example.com"`
+					if tt.returnedParam != "" {
+						params += ", " + tt.returnedParam
+					}
+					fmt.Fprintf(w, `{"errorcode": 0, "sensor": {"hsid": "10", "sensorid": "2", "enabled": "1", "params": {%s}}}`, params)
+				default:
+					t.Errorf("Unexpected command %q", r.FormValue("cmd"))
+				}
+			}))
+			defer server.Close()
+
+			client, err := New(Options{
+				HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 10.0,
+				MaxRetries:        3,
+				InitialBackoff:    time.Second,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        30 * time.Second,
+			})
+			if err != nil {
+				t.Fatalf("New() returned error: %v", err)
+			}
+
+			if _, err := client.CreateSensorHTTP(t.Context(), &SensorHTTPCreateRequest{
+				HostID:          456,
+				URL:             "https://example.com",
+				FollowRedirects: tt.follow,
+			}); err != nil {
+				t.Fatalf("CreateSensorHTTP() returned error: %v", err)
+			}
+
+			sensor, err := client.GetSensorHTTP(t.Context(), 456, 10)
+			if err != nil {
+				t.Fatalf("GetSensorHTTP() returned error: %v", err)
+			}
+			switch {
+			case tt.follow == nil:
+				if sensor.FollowRedirects != nil {
+					t.Errorf("Expected FollowRedirects to be unset after read, got %t", *sensor.FollowRedirects)
+				}
+			case sensor.FollowRedirects == nil || *sensor.FollowRedirects != *tt.follow:
+				t.Errorf("Expected FollowRedirects %t after read, got %v", *tt.follow, sensor.FollowRedirects)
+			}
+		})
+	}
+}
+
+func TestClient_SensorHTTP_BasicAuth(t *testing.T) {
+	tests := []struct {
+		name           string
+		username       string
+		password       string
+		returnPassword bool
+	}{
+		{name: "credentials", username: "monitor", password: "s3cret", returnPassword: true},
+		{name: "password not returned", username: "monitor", password: "s3cret"},
+		{name: "no credentials"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseForm(); err != nil {
+					t.Fatalf("Failed to parse form: %v", err)
+				}
+				w.Header().Set("Content-Type", "application/json")
+
+				switch r.FormValue("cmd") {
+				case "addHostSensor_HTTP":
+					for param, expected := range map[string]string{"httpusername": tt.username, "httppassword": tt.password} {
+						if r.Form.Has(param) != (expected != "") {
+							t.Errorf("Expected %s to be sent: %t, got form %v", param, expected != "", r.Form)
+						}
+						if got := r.FormValue(param); got != expected {
+							t.Errorf("Expected %s %q, got %q", param, expected, got)
+						}
+					}
+					fmt.Fprint(w, `{"errorcode": 0, "hostsensorid": 10}`)
+				case "getHostSensor":
+					// getHostSensor is unavailable, so the sensor is read from the getHostSensors list
+					fmt.Fprint(w, `{"errorcode": 5, "message": "Unknown command"}`)
+				case "getHostSensors":
+					params := map[string]string{"url": "https://example.com", "httpusername": tt.username}
+					if tt.returnPassword {
+						params["httppassword"] = tt.password
+					}
+					body, err := json.Marshal(map[string]interface{}{
+						"errorcode": 0,
+						"sensors":   []interface{}{map[string]interface{}{"hsid": "10", "sensorid": "2", "enabled": "1", "params": params}},
+					})
+					if err != nil {
+						t.Fatalf("Failed to encode response: %v", err)
+					}
+					_, _ = w.Write(body)
+				default:
+					t.Errorf("Unexpected command %q", r.FormValue("cmd"))
+				}
+			}))
+			defer server.Close()
+
+			client, err := New(Options{
+				HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 10.0,
+				MaxRetries:        3,
+				InitialBackoff:    time.Second,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        30 * time.Second,
+			})
+			if err != nil {
+				t.Fatalf("New() returned error: %v", err)
+			}
+
+			if _, err := client.CreateSensorHTTP(t.Context(), &SensorHTTPCreateRequest{
+				HostID:       456,
+				URL:          "https://example.com",
+				AuthUsername: tt.username,
+				AuthPassword: tt.password,
+			}); err != nil {
+				t.Fatalf("CreateSensorHTTP() returned error: %v", err)
+			}
+
+			sensor, err := client.GetSensorHTTP(t.Context(), 456, 10)
+			if err != nil {
+				t.Fatalf("GetSensorHTTP() returned error: %v", err)
+			}
+			if sensor.AuthUsername != tt.username {
+				t.Errorf("Expected AuthUsername %q after read, got %q", tt.username, sensor.AuthUsername)
+			}
+			expectedPassword := ""
+			if tt.returnPassword {
+				expectedPassword = tt.password
+			}
+			if sensor.AuthPassword != expectedPassword {
+				t.Errorf("Expected AuthPassword %q after read, got %q", expectedPassword, sensor.AuthPassword)
+			}
+			if sensor.ReturnedParams["httppassword"] != tt.returnPassword {
+				t.Errorf("Expected httppassword returned: %t, got %t", tt.returnPassword, sensor.ReturnedParams["httppassword"])
+			}
+		})
+	}
+}
+
+func TestClient_CreateSensorHTTP_TestLocations(t *testing.T) {
+	var requests []url.Values
+	client := newContactTestClient(t, `{"errorcode": 0, "hostsensorid": 10}`, &requests)
+
+	sensor, err := client.CreateSensorHTTP(t.Context(), &SensorHTTPCreateRequest{
+		HostID:        456,
+		URL:           "https://example.com",
+		TestLocations: []string{TestLocationLondon, TestLocationNewYork},
+	})
+
+	if err != nil {
+		t.Fatalf("CreateSensorHTTP() returned error: %v", err)
+	}
+	if !slices.Equal(sensor.TestLocations, []string{"lon", "nyc"}) {
+		t.Errorf("Expected TestLocations [lon nyc], got %v", sensor.TestLocations)
+	}
+	if len(requests) != 1 {
+		t.Fatalf("Expected 1 request, got %d", len(requests))
+	}
+	if got := requests[0].Get("locations"); got != "lon,nyc" {
+		t.Errorf("Expected locations %q, got %q", "lon,nyc", got)
+	}
+}
+
+func TestParseHTTPSensorParams_TestLocations(t *testing.T) {
+	tests := []struct {
+		name     string
+		params   map[string]interface{}
+		expected []string
+		returned bool
+	}{
+		{name: "array", params: map[string]interface{}{"locations": []interface{}{"lon", "nyc"}}, expected: []string{"lon", "nyc"}, returned: true},
+		{name: "comma-separated", params: map[string]interface{}{"locations": "lon, nyc"}, expected: []string{"lon", "nyc"}, returned: true},
+		{name: "empty", params: map[string]interface{}{"locations": ""}, expected: []string{}, returned: true},
+		{name: "not returned", params: map[string]interface{}{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := parseHTTPSensorParamsFromMap(tt.params)
+
+			if !slices.Equal(params.TestLocations, tt.expected) {
+				t.Errorf("Expected TestLocations %v, got %v", tt.expected, params.TestLocations)
+			}
+			if params.Returned["locations"] != tt.returned {
+				t.Errorf("Expected locations returned %t, got %t", tt.returned, params.Returned["locations"])
+			}
+		})
+	}
+}
+
+func TestClient_GetSensorHTTP_SingleFetch(t *testing.T) {
+	var commands []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		commands = append(commands, r.FormValue("cmd"))
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.FormValue("cmd") {
+		case "getHostSensor":
+			if r.FormValue("hostid") != "456" || r.FormValue("hsid") != "10" {
+				t.Errorf("Expected hostid 456 and hsid 10, got %q and %q", r.FormValue("hostid"), r.FormValue("hsid"))
+			}
+			fmt.Fprint(w, `{"errorcode": 0, "sensor": {"hsid": "10", "sensorid": "2", "enabled": "1", "params": {"url": "https://example.com"}}}`)
+		default:
+			t.Errorf("Unexpected command %q", r.FormValue("cmd"))
+		}
+	}))
+	defer server.Close()
+
+	client, err := New(Options{
+		HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 10.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	sensor, err := client.GetSensorHTTP(t.Context(), 456, 10)
+	if err != nil {
+		t.Fatalf("GetSensorHTTP() returned error: %v", err)
+	}
+	if sensor.ID != 10 || sensor.HostID != 456 || sensor.URL != "https://example.com" || !sensor.Enabled {
+		t.Errorf("Unexpected sensor: %+v", sensor)
+	}
+	if !slices.Equal(commands, []string{"getHostSensor"}) {
+		t.Errorf("Expected only getHostSensor to be called, got %v", commands)
+	}
+}
+
+func TestClient_GetSensorHTTP_FallsBackToList(t *testing.T) {
+	tests := []struct {
+		name             string
+		status           int
+		response         string
+		expectedCommands []string
+	}{
+		{
+			name:     "unknown command",
+			response: `{"errorcode": 5, "message": "Unknown command"}`,
+			// getHostSensor is not tried again once it is known to be unavailable
+			expectedCommands: []string{"getHostSensor", "getHostSensors", "getHostSensors"},
+		},
+		{
+			name:             "API error",
+			response:         `{"errorcode": 1, "message": "Invalid hsid"}`,
+			expectedCommands: []string{"getHostSensor", "getHostSensors", "getHostSensor", "getHostSensors"},
+		},
+		{
+			name:             "HTTP 404",
+			status:           http.StatusNotFound,
+			response:         `Not Found`,
+			expectedCommands: []string{"getHostSensor", "getHostSensors", "getHostSensors"},
+		},
+		{
+			name:             "undecodable response",
+			response:         `<html><body>Unknown page</body></html>`,
+			expectedCommands: []string{"getHostSensor", "getHostSensors", "getHostSensors"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var commands []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				commands = append(commands, r.FormValue("cmd"))
+				w.Header().Set("Content-Type", "application/json")
+
+				switch r.FormValue("cmd") {
+				case "getHostSensor":
+					if tt.status != 0 {
+						w.WriteHeader(tt.status)
+					}
+					fmt.Fprint(w, tt.response)
+				case "getHostSensors":
+					fmt.Fprint(w, `{"errorcode": 0, "sensors": [{"hsid": "10", "sensorid": "2", "enabled": "1", "params": {"url": "https://example.com"}}]}`)
+				default:
+					t.Errorf("Unexpected command %q", r.FormValue("cmd"))
+				}
+			}))
+			defer server.Close()
+
+			client, err := New(Options{
+				HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 10.0,
+				MaxRetries:        3,
+				InitialBackoff:    time.Second,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        30 * time.Second,
+			})
+			if err != nil {
+				t.Fatalf("New() returned error: %v", err)
+			}
+
+			sensor, err := client.GetSensorHTTP(t.Context(), 456, 10)
+			if err != nil {
+				t.Fatalf("GetSensorHTTP() returned error: %v", err)
+			}
+			if sensor.ID != 10 || sensor.URL != "https://example.com" {
+				t.Errorf("Unexpected sensor: %+v", sensor)
+			}
+
+			if _, err := client.GetSensorHTTP(t.Context(), 456, 11); !errors.Is(err, ErrNotFound) {
+				t.Errorf("Expected ErrNotFound for a sensor missing from the list, got: %v", err)
+			}
+
+			if !slices.Equal(commands, tt.expectedCommands) {
+				t.Errorf("Expected commands %v, got %v", tt.expectedCommands, commands)
+			}
+		})
+	}
+}
+
+func TestClient_GetSensorHTTP_SingleFetchOtherSensorType(t *testing.T) {
+	var commands []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		commands = append(commands, r.FormValue("cmd"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"errorcode": 0, "sensor": {"hsid": "10", "sensorid": "1", "enabled": "1", "params": {"host": "example.com"}}}`)
+	}))
+	defer server.Close()
+
+	client, err := New(Options{
+		HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 10.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	if _, err := client.GetSensorHTTP(t.Context(), 456, 10); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for a sensor of another type, got: %v", err)
+	}
+	if !slices.Equal(commands, []string{"getHostSensor"}) {
+		t.Errorf("Expected only getHostSensor to be called, got %v", commands)
+	}
+}
+
+func TestClient_GetSensorHTTP_ContextCanceled(t *testing.T) {
+	var commands []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		commands = append(commands, r.FormValue("cmd"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"errorcode": 0, "sensors": []}`)
+	}))
+	defer server.Close()
+
+	client, err := New(Options{
+		HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 10.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	if _, err := client.GetSensorHTTP(ctx, 456, 10); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got: %v", err)
+	}
+	if len(commands) != 0 {
+		t.Errorf("Expected no fallback to getHostSensors, got %v", commands)
+	}
+	if client.singleSensorFetchUnavailable.Load() {
+		t.Error("Expected getHostSensor to stay available after a canceled request")
+	}
+}
+
+func TestClient_GetHostSensors_Pagination(t *testing.T) {
+	var pages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("cmd") == "getHostSensor" {
+			// getHostSensor is unavailable, so sensors are looked up in the paginated list
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"errorcode": 5, "message": "Unknown command"}`)
+			return
+		}
+		if r.FormValue("cmd") != "getHostSensors" {
+			t.Errorf("Unexpected command %q", r.FormValue("cmd"))
+		}
+		if r.FormValue("hostid") != "456" {
+			t.Errorf("Expected hostid 456, got %q", r.FormValue("hostid"))
+		}
+		pages = append(pages, r.FormValue("page"))
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.FormValue("page") {
+		case "":
+			fmt.Fprint(w, `{
+				"errorcode": 0,
+				"nextpage": 2,
+				"sensors": [
+					{"hsid": "10", "sensorid": "2", "enabled": "1", "params": {"url": "https://one.example.com"}},
+					{"hsid": "11", "sensorid": "1", "enabled": "1", "params": {"host": "example.com"}}
+				]
+			}`)
+		case "2":
+			fmt.Fprint(w, `{
+				"errorcode": 0,
+				"nextpage": "",
+				"sensors": [
+					{"hsid": "12", "sensorid": "2", "enabled": "1", "params": {"url": "https://two.example.com"}}
+				]
+			}`)
+		default:
+			t.Errorf("Unexpected page %q", r.FormValue("page"))
+		}
+	}))
+	defer server.Close()
+
+	client, err := New(Options{
+		HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 1000.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	sensors, err := client.ListSensorHTTP(t.Context(), 456)
+	if err != nil {
+		t.Fatalf("ListSensorHTTP() returned error: %v", err)
+	}
+	var urls []string
+	for _, sensor := range sensors {
+		urls = append(urls, sensor.URL)
+	}
+	if expected := []string{"https://one.example.com", "https://two.example.com"}; !slices.Equal(urls, expected) {
+		t.Errorf("Expected sensors %v from both pages, got %v", expected, urls)
+	}
+	if expected := []string{"", "2"}; !slices.Equal(pages, expected) {
+		t.Errorf("Expected pages %v to be requested, got %v", expected, pages)
+	}
+
+	sensor, err := client.GetSensorHTTP(t.Context(), 456, 12)
+	if err != nil {
+		t.Fatalf("GetSensorHTTP() returned error for a sensor on the second page: %v", err)
+	}
+	if sensor.URL != "https://two.example.com" {
+		t.Errorf("Expected URL 'https://two.example.com', got %q", sensor.URL)
+	}
+}
+
+func TestClient_GetHostSensors_RepeatedPage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"errorcode": 0, "nextpage": "2", "sensors": []}`)
+	}))
+	defer server.Close()
+
+	client, err := New(Options{
+		HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 1000.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	_, err = client.ListSensorHTTP(t.Context(), 456)
+	if err == nil || !strings.Contains(err.Error(), "returned page 2 more than once") {
+		t.Errorf("Expected an error for a repeated page, got: %v", err)
+	}
+}
+
+func TestClient_SetSensorHTTPVerifySSL(t *testing.T) {
+	var forms []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("Failed to parse form: %v", err)
+		}
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected a POST request, got %s", r.Method)
+		}
+		forms = append(forms, r.PostForm)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"errorcode": 0}`)
+	}))
+	defer server.Close()
+
+	client, err := New(Options{
+		HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 1000.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	if err := client.SetSensorHTTPVerifySSL(t.Context(), 10, true); err != nil {
+		t.Fatalf("SetSensorHTTPVerifySSL(true) returned error: %v", err)
+	}
+	if err := client.SetSensorHTTPVerifySSL(t.Context(), 10, false); err != nil {
+		t.Fatalf("SetSensorHTTPVerifySSL(false) returned error: %v", err)
+	}
+
+	if len(forms) != 2 {
+		t.Fatalf("Expected 2 requests, got %d", len(forms))
+	}
+	for i, expected := range []string{"1", "0"} {
+		if forms[i].Get("cmd") != "setSensorParams" {
+			t.Errorf("Expected command setSensorParams, got %q", forms[i].Get("cmd"))
+		}
+		if forms[i].Get("hsid") != "10" {
+			t.Errorf("Expected hsid 10, got %q", forms[i].Get("hsid"))
+		}
+		if forms[i].Get("verifysslcert") != expected {
+			t.Errorf("Expected verifysslcert %q, got %q", expected, forms[i].Get("verifysslcert"))
+		}
+	}
+}
+
+func TestClient_GetHostSensors_APIErrorMessage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"errorcode": 2, "message": "Invalid hostid"}`)
+	}))
+	defer server.Close()
+
+	client, err := New(Options{
+		HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 10.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	const expected = "API returned error code 2: Invalid hostid"
+
+	if _, err := client.ListSensorHTTP(t.Context(), 456); err == nil || !strings.Contains(err.Error(), expected) {
+		t.Errorf("ListSensorHTTP() error = %v, want it to contain %q", err, expected)
+	}
+	if _, err := client.GetSensorHTTP(t.Context(), 456, 10); err == nil || !strings.Contains(err.Error(), expected) {
+		t.Errorf("GetSensorHTTP() error = %v, want it to contain %q", err, expected)
+	}
+}
+
+func TestClient_GetSensorHTTP_NotFound(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+	}{
+		{name: "other sensors listed", response: `{"errorcode": 0, "sensors": [{"hsid": "11", "sensorid": "1"}]}`},
+		{name: "empty list", response: `{"errorcode": 0, "sensors": []}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, tt.response)
+			}))
+			defer server.Close()
+
+			client, err := New(Options{
+				HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 10.0,
+				MaxRetries:        3,
+				InitialBackoff:    time.Second,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        30 * time.Second,
+			})
+			if err != nil {
+				t.Fatalf("New() returned error: %v", err)
+			}
+
+			_, err = client.GetSensorHTTP(t.Context(), 456, 10)
+			if !errors.Is(err, ErrNotFound) {
+				t.Errorf("GetSensorHTTP() error = %v, want it to wrap ErrNotFound", err)
+			}
+		})
+	}
+}
+
+func TestClient_FindSensorHost(t *testing.T) {
+	tests := []struct {
+		name           string
+		sensorID       int
+		expectedHostID int
+		expectedError  string
+		notFound       bool
+	}{
+		{name: "sensor on second host", sensorID: 20, expectedHostID: 2},
+		{name: "unknown sensor", sensorID: 99, notFound: true},
+		{name: "not an HTTP sensor", sensorID: 11, expectedError: "sensor 11 on host 1 is not an HTTP sensor"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.FormValue("cmd") {
+				case "getHosts":
+					fmt.Fprint(w, `{"errorcode": 0, "hosts": [{"hostid": 1, "name": "web"}, {"hostid": 2, "name": "api"}]}`)
+				case "getHostSensors":
+					switch r.FormValue("hostid") {
+					case "1":
+						fmt.Fprint(w, `{"errorcode": 0, "sensors": [{"hsid": "10", "sensorid": "2"}, {"hsid": "11", "sensorid": "3"}]}`)
+					case "2":
+						fmt.Fprint(w, `{"errorcode": 0, "sensors": [{"hsid": "20", "sensorid": "2"}]}`)
+					}
+				default:
+					t.Errorf("Unexpected command %q", r.FormValue("cmd"))
+				}
+			}))
+			defer server.Close()
+
+			client, err := New(Options{
+				HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 10.0,
+				MaxRetries:        3,
+				InitialBackoff:    time.Second,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        30 * time.Second,
+			})
+			if err != nil {
+				t.Fatalf("New() returned error: %v", err)
+			}
+
+			hostID, err := client.FindSensorHost(t.Context(), tt.sensorID)
+
+			switch {
+			case tt.notFound:
+				if !errors.Is(err, ErrNotFound) {
+					t.Errorf("FindSensorHost() error = %v, want ErrNotFound", err)
+				}
+			case tt.expectedError != "":
+				if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("FindSensorHost() error = %v, want it to contain %q", err, tt.expectedError)
+				}
+			default:
+				if err != nil {
+					t.Fatalf("FindSensorHost() returned error: %v", err)
+				}
+				if hostID != tt.expectedHostID {
+					t.Errorf("FindSensorHost() = %d, want %d", hostID, tt.expectedHostID)
+				}
+			}
+		})
+	}
+}
+
+func TestClient_GetSensorHTTPLatestResult(t *testing.T) {
+	tests := []struct {
+		name           string
+		responseBody   string
+		expectedError  bool
+		expectedNil    bool
+		expectedIssuer string
+		expectedExpiry *time.Time
+	}{
+		{
+			name:           "result with certificate",
+			responseBody:   `{"errorcode": 0, "results": [{"time": 1767268800, "ssl": {"issuer": "Let's Encrypt", "expires": 1772474400}}]}`,
+			expectedIssuer: "Let's Encrypt",
+			expectedExpiry: func() *time.Time { t := time.Unix(1772474400, 0).UTC(); return &t }(),
+		},
+		{
+			name:         "result without certificate",
+			responseBody: `{"errorcode": 0, "results": [{"time": 1767268800}]}`,
+		},
+		{
+			name:         "never checked",
+			responseBody: `{"errorcode": 0, "results": []}`,
+			expectedNil:  true,
+		},
+		{
+			name:          "API error",
+			responseBody:  `{"errorcode": 1, "message": "Sensor not found"}`,
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.FormValue("cmd") != "getSensorResults" || r.FormValue("hsid") != "123" {
+					t.Errorf("Unexpected request cmd=%q hsid=%q", r.FormValue("cmd"), r.FormValue("hsid"))
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, tt.responseBody)
+			}))
+			defer server.Close()
+
+			client, err := New(Options{
+				HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 10.0,
+				MaxRetries:        3,
+				InitialBackoff:    time.Second,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        30 * time.Second,
+			})
+			if err != nil {
+				t.Fatalf("New() returned error: %v", err)
+			}
+
+			result, err := client.GetSensorHTTPLatestResult(t.Context(), 123)
+			if tt.expectedError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetSensorHTTPLatestResult() returned error: %v", err)
+			}
+
+			if tt.expectedNil {
+				if result != nil {
+					t.Errorf("Expected nil result, got %+v", result)
+				}
+				return
+			}
+			if result == nil {
+				t.Fatal("Expected result, got nil")
+			}
+			if result.SSLIssuer != tt.expectedIssuer {
+				t.Errorf("Expected issuer %q, got %q", tt.expectedIssuer, result.SSLIssuer)
+			}
+			switch {
+			case tt.expectedExpiry == nil && result.SSLExpiry != nil:
+				t.Errorf("Expected no SSL expiry, got %v", result.SSLExpiry)
+			case tt.expectedExpiry != nil && (result.SSLExpiry == nil || !result.SSLExpiry.Equal(*tt.expectedExpiry)):
+				t.Errorf("Expected SSL expiry %v, got %v", tt.expectedExpiry, result.SSLExpiry)
+			}
+		})
+	}
+}
+
+func TestClient_CreateSensorsHTTPBatch(t *testing.T) {
+	tests := []struct {
+		name            string
+		failURL         string
+		expectedIDs     []int
+		expectedCreates int
+		expectError     bool
+	}{
+		{
+			name:            "all created",
+			expectedIDs:     []int{100, 101, 102},
+			expectedCreates: 3,
+		},
+		{
+			name:            "short-circuits after mid-batch failure",
+			failURL:         "https://b.example.com",
+			expectedIDs:     []int{100, 0, 0},
+			expectedCreates: 2,
+			expectError:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var creates int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.FormValue("cmd") != "addHostSensor_HTTP" {
+					t.Errorf("Unexpected command %q", r.FormValue("cmd"))
+				}
+				w.Header().Set("Content-Type", "application/json")
+				if r.FormValue("url") == tt.failURL {
+					fmt.Fprint(w, `{"errorcode": 1, "message": "Invalid URL"}`)
+				} else {
+					fmt.Fprintf(w, `{"errorcode": 0, "hostsensorid": %d}`, 100+creates)
+				}
+				creates++
+			}))
+			defer server.Close()
+
+			// A burst of 1 keeps a single create in flight, making the short-circuit deterministic
+			client, err := New(Options{
+				HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 1000.0,
+				MaxRetries:        3,
+				InitialBackoff:    time.Millisecond,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        10 * time.Millisecond,
+			})
+			if err != nil {
+				t.Fatalf("New() returned error: %v", err)
+			}
+
+			sensors, err := client.CreateSensorsHTTPBatch(t.Context(), []*SensorHTTPCreateRequest{
+				{HostID: 7, URL: "https://a.example.com"},
+				{HostID: 7, URL: "https://b.example.com"},
+				{HostID: 7, URL: "https://c.example.com"},
+			})
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected an error, got nil")
+				}
+				for _, want := range []string{"sensor 1 (https://b.example.com)", "Invalid URL", "1 of 3 HTTP sensors were not attempted"} {
+					if !strings.Contains(err.Error(), want) {
+						t.Errorf("Expected error to contain %q, got %q", want, err.Error())
+					}
+				}
+			} else if err != nil {
+				t.Fatalf("CreateSensorsHTTPBatch() returned error: %v", err)
+			}
+
+			if creates != tt.expectedCreates {
+				t.Errorf("Expected %d create requests, got %d", tt.expectedCreates, creates)
+			}
+			if len(sensors) != len(tt.expectedIDs) {
+				t.Fatalf("Expected %d results, got %d", len(tt.expectedIDs), len(sensors))
+			}
+			for i, id := range tt.expectedIDs {
+				switch {
+				case id == 0 && sensors[i] != nil:
+					t.Errorf("Expected no sensor at index %d, got %+v", i, sensors[i])
+				case id != 0 && (sensors[i] == nil || sensors[i].ID != id):
+					t.Errorf("Expected sensor %d at index %d, got %+v", id, i, sensors[i])
+				}
+			}
+		})
+	}
+}
+
+func TestClient_SensorHTTP_ExpectedTextIsRegex(t *testing.T) {
+	tests := []struct {
+		name          string
+		isRegex       bool
+		expectedParam string
+		returnedParam string
+	}{
+		{name: "regex", isRegex: true, expectedParam: "1", returnedParam: `"expectedtextregex": "1"`},
+		{name: "literal by default", expectedParam: "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseForm(); err != nil {
+					t.Fatalf("Failed to parse form: %v", err)
+				}
+				w.Header().Set("Content-Type", "application/json")
+
+				switch r.FormValue("cmd") {
+				case "addHostSensor_HTTP":
+					if got := r.FormValue("expectedtextregex"); got != tt.expectedParam {
+						t.Errorf("Expected expectedtextregex %q, got %q", tt.expectedParam, got)
+					}
+					fmt.Fprint(w, `{"errorcode": 0, "hostsensorid": 10}`)
+				case "getHostSensor":
+					params := `"url": "https://example.com", "wantedstring": "^OK [0-9]+$"`
+					if tt.returnedParam != "" {
+						params += ", " + tt.returnedParam
+					}
+					fmt.Fprintf(w, `{"errorcode": 0, "sensor": {"hsid": "10", "sensorid": "2", "enabled": "1", "params": {%s}}}`, params)
+				default:
+					t.Errorf("Unexpected command %q", r.FormValue("cmd"))
+				}
+			}))
+			defer server.Close()
+
+			client, err := New(Options{
+				HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 10.0,
+				MaxRetries:        3,
+				InitialBackoff:    time.Second,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        30 * time.Second,
+			})
+			if err != nil {
+				t.Fatalf("New() returned error: %v", err)
+			}
+
+			created, err := client.CreateSensorHTTP(t.Context(), &SensorHTTPCreateRequest{
+				HostID:              456,
+				URL:                 "https://example.com",
+				ExpectedText:        "^OK [0-9]+$",
+				ExpectedTextIsRegex: tt.isRegex,
+			})
+			if err != nil {
+				t.Fatalf("CreateSensorHTTP() returned error: %v", err)
+			}
+			if created.ExpectedTextIsRegex != tt.isRegex {
+				t.Errorf("Expected created ExpectedTextIsRegex %t, got %t", tt.isRegex, created.ExpectedTextIsRegex)
+			}
+
+			sensor, err := client.GetSensorHTTP(t.Context(), 456, 10)
+			if err != nil {
+				t.Fatalf("GetSensorHTTP() returned error: %v", err)
+			}
+			if sensor.ExpectedTextIsRegex != tt.isRegex {
+				t.Errorf("Expected ExpectedTextIsRegex %t after read, got %t", tt.isRegex, sensor.ExpectedTextIsRegex)
+			}
+			if sensor.ReturnedParams["expectedtextregex"] != (tt.returnedParam != "") {
+				t.Errorf("Expected expectedtextregex returned: %t, got %v", tt.returnedParam != "", sensor.ReturnedParams)
+			}
+		})
+	}
+}
+
+func TestClient_SensorHTTP_HTTPMethod(t *testing.T) {
+	tests := []struct {
+		name          string
+		method        string
+		returnedParam string
+		expected      string
+	}{
+		{name: "head", method: "HEAD", returnedParam: `"method": "HEAD"`, expected: "HEAD"},
+		{name: "returned in lower case", method: "PUT", returnedParam: `"method": "put"`, expected: "PUT"},
+		{name: "unset uses the API default"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseForm(); err != nil {
+					t.Fatalf("Failed to parse form: %v", err)
+				}
+				w.Header().Set("Content-Type", "application/json")
+
+				switch r.FormValue("cmd") {
+				case "addHostSensor_HTTP":
+					if r.Form.Has("method") != (tt.method != "") {
+						t.Errorf("Expected method to be sent: %t, got form %v", tt.method != "", r.Form)
+					}
+					if got := r.FormValue("method"); got != tt.method {
+						t.Errorf("Expected method %q, got %q", tt.method, got)
+					}
+					fmt.Fprint(w, `{"errorcode": 0, "hostsensorid": 10}`)
+				case "getHostSensor":
+					params := `"url": "https://example.com"`
+					if tt.returnedParam != "" {
+						params += ", " + tt.returnedParam
+					}
+					fmt.Fprintf(w, `{"errorcode": 0, "sensor": {"hsid": "10", "sensorid": "2", "enabled": "1", "params": {%s}}}`, params)
+				default:
+					t.Errorf("Unexpected command %q", r.FormValue("cmd"))
+				}
+			}))
+			defer server.Close()
+
+			client, err := New(Options{
+				HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 10.0,
+				MaxRetries:        3,
+				InitialBackoff:    time.Second,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        30 * time.Second,
+			})
+			if err != nil {
+				t.Fatalf("New() returned error: %v", err)
+			}
+
+			if _, err := client.CreateSensorHTTP(t.Context(), &SensorHTTPCreateRequest{
+				HostID:     456,
+				URL:        "https://example.com",
+				HTTPMethod: tt.method,
+			}); err != nil {
+				t.Fatalf("CreateSensorHTTP() returned error: %v", err)
+			}
+
+			sensor, err := client.GetSensorHTTP(t.Context(), 456, 10)
+			if err != nil {
+				t.Fatalf("GetSensorHTTP() returned error: %v", err)
+			}
+			if sensor.HTTPMethod != tt.expected {
+				t.Errorf("Expected HTTPMethod %q after read, got %q", tt.expected, sensor.HTTPMethod)
+			}
+		})
+	}
+}
+
+func TestClient_ListSensorsHTTPBatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("cmd") != "getHostSensors" {
+			t.Errorf("Unexpected command %q", r.FormValue("cmd"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch hostID := r.FormValue("hostid"); hostID {
+		case "9":
+			fmt.Fprint(w, `{"errorcode": 1, "message": "Invalid hostid"}`)
+		default:
+			fmt.Fprintf(w, `{"errorcode": 0, "sensors": [{"hsid": "%s1", "sensorid": "2", "enabled": "1", "params": {"url": "https://%s.example.com"}}]}`, hostID, hostID)
+		}
+	}))
+	defer server.Close()
+
+	// A burst of 3 lists every host concurrently
+	client, err := New(Options{
+		HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 1000.0,
+		RequestsBurst:     3,
+		MaxRetries:        3,
+		InitialBackoff:    time.Millisecond,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	sensors, err := client.ListSensorsHTTPBatch(t.Context(), []int{4, 5, 6})
+	if err != nil {
+		t.Fatalf("ListSensorsHTTPBatch() returned error: %v", err)
+	}
+	if len(sensors) != 3 {
+		t.Fatalf("Expected 3 results, got %d", len(sensors))
+	}
+	for i, hostID := range []int{4, 5, 6} {
+		if len(sensors[i]) != 1 || sensors[i][0].ID != hostID*10+1 || sensors[i][0].HostID != hostID {
+			t.Errorf("Expected the sensor of host %d at index %d, got %+v", hostID, i, sensors[i])
+		}
+	}
+
+	sensors, err = client.ListSensorsHTTPBatch(t.Context(), []int{4, 9})
+	if err == nil {
+		t.Fatal("Expected an error, got nil")
+	}
+	if !strings.Contains(err.Error(), "host 9") || !strings.Contains(err.Error(), "Invalid hostid") {
+		t.Errorf("Expected the error to name host 9, got %q", err.Error())
+	}
+	if len(sensors[0]) != 1 || sensors[1] != nil {
+		t.Errorf("Expected only the sensors of host 4, got %+v", sensors)
+	}
+}
+
+func TestClient_SensorHTTP_PostContentType(t *testing.T) {
+	tests := []struct {
+		name            string
+		contentType     string
+		postParams      string
+		returnedParam   string
+		expectedPostRaw string
+	}{
+		{
+			name:            "json body is sent verbatim",
+			contentType:     SensorHTTPPostContentTypeJSON,
+			postParams:      `{"probe": true, "tags": ["a&b", "c=d"]}`,
+			returnedParam:   `"postcontenttype": "application/json"`,
+			expectedPostRaw: `{"probe": true, "tags": ["a&b", "c=d"]}`,
+		},
+		{
+			name:            "form params",
+			contentType:     SensorHTTPPostContentTypeForm,
+			postParams:      "probe=1&team=web",
+			returnedParam:   `"postcontenttype": "application/x-www-form-urlencoded"`,
+			expectedPostRaw: "probe=1&team=web",
+		},
+		{
+			name:            "unset uses the API default",
+			postParams:      "probe=1",
+			expectedPostRaw: "probe=1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseForm(); err != nil {
+					t.Fatalf("Failed to parse form: %v", err)
+				}
+				w.Header().Set("Content-Type", "application/json")
+
+				switch r.FormValue("cmd") {
+				case "addHostSensor_HTTP":
+					if r.Form.Has("postcontenttype") != (tt.contentType != "") {
+						t.Errorf("Expected postcontenttype to be sent: %t, got form %v", tt.contentType != "", r.Form)
+					}
+					if got := r.FormValue("postcontenttype"); got != tt.contentType {
+						t.Errorf("Expected postcontenttype %q, got %q", tt.contentType, got)
+					}
+					if got := r.FormValue("postparams"); got != tt.expectedPostRaw {
+						t.Errorf("Expected postparams %q, got %q", tt.expectedPostRaw, got)
+					}
+					fmt.Fprint(w, `{"errorcode": 0, "hostsensorid": 10}`)
+				case "getHostSensor":
+					params := `"url": "https://example.com"`
+					if tt.returnedParam != "" {
+						params += ", " + tt.returnedParam
+					}
+					fmt.Fprintf(w, `{"errorcode": 0, "sensor": {"hsid": "10", "sensorid": "2", "enabled": "1", "params": {%s}}}`, params)
+				default:
+					t.Errorf("Unexpected command %q", r.FormValue("cmd"))
+				}
+			}))
+			defer server.Close()
+
+			client, err := New(Options{
+				HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+				APIKey:            "test-api-key",
+				BaseURL:           server.URL,
+				UserAgent:         "test-agent/1.0",
+				RequestsPerSecond: 10.0,
+				MaxRetries:        3,
+				InitialBackoff:    time.Second,
+				BackoffMultiplier: 2.0,
+				MaxBackoff:        30 * time.Second,
+			})
+			if err != nil {
+				t.Fatalf("New() returned error: %v", err)
+			}
+
+			if _, err := client.CreateSensorHTTP(t.Context(), &SensorHTTPCreateRequest{
+				HostID:          456,
+				URL:             "https://example.com",
+				PostParams:      tt.postParams,
+				PostContentType: tt.contentType,
+			}); err != nil {
+				t.Fatalf("CreateSensorHTTP() returned error: %v", err)
+			}
+
+			sensor, err := client.GetSensorHTTP(t.Context(), 456, 10)
+			if err != nil {
+				t.Fatalf("GetSensorHTTP() returned error: %v", err)
+			}
+			if sensor.PostContentType != tt.contentType {
+				t.Errorf("Expected PostContentType %q after read, got %q", tt.contentType, sensor.PostContentType)
+			}
+		})
+	}
+}
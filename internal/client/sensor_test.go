@@ -0,0 +1,54 @@
+package client
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+)
+
+func TestClient_SensorCommands(t *testing.T) {
+	tests := []struct {
+		name    string
+		toggle  func(c *Client, hsid int) error
+		command string
+	}{
+		{name: "enable", toggle: func(c *Client, hsid int) error { return c.EnableSensor(t.Context(), hsid) }, command: "enableSensor"},
+		{name: "disable", toggle: func(c *Client, hsid int) error { return c.DisableSensor(t.Context(), hsid) }, command: "disableSensor"},
+		{name: "enable HTTP", toggle: func(c *Client, hsid int) error { return c.EnableSensorHTTP(t.Context(), hsid) }, command: "enableSensor"},
+		{name: "disable HTTP", toggle: func(c *Client, hsid int) error { return c.DisableSensorHTTP(t.Context(), hsid) }, command: "disableSensor"},
+		{name: "acknowledge", toggle: func(c *Client, hsid int) error { return c.AcknowledgeSensor(t.Context(), hsid) }, command: "acknowledgeSensorError"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []url.Values
+			client := newContactTestClient(t, `{"errorcode": 0}`, &requests)
+
+			if err := tt.toggle(client, 42); err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+
+			if len(requests) != 1 {
+				t.Fatalf("Expected 1 request, got %d", len(requests))
+			}
+			if got := requests[0].Get("cmd"); got != tt.command {
+				t.Errorf("Expected command %q, got %q", tt.command, got)
+			}
+			if got := requests[0].Get("hsid"); got != "42" {
+				t.Errorf("Expected hsid %q, got %q", "42", got)
+			}
+		})
+	}
+}
+
+func TestClient_EnableSensor_APIError(t *testing.T) {
+	var requests []url.Values
+	client := newContactTestClient(t, `{"errorcode": 1, "message": "Invalid hsid"}`, &requests)
+
+	err := client.EnableSensor(t.Context(), 42)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Message != "Invalid hsid" {
+		t.Errorf("Expected an *APIError for the errorcode, got: %v", err)
+	}
+}
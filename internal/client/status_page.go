@@ -0,0 +1,185 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// StatusPage represents a Wormly status page showing the state of a set of hosts.
+type StatusPage struct {
+	ID      int
+	Title   string
+	HostIDs []int
+	Public  bool
+}
+
+// WormlyStatusPageResponse represents the API response for status page operations.
+type WormlyStatusPageResponse struct {
+	ErrorCode    int         `json:"errorcode"`
+	Message      string      `json:"message,omitempty"`
+	StatusPageID json.Number `json:"statuspageid,omitempty"` // Can be returned as string or number
+}
+
+// WormlyGetStatusPagesResponse represents the API response for getStatusPages.
+type WormlyGetStatusPagesResponse struct {
+	ErrorCode   int    `json:"errorcode"`
+	Message     string `json:"message,omitempty"`
+	StatusPages []struct {
+		StatusPageID json.Number   `json:"statuspageid"` // Can be returned as string or number
+		Title        string        `json:"title"`
+		HostIDs      []json.Number `json:"hostids"` // Hosts can be returned as strings or numbers
+		Public       bool          `json:"public"`
+	} `json:"statuspages"`
+}
+
+// StatusPageAPI defines the interface for status page-related operations.
+type StatusPageAPI interface {
+	CreateStatusPage(ctx context.Context, title string, hostIDs []int) (*StatusPage, error)
+	GetStatusPage(ctx context.Context, id int) (*StatusPage, error)
+	UpdateStatusPage(ctx context.Context, id int, title string, hostIDs []int) (*StatusPage, error)
+	DeleteStatusPage(ctx context.Context, id int) error
+	PublishStatusPage(ctx context.Context, id int) error
+	UnpublishStatusPage(ctx context.Context, id int) error
+}
+
+// Ensure Client implements StatusPageAPI.
+var _ StatusPageAPI = (*Client)(nil)
+
+// CreateStatusPage creates a new status page showing the given hosts. Wormly
+// creates status pages private; use PublishStatusPage to make one public.
+func (c *Client) CreateStatusPage(ctx context.Context, title string, hostIDs []int) (*StatusPage, error) {
+	params := map[string]string{
+		"title":   title,
+		"hostids": formatHostIDs(hostIDs),
+	}
+
+	var response WormlyStatusPageResponse
+	if err := c.makeFormRequest(ctx, "addStatusPage", params, &response); err != nil {
+		return nil, fmt.Errorf("failed to create status page: %w", err)
+	}
+
+	if response.ErrorCode != 0 {
+		c.DebugLog(ctx, "CreateStatusPage API error response: %+v", response)
+		return nil, mapErrorCode(response.ErrorCode, response.Message)
+	}
+
+	id, err := strconv.Atoi(response.StatusPageID.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid statuspageid value: %s", response.StatusPageID)
+	}
+
+	return &StatusPage{
+		ID:      id,
+		Title:   title,
+		HostIDs: hostIDs,
+		Public:  false,
+	}, nil
+}
+
+// GetStatusPage retrieves a status page by ID.
+func (c *Client) GetStatusPage(ctx context.Context, id int) (*StatusPage, error) {
+	var response WormlyGetStatusPagesResponse
+	if err := c.makeFormRequestGET(ctx, "getStatusPages", nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get status page: %w", err)
+	}
+
+	if response.ErrorCode != 0 {
+		return nil, mapErrorCode(response.ErrorCode, response.Message)
+	}
+
+	for _, page := range response.StatusPages {
+		pageID, err := strconv.Atoi(page.StatusPageID.String())
+		if err != nil {
+			return nil, fmt.Errorf("invalid statuspageid value: %s", page.StatusPageID)
+		}
+		if pageID != id {
+			continue
+		}
+
+		hostIDs := make([]int, 0, len(page.HostIDs))
+		for _, hostID := range page.HostIDs {
+			parsed, err := strconv.Atoi(hostID.String())
+			if err != nil {
+				return nil, fmt.Errorf("invalid hostid value in status page %d: %s", id, hostID)
+			}
+			hostIDs = append(hostIDs, parsed)
+		}
+
+		return &StatusPage{
+			ID:      id,
+			Title:   page.Title,
+			HostIDs: hostIDs,
+			Public:  page.Public,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("status page with ID %d %w", id, ErrNotFound)
+}
+
+// UpdateStatusPage changes the title and hosts of an existing status page.
+// Wormly updates a status page when addStatusPage is called with an existing statuspageid.
+func (c *Client) UpdateStatusPage(ctx context.Context, id int, title string, hostIDs []int) (*StatusPage, error) {
+	params := map[string]string{
+		"statuspageid": strconv.Itoa(id),
+		"title":        title,
+		"hostids":      formatHostIDs(hostIDs),
+	}
+
+	var response WormlyStatusPageResponse
+	if err := c.makeFormRequest(ctx, "addStatusPage", params, &response); err != nil {
+		return nil, fmt.Errorf("failed to update status page: %w", err)
+	}
+
+	if response.ErrorCode != 0 {
+		c.DebugLog(ctx, "UpdateStatusPage API error response: %+v", response)
+		return nil, mapErrorCode(response.ErrorCode, response.Message)
+	}
+
+	return c.GetStatusPage(ctx, id)
+}
+
+// DeleteStatusPage deletes a status page by ID. The hosts it shows are not deleted.
+func (c *Client) DeleteStatusPage(ctx context.Context, id int) error {
+	return c.statusPageCommand(ctx, "deleteStatusPage", "delete", id)
+}
+
+// PublishStatusPage makes a status page publicly accessible.
+func (c *Client) PublishStatusPage(ctx context.Context, id int) error {
+	return c.statusPageCommand(ctx, "publishStatusPage", "publish", id)
+}
+
+// UnpublishStatusPage makes a status page private again.
+func (c *Client) UnpublishStatusPage(ctx context.Context, id int) error {
+	return c.statusPageCommand(ctx, "unpublishStatusPage", "unpublish", id)
+}
+
+// statusPageCommand sends a command that acts on a single status page; action
+// describes it in errors.
+func (c *Client) statusPageCommand(ctx context.Context, command, action string, id int) error {
+	params := map[string]string{
+		"statuspageid": strconv.Itoa(id),
+	}
+
+	var response WormlyStatusPageResponse
+	if err := c.makeFormRequest(ctx, command, params, &response); err != nil {
+		return fmt.Errorf("failed to %s status page: %w", action, err)
+	}
+
+	if response.ErrorCode != 0 {
+		return mapErrorCode(response.ErrorCode, response.Message)
+	}
+
+	return nil
+}
+
+// formatHostIDs formats host IDs as the comma-separated list the API expects.
+func formatHostIDs(hostIDs []int) string {
+	values := make([]string, 0, len(hostIDs))
+	for _, hostID := range hostIDs {
+		values = append(values, strconv.Itoa(hostID))
+	}
+	return strings.Join(values, ",")
+}
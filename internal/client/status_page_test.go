@@ -0,0 +1,120 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_StatusPageCRUD(t *testing.T) {
+	assert := assert.New(t)
+
+	// The server keeps a single status page, so reads reflect earlier writes
+	var requests []url.Values
+	title, hostIDs, public, deleted := "", "", false, false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse form: %v", err)
+		}
+		requests = append(requests, r.Form)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.FormValue("cmd") {
+		case "addStatusPage":
+			title, hostIDs = r.FormValue("title"), r.FormValue("hostids")
+			fmt.Fprint(w, `{"errorcode": 0, "statuspageid": "42"}`)
+		case "getStatusPages":
+			if deleted {
+				fmt.Fprint(w, `{"errorcode": 0, "statuspages": []}`)
+				return
+			}
+			fmt.Fprintf(w, `{"errorcode": 0, "statuspages": [{"statuspageid": 42, "title": %q, "hostids": ["%s"], "public": %t}]}`,
+				title, hostIDs, public)
+		case "publishStatusPage":
+			public = true
+			fmt.Fprint(w, `{"errorcode": 0}`)
+		case "unpublishStatusPage":
+			public = false
+			fmt.Fprint(w, `{"errorcode": 0}`)
+		case "deleteStatusPage":
+			deleted = true
+			fmt.Fprint(w, `{"errorcode": 0}`)
+		default:
+			t.Errorf("Unexpected command %q", r.FormValue("cmd"))
+		}
+	}))
+	defer server.Close()
+
+	client, err := New(Options{
+		HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 10.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+	})
+	assert.NoError(err, "Failed to create client")
+
+	page, err := client.CreateStatusPage(t.Context(), "Service status", []int{123})
+	assert.NoError(err)
+	assert.Equal(&StatusPage{ID: 42, Title: "Service status", HostIDs: []int{123}}, page)
+
+	assert.NoError(client.PublishStatusPage(t.Context(), 42))
+
+	page, err = client.GetStatusPage(t.Context(), 42)
+	assert.NoError(err)
+	assert.Equal(&StatusPage{ID: 42, Title: "Service status", HostIDs: []int{123}, Public: true}, page)
+
+	page, err = client.UpdateStatusPage(t.Context(), 42, "Status", []int{456})
+	assert.NoError(err)
+	assert.Equal(&StatusPage{ID: 42, Title: "Status", HostIDs: []int{456}, Public: true}, page)
+
+	assert.NoError(client.UnpublishStatusPage(t.Context(), 42))
+	assert.NoError(client.DeleteStatusPage(t.Context(), 42))
+
+	_, err = client.GetStatusPage(t.Context(), 42)
+	assert.True(errors.Is(err, ErrNotFound), "Expected ErrNotFound, got: %v", err)
+
+	var commands []string
+	for _, request := range requests {
+		commands = append(commands, request.Get("cmd"))
+	}
+	assert.Equal([]string{
+		"addStatusPage", "publishStatusPage", "getStatusPages", "addStatusPage", "getStatusPages",
+		"unpublishStatusPage", "deleteStatusPage", "getStatusPages",
+	}, commands)
+	assert.Empty(requests[0].Get("statuspageid"))
+	assert.Equal("42", requests[3].Get("statuspageid"))
+	assert.Equal("42", requests[6].Get("statuspageid"))
+}
+
+func TestClient_CreateStatusPage_HostIDs(t *testing.T) {
+	var requests []url.Values
+	client := newContactTestClient(t, `{"errorcode": 0, "statuspageid": 42}`, &requests)
+
+	_, err := client.CreateStatusPage(t.Context(), "Service status", []int{1, 2, 3})
+
+	assert.NoError(t, err)
+	if assert.Len(t, requests, 1) {
+		assert.Equal(t, "1,2,3", requests[0].Get("hostids"))
+	}
+}
+
+func TestClient_StatusPage_APIError(t *testing.T) {
+	var requests []url.Values
+	client := newContactTestClient(t, `{"errorcode": 2, "message": "Invalid hostid"}`, &requests)
+
+	_, err := client.CreateStatusPage(t.Context(), "Service status", []int{999})
+
+	assert.ErrorIs(t, err, ErrInvalidHost)
+	assert.ErrorContains(t, err, "API returned error code 2: Invalid hostid")
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// formatSensorID builds a sensor ID in format "host_id/sensor_id".
+func formatSensorID(hostID, sensorID int) string {
+	return fmt.Sprintf("%d/%d", hostID, sensorID)
+}
+
+// parseSensorID parses a sensor ID in format "host_id/sensor_id" and returns the components.
+func parseSensorID(id string) (hostID int, sensorID int, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid ID format, expected host_id/sensor_id")
+	}
+
+	hostID, err = strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid host_id: %s", err)
+	}
+
+	sensorID, err = strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid sensor_id: %s", err)
+	}
+
+	return hostID, sensorID, nil
+}
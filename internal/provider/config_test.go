@@ -1,16 +1,30 @@
 package provider
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/radarnex/terraform-provider-wormly/internal/client"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestProvider_Configure(t *testing.T) {
+	// Keep the missing api key case from picking up a key from the environment
+	t.Setenv(apiKeyEnvVar, "")
+
 	tests := []struct {
 		name           string
 		config         map[string]tftypes.Value
@@ -20,15 +34,30 @@ func TestProvider_Configure(t *testing.T) {
 		{
 			name: "default configuration",
 			config: map[string]tftypes.Value{
-				"api_key":             tftypes.NewValue(tftypes.String, "test-api-key"),
-				"base_url":            tftypes.NewValue(tftypes.String, nil),
-				"requests_per_second": tftypes.NewValue(tftypes.Number, nil),
-				"max_retries":         tftypes.NewValue(tftypes.Number, nil),
-				"initial_backoff":     tftypes.NewValue(tftypes.String, nil),
-				"backoff_multiplier":  tftypes.NewValue(tftypes.Number, nil),
-				"max_backoff":         tftypes.NewValue(tftypes.String, nil),
-				"user_agent":          tftypes.NewValue(tftypes.String, nil),
-				"debug":               tftypes.NewValue(tftypes.Bool, nil),
+				"api_key":                      tftypes.NewValue(tftypes.String, "test-api-key"),
+				"base_url":                     tftypes.NewValue(tftypes.String, nil),
+				"requests_per_second":          tftypes.NewValue(tftypes.Number, nil),
+				"max_retries":                  tftypes.NewValue(tftypes.Number, nil),
+				"initial_backoff":              tftypes.NewValue(tftypes.String, nil),
+				"backoff_multiplier":           tftypes.NewValue(tftypes.Number, nil),
+				"max_backoff":                  tftypes.NewValue(tftypes.String, nil),
+				"request_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"user_agent":                   tftypes.NewValue(tftypes.String, nil),
+				"debug":                        tftypes.NewValue(tftypes.Bool, nil),
+				"proxy_url":                    tftypes.NewValue(tftypes.String, nil),
+				"insecure_skip_verify":         tftypes.NewValue(tftypes.Bool, nil),
+				"retry_strategy":               tftypes.NewValue(tftypes.String, nil),
+				"requests_burst":               tftypes.NewValue(tftypes.Number, nil),
+				"retry_on_status":              tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, nil),
+				"max_response_bytes":           tftypes.NewValue(tftypes.Number, nil),
+				"eventual_consistency_retries": tftypes.NewValue(tftypes.Number, nil),
+				"circuit_breaker_threshold":    tftypes.NewValue(tftypes.Number, nil),
+				"command_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"response_format":              tftypes.NewValue(tftypes.String, nil),
+				"max_idle_conns":               tftypes.NewValue(tftypes.Number, nil),
+				"max_idle_conns_per_host":      tftypes.NewValue(tftypes.Number, nil),
+				"default_test_interval":        tftypes.NewValue(tftypes.Number, nil),
+				"verify_credentials":           tftypes.NewValue(tftypes.Bool, nil),
 			},
 			expectedConfig: Config{
 				APIKey:            "test-api-key",
@@ -38,6 +67,7 @@ func TestProvider_Configure(t *testing.T) {
 				InitialBackoff:    time.Second,
 				BackoffMultiplier: 2.0,
 				MaxBackoff:        30 * time.Second,
+				RequestTimeout:    30 * time.Second,
 				UserAgent:         "terraform-provider-wormly/dev",
 				Debug:             false,
 			},
@@ -46,15 +76,30 @@ func TestProvider_Configure(t *testing.T) {
 		{
 			name: "custom configuration",
 			config: map[string]tftypes.Value{
-				"api_key":             tftypes.NewValue(tftypes.String, "custom-api-key"),
-				"base_url":            tftypes.NewValue(tftypes.String, "https://custom.api.com"),
-				"requests_per_second": tftypes.NewValue(tftypes.Number, 5.0),
-				"max_retries":         tftypes.NewValue(tftypes.Number, 5),
-				"initial_backoff":     tftypes.NewValue(tftypes.String, "2s"),
-				"backoff_multiplier":  tftypes.NewValue(tftypes.Number, 1.5),
-				"max_backoff":         tftypes.NewValue(tftypes.String, "60s"),
-				"user_agent":          tftypes.NewValue(tftypes.String, "custom-agent"),
-				"debug":               tftypes.NewValue(tftypes.Bool, true),
+				"api_key":                      tftypes.NewValue(tftypes.String, "custom-api-key"),
+				"base_url":                     tftypes.NewValue(tftypes.String, "https://custom.api.com"),
+				"requests_per_second":          tftypes.NewValue(tftypes.Number, 5.0),
+				"max_retries":                  tftypes.NewValue(tftypes.Number, 5),
+				"initial_backoff":              tftypes.NewValue(tftypes.String, "2s"),
+				"backoff_multiplier":           tftypes.NewValue(tftypes.Number, 1.5),
+				"max_backoff":                  tftypes.NewValue(tftypes.String, "60s"),
+				"request_timeout":              tftypes.NewValue(tftypes.String, "45s"),
+				"user_agent":                   tftypes.NewValue(tftypes.String, "custom-agent"),
+				"debug":                        tftypes.NewValue(tftypes.Bool, true),
+				"proxy_url":                    tftypes.NewValue(tftypes.String, nil),
+				"insecure_skip_verify":         tftypes.NewValue(tftypes.Bool, nil),
+				"retry_strategy":               tftypes.NewValue(tftypes.String, nil),
+				"requests_burst":               tftypes.NewValue(tftypes.Number, nil),
+				"retry_on_status":              tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, nil),
+				"max_response_bytes":           tftypes.NewValue(tftypes.Number, nil),
+				"eventual_consistency_retries": tftypes.NewValue(tftypes.Number, nil),
+				"circuit_breaker_threshold":    tftypes.NewValue(tftypes.Number, nil),
+				"command_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"response_format":              tftypes.NewValue(tftypes.String, nil),
+				"max_idle_conns":               tftypes.NewValue(tftypes.Number, nil),
+				"max_idle_conns_per_host":      tftypes.NewValue(tftypes.Number, nil),
+				"default_test_interval":        tftypes.NewValue(tftypes.Number, nil),
+				"verify_credentials":           tftypes.NewValue(tftypes.Bool, nil),
 			},
 			expectedConfig: Config{
 				APIKey:            "custom-api-key",
@@ -64,6 +109,7 @@ func TestProvider_Configure(t *testing.T) {
 				InitialBackoff:    2 * time.Second,
 				BackoffMultiplier: 1.5,
 				MaxBackoff:        60 * time.Second,
+				RequestTimeout:    45 * time.Second,
 				UserAgent:         "custom-agent",
 				Debug:             true,
 			},
@@ -72,45 +118,450 @@ func TestProvider_Configure(t *testing.T) {
 		{
 			name: "invalid initial backoff",
 			config: map[string]tftypes.Value{
-				"api_key":             tftypes.NewValue(tftypes.String, "test-api-key"),
-				"base_url":            tftypes.NewValue(tftypes.String, nil),
-				"requests_per_second": tftypes.NewValue(tftypes.Number, nil),
-				"max_retries":         tftypes.NewValue(tftypes.Number, nil),
-				"initial_backoff":     tftypes.NewValue(tftypes.String, "invalid-duration"),
-				"backoff_multiplier":  tftypes.NewValue(tftypes.Number, nil),
-				"max_backoff":         tftypes.NewValue(tftypes.String, nil),
-				"user_agent":          tftypes.NewValue(tftypes.String, nil),
-				"debug":               tftypes.NewValue(tftypes.Bool, nil),
+				"api_key":                      tftypes.NewValue(tftypes.String, "test-api-key"),
+				"base_url":                     tftypes.NewValue(tftypes.String, nil),
+				"requests_per_second":          tftypes.NewValue(tftypes.Number, nil),
+				"max_retries":                  tftypes.NewValue(tftypes.Number, nil),
+				"initial_backoff":              tftypes.NewValue(tftypes.String, "invalid-duration"),
+				"backoff_multiplier":           tftypes.NewValue(tftypes.Number, nil),
+				"max_backoff":                  tftypes.NewValue(tftypes.String, nil),
+				"request_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"user_agent":                   tftypes.NewValue(tftypes.String, nil),
+				"debug":                        tftypes.NewValue(tftypes.Bool, nil),
+				"proxy_url":                    tftypes.NewValue(tftypes.String, nil),
+				"insecure_skip_verify":         tftypes.NewValue(tftypes.Bool, nil),
+				"retry_strategy":               tftypes.NewValue(tftypes.String, nil),
+				"requests_burst":               tftypes.NewValue(tftypes.Number, nil),
+				"retry_on_status":              tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, nil),
+				"max_response_bytes":           tftypes.NewValue(tftypes.Number, nil),
+				"eventual_consistency_retries": tftypes.NewValue(tftypes.Number, nil),
+				"circuit_breaker_threshold":    tftypes.NewValue(tftypes.Number, nil),
+				"command_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"response_format":              tftypes.NewValue(tftypes.String, nil),
+				"max_idle_conns":               tftypes.NewValue(tftypes.Number, nil),
+				"max_idle_conns_per_host":      tftypes.NewValue(tftypes.Number, nil),
+				"default_test_interval":        tftypes.NewValue(tftypes.Number, nil),
+				"verify_credentials":           tftypes.NewValue(tftypes.Bool, nil),
 			},
 			expectError: true,
 		},
 		{
 			name: "invalid max backoff",
 			config: map[string]tftypes.Value{
-				"api_key":             tftypes.NewValue(tftypes.String, "test-api-key"),
-				"base_url":            tftypes.NewValue(tftypes.String, nil),
-				"requests_per_second": tftypes.NewValue(tftypes.Number, nil),
-				"max_retries":         tftypes.NewValue(tftypes.Number, nil),
-				"initial_backoff":     tftypes.NewValue(tftypes.String, nil),
-				"backoff_multiplier":  tftypes.NewValue(tftypes.Number, nil),
-				"max_backoff":         tftypes.NewValue(tftypes.String, "invalid-duration"),
-				"user_agent":          tftypes.NewValue(tftypes.String, nil),
-				"debug":               tftypes.NewValue(tftypes.Bool, nil),
+				"api_key":                      tftypes.NewValue(tftypes.String, "test-api-key"),
+				"base_url":                     tftypes.NewValue(tftypes.String, nil),
+				"requests_per_second":          tftypes.NewValue(tftypes.Number, nil),
+				"max_retries":                  tftypes.NewValue(tftypes.Number, nil),
+				"initial_backoff":              tftypes.NewValue(tftypes.String, nil),
+				"backoff_multiplier":           tftypes.NewValue(tftypes.Number, nil),
+				"max_backoff":                  tftypes.NewValue(tftypes.String, "invalid-duration"),
+				"request_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"user_agent":                   tftypes.NewValue(tftypes.String, nil),
+				"debug":                        tftypes.NewValue(tftypes.Bool, nil),
+				"proxy_url":                    tftypes.NewValue(tftypes.String, nil),
+				"insecure_skip_verify":         tftypes.NewValue(tftypes.Bool, nil),
+				"retry_strategy":               tftypes.NewValue(tftypes.String, nil),
+				"requests_burst":               tftypes.NewValue(tftypes.Number, nil),
+				"retry_on_status":              tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, nil),
+				"max_response_bytes":           tftypes.NewValue(tftypes.Number, nil),
+				"eventual_consistency_retries": tftypes.NewValue(tftypes.Number, nil),
+				"circuit_breaker_threshold":    tftypes.NewValue(tftypes.Number, nil),
+				"command_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"response_format":              tftypes.NewValue(tftypes.String, nil),
+				"max_idle_conns":               tftypes.NewValue(tftypes.Number, nil),
+				"max_idle_conns_per_host":      tftypes.NewValue(tftypes.Number, nil),
+				"default_test_interval":        tftypes.NewValue(tftypes.Number, nil),
+				"verify_credentials":           tftypes.NewValue(tftypes.Bool, nil),
+			},
+			expectError: true,
+		},
+		{
+			name: "custom request timeout",
+			config: map[string]tftypes.Value{
+				"api_key":                      tftypes.NewValue(tftypes.String, "test-api-key"),
+				"base_url":                     tftypes.NewValue(tftypes.String, nil),
+				"requests_per_second":          tftypes.NewValue(tftypes.Number, nil),
+				"max_retries":                  tftypes.NewValue(tftypes.Number, nil),
+				"initial_backoff":              tftypes.NewValue(tftypes.String, nil),
+				"backoff_multiplier":           tftypes.NewValue(tftypes.Number, nil),
+				"max_backoff":                  tftypes.NewValue(tftypes.String, nil),
+				"request_timeout":              tftypes.NewValue(tftypes.String, "90s"),
+				"user_agent":                   tftypes.NewValue(tftypes.String, nil),
+				"debug":                        tftypes.NewValue(tftypes.Bool, nil),
+				"proxy_url":                    tftypes.NewValue(tftypes.String, nil),
+				"insecure_skip_verify":         tftypes.NewValue(tftypes.Bool, nil),
+				"retry_strategy":               tftypes.NewValue(tftypes.String, nil),
+				"requests_burst":               tftypes.NewValue(tftypes.Number, nil),
+				"retry_on_status":              tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, nil),
+				"max_response_bytes":           tftypes.NewValue(tftypes.Number, nil),
+				"eventual_consistency_retries": tftypes.NewValue(tftypes.Number, nil),
+				"circuit_breaker_threshold":    tftypes.NewValue(tftypes.Number, nil),
+				"command_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"response_format":              tftypes.NewValue(tftypes.String, nil),
+				"max_idle_conns":               tftypes.NewValue(tftypes.Number, nil),
+				"max_idle_conns_per_host":      tftypes.NewValue(tftypes.Number, nil),
+				"default_test_interval":        tftypes.NewValue(tftypes.Number, nil),
+				"verify_credentials":           tftypes.NewValue(tftypes.Bool, nil),
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid request timeout",
+			config: map[string]tftypes.Value{
+				"api_key":                      tftypes.NewValue(tftypes.String, "test-api-key"),
+				"base_url":                     tftypes.NewValue(tftypes.String, nil),
+				"requests_per_second":          tftypes.NewValue(tftypes.Number, nil),
+				"max_retries":                  tftypes.NewValue(tftypes.Number, nil),
+				"initial_backoff":              tftypes.NewValue(tftypes.String, nil),
+				"backoff_multiplier":           tftypes.NewValue(tftypes.Number, nil),
+				"max_backoff":                  tftypes.NewValue(tftypes.String, nil),
+				"request_timeout":              tftypes.NewValue(tftypes.String, "invalid-duration"),
+				"user_agent":                   tftypes.NewValue(tftypes.String, nil),
+				"debug":                        tftypes.NewValue(tftypes.Bool, nil),
+				"proxy_url":                    tftypes.NewValue(tftypes.String, nil),
+				"insecure_skip_verify":         tftypes.NewValue(tftypes.Bool, nil),
+				"retry_strategy":               tftypes.NewValue(tftypes.String, nil),
+				"requests_burst":               tftypes.NewValue(tftypes.Number, nil),
+				"retry_on_status":              tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, nil),
+				"max_response_bytes":           tftypes.NewValue(tftypes.Number, nil),
+				"eventual_consistency_retries": tftypes.NewValue(tftypes.Number, nil),
+				"circuit_breaker_threshold":    tftypes.NewValue(tftypes.Number, nil),
+				"command_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"response_format":              tftypes.NewValue(tftypes.String, nil),
+				"max_idle_conns":               tftypes.NewValue(tftypes.Number, nil),
+				"max_idle_conns_per_host":      tftypes.NewValue(tftypes.Number, nil),
+				"default_test_interval":        tftypes.NewValue(tftypes.Number, nil),
+				"verify_credentials":           tftypes.NewValue(tftypes.Bool, nil),
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid proxy url",
+			config: map[string]tftypes.Value{
+				"api_key":                      tftypes.NewValue(tftypes.String, "test-api-key"),
+				"base_url":                     tftypes.NewValue(tftypes.String, nil),
+				"requests_per_second":          tftypes.NewValue(tftypes.Number, nil),
+				"max_retries":                  tftypes.NewValue(tftypes.Number, nil),
+				"initial_backoff":              tftypes.NewValue(tftypes.String, nil),
+				"backoff_multiplier":           tftypes.NewValue(tftypes.Number, nil),
+				"max_backoff":                  tftypes.NewValue(tftypes.String, nil),
+				"request_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"user_agent":                   tftypes.NewValue(tftypes.String, nil),
+				"debug":                        tftypes.NewValue(tftypes.Bool, nil),
+				"proxy_url":                    tftypes.NewValue(tftypes.String, "not a url"),
+				"insecure_skip_verify":         tftypes.NewValue(tftypes.Bool, nil),
+				"retry_strategy":               tftypes.NewValue(tftypes.String, nil),
+				"requests_burst":               tftypes.NewValue(tftypes.Number, nil),
+				"retry_on_status":              tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, nil),
+				"max_response_bytes":           tftypes.NewValue(tftypes.Number, nil),
+				"eventual_consistency_retries": tftypes.NewValue(tftypes.Number, nil),
+				"circuit_breaker_threshold":    tftypes.NewValue(tftypes.Number, nil),
+				"command_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"response_format":              tftypes.NewValue(tftypes.String, nil),
+				"max_idle_conns":               tftypes.NewValue(tftypes.Number, nil),
+				"max_idle_conns_per_host":      tftypes.NewValue(tftypes.Number, nil),
+				"default_test_interval":        tftypes.NewValue(tftypes.Number, nil),
+				"verify_credentials":           tftypes.NewValue(tftypes.Bool, nil),
+			},
+			expectError: true,
+		},
+		{
+			name: "constant retry strategy",
+			config: map[string]tftypes.Value{
+				"api_key":                      tftypes.NewValue(tftypes.String, "test-api-key"),
+				"base_url":                     tftypes.NewValue(tftypes.String, nil),
+				"requests_per_second":          tftypes.NewValue(tftypes.Number, nil),
+				"max_retries":                  tftypes.NewValue(tftypes.Number, nil),
+				"initial_backoff":              tftypes.NewValue(tftypes.String, nil),
+				"backoff_multiplier":           tftypes.NewValue(tftypes.Number, nil),
+				"max_backoff":                  tftypes.NewValue(tftypes.String, nil),
+				"request_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"user_agent":                   tftypes.NewValue(tftypes.String, nil),
+				"debug":                        tftypes.NewValue(tftypes.Bool, nil),
+				"proxy_url":                    tftypes.NewValue(tftypes.String, nil),
+				"insecure_skip_verify":         tftypes.NewValue(tftypes.Bool, nil),
+				"retry_strategy":               tftypes.NewValue(tftypes.String, "constant"),
+				"requests_burst":               tftypes.NewValue(tftypes.Number, nil),
+				"retry_on_status":              tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, nil),
+				"max_response_bytes":           tftypes.NewValue(tftypes.Number, nil),
+				"eventual_consistency_retries": tftypes.NewValue(tftypes.Number, nil),
+				"circuit_breaker_threshold":    tftypes.NewValue(tftypes.Number, nil),
+				"command_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"response_format":              tftypes.NewValue(tftypes.String, nil),
+				"max_idle_conns":               tftypes.NewValue(tftypes.Number, nil),
+				"max_idle_conns_per_host":      tftypes.NewValue(tftypes.Number, nil),
+				"default_test_interval":        tftypes.NewValue(tftypes.Number, nil),
+				"verify_credentials":           tftypes.NewValue(tftypes.Bool, nil),
+			},
+			expectError: false,
+		},
+		{
+			name: "custom requests burst",
+			config: map[string]tftypes.Value{
+				"api_key":                      tftypes.NewValue(tftypes.String, "test-api-key"),
+				"base_url":                     tftypes.NewValue(tftypes.String, nil),
+				"requests_per_second":          tftypes.NewValue(tftypes.Number, nil),
+				"max_retries":                  tftypes.NewValue(tftypes.Number, nil),
+				"initial_backoff":              tftypes.NewValue(tftypes.String, nil),
+				"backoff_multiplier":           tftypes.NewValue(tftypes.Number, nil),
+				"max_backoff":                  tftypes.NewValue(tftypes.String, nil),
+				"request_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"user_agent":                   tftypes.NewValue(tftypes.String, nil),
+				"debug":                        tftypes.NewValue(tftypes.Bool, nil),
+				"proxy_url":                    tftypes.NewValue(tftypes.String, nil),
+				"insecure_skip_verify":         tftypes.NewValue(tftypes.Bool, nil),
+				"retry_strategy":               tftypes.NewValue(tftypes.String, nil),
+				"requests_burst":               tftypes.NewValue(tftypes.Number, 5),
+				"retry_on_status":              tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, nil),
+				"max_response_bytes":           tftypes.NewValue(tftypes.Number, nil),
+				"eventual_consistency_retries": tftypes.NewValue(tftypes.Number, nil),
+				"circuit_breaker_threshold":    tftypes.NewValue(tftypes.Number, nil),
+				"command_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"response_format":              tftypes.NewValue(tftypes.String, nil),
+				"max_idle_conns":               tftypes.NewValue(tftypes.Number, nil),
+				"max_idle_conns_per_host":      tftypes.NewValue(tftypes.Number, nil),
+				"default_test_interval":        tftypes.NewValue(tftypes.Number, nil),
+				"verify_credentials":           tftypes.NewValue(tftypes.Bool, nil),
+			},
+			expectError: false,
+		},
+		{
+			name: "custom retry status codes",
+			config: map[string]tftypes.Value{
+				"api_key":                      tftypes.NewValue(tftypes.String, "test-api-key"),
+				"base_url":                     tftypes.NewValue(tftypes.String, nil),
+				"requests_per_second":          tftypes.NewValue(tftypes.Number, nil),
+				"max_retries":                  tftypes.NewValue(tftypes.Number, nil),
+				"initial_backoff":              tftypes.NewValue(tftypes.String, nil),
+				"backoff_multiplier":           tftypes.NewValue(tftypes.Number, nil),
+				"max_backoff":                  tftypes.NewValue(tftypes.String, nil),
+				"request_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"user_agent":                   tftypes.NewValue(tftypes.String, nil),
+				"debug":                        tftypes.NewValue(tftypes.Bool, nil),
+				"proxy_url":                    tftypes.NewValue(tftypes.String, nil),
+				"insecure_skip_verify":         tftypes.NewValue(tftypes.Bool, nil),
+				"retry_strategy":               tftypes.NewValue(tftypes.String, nil),
+				"requests_burst":               tftypes.NewValue(tftypes.Number, nil),
+				"retry_on_status":              tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, []tftypes.Value{tftypes.NewValue(tftypes.Number, 408), tftypes.NewValue(tftypes.Number, 503)}),
+				"max_response_bytes":           tftypes.NewValue(tftypes.Number, nil),
+				"eventual_consistency_retries": tftypes.NewValue(tftypes.Number, nil),
+				"circuit_breaker_threshold":    tftypes.NewValue(tftypes.Number, nil),
+				"command_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"response_format":              tftypes.NewValue(tftypes.String, nil),
+				"max_idle_conns":               tftypes.NewValue(tftypes.Number, nil),
+				"max_idle_conns_per_host":      tftypes.NewValue(tftypes.Number, nil),
+				"default_test_interval":        tftypes.NewValue(tftypes.Number, nil),
+				"verify_credentials":           tftypes.NewValue(tftypes.Bool, nil),
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid retry status code",
+			config: map[string]tftypes.Value{
+				"api_key":                      tftypes.NewValue(tftypes.String, "test-api-key"),
+				"base_url":                     tftypes.NewValue(tftypes.String, nil),
+				"requests_per_second":          tftypes.NewValue(tftypes.Number, nil),
+				"max_retries":                  tftypes.NewValue(tftypes.Number, nil),
+				"initial_backoff":              tftypes.NewValue(tftypes.String, nil),
+				"backoff_multiplier":           tftypes.NewValue(tftypes.Number, nil),
+				"max_backoff":                  tftypes.NewValue(tftypes.String, nil),
+				"request_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"user_agent":                   tftypes.NewValue(tftypes.String, nil),
+				"debug":                        tftypes.NewValue(tftypes.Bool, nil),
+				"proxy_url":                    tftypes.NewValue(tftypes.String, nil),
+				"insecure_skip_verify":         tftypes.NewValue(tftypes.Bool, nil),
+				"retry_strategy":               tftypes.NewValue(tftypes.String, nil),
+				"requests_burst":               tftypes.NewValue(tftypes.Number, nil),
+				"retry_on_status":              tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, []tftypes.Value{tftypes.NewValue(tftypes.Number, 42)}),
+				"max_response_bytes":           tftypes.NewValue(tftypes.Number, nil),
+				"eventual_consistency_retries": tftypes.NewValue(tftypes.Number, nil),
+				"circuit_breaker_threshold":    tftypes.NewValue(tftypes.Number, nil),
+				"command_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"response_format":              tftypes.NewValue(tftypes.String, nil),
+				"max_idle_conns":               tftypes.NewValue(tftypes.Number, nil),
+				"max_idle_conns_per_host":      tftypes.NewValue(tftypes.Number, nil),
+				"default_test_interval":        tftypes.NewValue(tftypes.Number, nil),
+				"verify_credentials":           tftypes.NewValue(tftypes.Bool, nil),
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid requests burst",
+			config: map[string]tftypes.Value{
+				"api_key":                      tftypes.NewValue(tftypes.String, "test-api-key"),
+				"base_url":                     tftypes.NewValue(tftypes.String, nil),
+				"requests_per_second":          tftypes.NewValue(tftypes.Number, nil),
+				"max_retries":                  tftypes.NewValue(tftypes.Number, nil),
+				"initial_backoff":              tftypes.NewValue(tftypes.String, nil),
+				"backoff_multiplier":           tftypes.NewValue(tftypes.Number, nil),
+				"max_backoff":                  tftypes.NewValue(tftypes.String, nil),
+				"request_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"user_agent":                   tftypes.NewValue(tftypes.String, nil),
+				"debug":                        tftypes.NewValue(tftypes.Bool, nil),
+				"proxy_url":                    tftypes.NewValue(tftypes.String, nil),
+				"insecure_skip_verify":         tftypes.NewValue(tftypes.Bool, nil),
+				"retry_strategy":               tftypes.NewValue(tftypes.String, nil),
+				"requests_burst":               tftypes.NewValue(tftypes.Number, 0),
+				"retry_on_status":              tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, nil),
+				"max_response_bytes":           tftypes.NewValue(tftypes.Number, nil),
+				"eventual_consistency_retries": tftypes.NewValue(tftypes.Number, nil),
+				"circuit_breaker_threshold":    tftypes.NewValue(tftypes.Number, nil),
+				"command_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"response_format":              tftypes.NewValue(tftypes.String, nil),
+				"max_idle_conns":               tftypes.NewValue(tftypes.Number, nil),
+				"max_idle_conns_per_host":      tftypes.NewValue(tftypes.Number, nil),
+				"default_test_interval":        tftypes.NewValue(tftypes.Number, nil),
+				"verify_credentials":           tftypes.NewValue(tftypes.Bool, nil),
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid max response bytes",
+			config: map[string]tftypes.Value{
+				"api_key":                      tftypes.NewValue(tftypes.String, "test-api-key"),
+				"base_url":                     tftypes.NewValue(tftypes.String, nil),
+				"requests_per_second":          tftypes.NewValue(tftypes.Number, nil),
+				"max_retries":                  tftypes.NewValue(tftypes.Number, nil),
+				"initial_backoff":              tftypes.NewValue(tftypes.String, nil),
+				"backoff_multiplier":           tftypes.NewValue(tftypes.Number, nil),
+				"max_backoff":                  tftypes.NewValue(tftypes.String, nil),
+				"request_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"user_agent":                   tftypes.NewValue(tftypes.String, nil),
+				"debug":                        tftypes.NewValue(tftypes.Bool, nil),
+				"proxy_url":                    tftypes.NewValue(tftypes.String, nil),
+				"insecure_skip_verify":         tftypes.NewValue(tftypes.Bool, nil),
+				"retry_strategy":               tftypes.NewValue(tftypes.String, nil),
+				"requests_burst":               tftypes.NewValue(tftypes.Number, nil),
+				"retry_on_status":              tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, nil),
+				"max_response_bytes":           tftypes.NewValue(tftypes.Number, 0),
+				"eventual_consistency_retries": tftypes.NewValue(tftypes.Number, nil),
+				"circuit_breaker_threshold":    tftypes.NewValue(tftypes.Number, nil),
+				"command_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"response_format":              tftypes.NewValue(tftypes.String, nil),
+				"max_idle_conns":               tftypes.NewValue(tftypes.Number, nil),
+				"max_idle_conns_per_host":      tftypes.NewValue(tftypes.Number, nil),
+				"default_test_interval":        tftypes.NewValue(tftypes.Number, nil),
+				"verify_credentials":           tftypes.NewValue(tftypes.Bool, nil),
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid eventual consistency retries",
+			config: map[string]tftypes.Value{
+				"api_key":                      tftypes.NewValue(tftypes.String, "test-api-key"),
+				"base_url":                     tftypes.NewValue(tftypes.String, nil),
+				"requests_per_second":          tftypes.NewValue(tftypes.Number, nil),
+				"max_retries":                  tftypes.NewValue(tftypes.Number, nil),
+				"initial_backoff":              tftypes.NewValue(tftypes.String, nil),
+				"backoff_multiplier":           tftypes.NewValue(tftypes.Number, nil),
+				"max_backoff":                  tftypes.NewValue(tftypes.String, nil),
+				"request_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"user_agent":                   tftypes.NewValue(tftypes.String, nil),
+				"debug":                        tftypes.NewValue(tftypes.Bool, nil),
+				"proxy_url":                    tftypes.NewValue(tftypes.String, nil),
+				"insecure_skip_verify":         tftypes.NewValue(tftypes.Bool, nil),
+				"retry_strategy":               tftypes.NewValue(tftypes.String, nil),
+				"requests_burst":               tftypes.NewValue(tftypes.Number, nil),
+				"retry_on_status":              tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, nil),
+				"max_response_bytes":           tftypes.NewValue(tftypes.Number, nil),
+				"eventual_consistency_retries": tftypes.NewValue(tftypes.Number, -1),
+				"circuit_breaker_threshold":    tftypes.NewValue(tftypes.Number, nil),
+				"command_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"response_format":              tftypes.NewValue(tftypes.String, nil),
+				"max_idle_conns":               tftypes.NewValue(tftypes.Number, nil),
+				"max_idle_conns_per_host":      tftypes.NewValue(tftypes.Number, nil),
+				"default_test_interval":        tftypes.NewValue(tftypes.Number, nil),
+				"verify_credentials":           tftypes.NewValue(tftypes.Bool, nil),
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid circuit breaker threshold",
+			config: map[string]tftypes.Value{
+				"api_key":                      tftypes.NewValue(tftypes.String, "test-api-key"),
+				"base_url":                     tftypes.NewValue(tftypes.String, nil),
+				"requests_per_second":          tftypes.NewValue(tftypes.Number, nil),
+				"max_retries":                  tftypes.NewValue(tftypes.Number, nil),
+				"initial_backoff":              tftypes.NewValue(tftypes.String, nil),
+				"backoff_multiplier":           tftypes.NewValue(tftypes.Number, nil),
+				"max_backoff":                  tftypes.NewValue(tftypes.String, nil),
+				"request_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"user_agent":                   tftypes.NewValue(tftypes.String, nil),
+				"debug":                        tftypes.NewValue(tftypes.Bool, nil),
+				"proxy_url":                    tftypes.NewValue(tftypes.String, nil),
+				"insecure_skip_verify":         tftypes.NewValue(tftypes.Bool, nil),
+				"retry_strategy":               tftypes.NewValue(tftypes.String, nil),
+				"requests_burst":               tftypes.NewValue(tftypes.Number, nil),
+				"retry_on_status":              tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, nil),
+				"max_response_bytes":           tftypes.NewValue(tftypes.Number, nil),
+				"eventual_consistency_retries": tftypes.NewValue(tftypes.Number, nil),
+				"circuit_breaker_threshold":    tftypes.NewValue(tftypes.Number, -1),
+				"command_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"response_format":              tftypes.NewValue(tftypes.String, nil),
+				"max_idle_conns":               tftypes.NewValue(tftypes.Number, nil),
+				"max_idle_conns_per_host":      tftypes.NewValue(tftypes.Number, nil),
+				"default_test_interval":        tftypes.NewValue(tftypes.Number, nil),
+				"verify_credentials":           tftypes.NewValue(tftypes.Bool, nil),
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid retry strategy",
+			config: map[string]tftypes.Value{
+				"api_key":                      tftypes.NewValue(tftypes.String, "test-api-key"),
+				"base_url":                     tftypes.NewValue(tftypes.String, nil),
+				"requests_per_second":          tftypes.NewValue(tftypes.Number, nil),
+				"max_retries":                  tftypes.NewValue(tftypes.Number, nil),
+				"initial_backoff":              tftypes.NewValue(tftypes.String, nil),
+				"backoff_multiplier":           tftypes.NewValue(tftypes.Number, nil),
+				"max_backoff":                  tftypes.NewValue(tftypes.String, nil),
+				"request_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"user_agent":                   tftypes.NewValue(tftypes.String, nil),
+				"debug":                        tftypes.NewValue(tftypes.Bool, nil),
+				"proxy_url":                    tftypes.NewValue(tftypes.String, nil),
+				"insecure_skip_verify":         tftypes.NewValue(tftypes.Bool, nil),
+				"retry_strategy":               tftypes.NewValue(tftypes.String, "linear"),
+				"requests_burst":               tftypes.NewValue(tftypes.Number, nil),
+				"retry_on_status":              tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, nil),
+				"max_response_bytes":           tftypes.NewValue(tftypes.Number, nil),
+				"eventual_consistency_retries": tftypes.NewValue(tftypes.Number, nil),
+				"circuit_breaker_threshold":    tftypes.NewValue(tftypes.Number, nil),
+				"command_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"response_format":              tftypes.NewValue(tftypes.String, nil),
+				"max_idle_conns":               tftypes.NewValue(tftypes.Number, nil),
+				"max_idle_conns_per_host":      tftypes.NewValue(tftypes.Number, nil),
+				"default_test_interval":        tftypes.NewValue(tftypes.Number, nil),
+				"verify_credentials":           tftypes.NewValue(tftypes.Bool, nil),
 			},
 			expectError: true,
 		},
 		{
 			name: "missing api key",
 			config: map[string]tftypes.Value{
-				"api_key":             tftypes.NewValue(tftypes.String, ""),
-				"base_url":            tftypes.NewValue(tftypes.String, nil),
-				"requests_per_second": tftypes.NewValue(tftypes.Number, nil),
-				"max_retries":         tftypes.NewValue(tftypes.Number, nil),
-				"initial_backoff":     tftypes.NewValue(tftypes.String, nil),
-				"backoff_multiplier":  tftypes.NewValue(tftypes.Number, nil),
-				"max_backoff":         tftypes.NewValue(tftypes.String, nil),
-				"user_agent":          tftypes.NewValue(tftypes.String, nil),
-				"debug":               tftypes.NewValue(tftypes.Bool, nil),
+				"api_key":                      tftypes.NewValue(tftypes.String, ""),
+				"base_url":                     tftypes.NewValue(tftypes.String, nil),
+				"requests_per_second":          tftypes.NewValue(tftypes.Number, nil),
+				"max_retries":                  tftypes.NewValue(tftypes.Number, nil),
+				"initial_backoff":              tftypes.NewValue(tftypes.String, nil),
+				"backoff_multiplier":           tftypes.NewValue(tftypes.Number, nil),
+				"max_backoff":                  tftypes.NewValue(tftypes.String, nil),
+				"request_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"user_agent":                   tftypes.NewValue(tftypes.String, nil),
+				"debug":                        tftypes.NewValue(tftypes.Bool, nil),
+				"proxy_url":                    tftypes.NewValue(tftypes.String, nil),
+				"insecure_skip_verify":         tftypes.NewValue(tftypes.Bool, nil),
+				"retry_strategy":               tftypes.NewValue(tftypes.String, nil),
+				"requests_burst":               tftypes.NewValue(tftypes.Number, nil),
+				"retry_on_status":              tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, nil),
+				"max_response_bytes":           tftypes.NewValue(tftypes.Number, nil),
+				"eventual_consistency_retries": tftypes.NewValue(tftypes.Number, nil),
+				"circuit_breaker_threshold":    tftypes.NewValue(tftypes.Number, nil),
+				"command_timeout":              tftypes.NewValue(tftypes.String, nil),
+				"response_format":              tftypes.NewValue(tftypes.String, nil),
+				"max_idle_conns":               tftypes.NewValue(tftypes.Number, nil),
+				"max_idle_conns_per_host":      tftypes.NewValue(tftypes.Number, nil),
+				"default_test_interval":        tftypes.NewValue(tftypes.Number, nil),
+				"verify_credentials":           tftypes.NewValue(tftypes.Bool, nil),
 			},
 			expectError: true,
 		},
@@ -132,15 +583,30 @@ func TestProvider_Configure(t *testing.T) {
 			// Create a config value
 			configValue := tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
-					"api_key":             tftypes.String,
-					"base_url":            tftypes.String,
-					"requests_per_second": tftypes.Number,
-					"max_retries":         tftypes.Number,
-					"initial_backoff":     tftypes.String,
-					"backoff_multiplier":  tftypes.Number,
-					"max_backoff":         tftypes.String,
-					"user_agent":          tftypes.String,
-					"debug":               tftypes.Bool,
+					"api_key":                      tftypes.String,
+					"base_url":                     tftypes.String,
+					"requests_per_second":          tftypes.Number,
+					"max_retries":                  tftypes.Number,
+					"initial_backoff":              tftypes.String,
+					"backoff_multiplier":           tftypes.Number,
+					"max_backoff":                  tftypes.String,
+					"request_timeout":              tftypes.String,
+					"user_agent":                   tftypes.String,
+					"debug":                        tftypes.Bool,
+					"proxy_url":                    tftypes.String,
+					"insecure_skip_verify":         tftypes.Bool,
+					"retry_strategy":               tftypes.String,
+					"requests_burst":               tftypes.Number,
+					"retry_on_status":              tftypes.List{ElementType: tftypes.Number},
+					"max_response_bytes":           tftypes.Number,
+					"eventual_consistency_retries": tftypes.Number,
+					"circuit_breaker_threshold":    tftypes.Number,
+					"command_timeout":              tftypes.String,
+					"response_format":              tftypes.String,
+					"max_idle_conns":               tftypes.Number,
+					"max_idle_conns_per_host":      tftypes.Number,
+					"default_test_interval":        tftypes.Number,
+					"verify_credentials":           tftypes.Bool,
 				},
 			}, tt.config)
 
@@ -203,51 +669,78 @@ func TestProviderModel_Defaults(t *testing.T) {
 		{
 			name: "all null values use defaults",
 			input: wormlyProviderModel{
-				APIKey:            types.StringValue("test-key"),
-				BaseURL:           types.StringNull(),
-				RequestsPerSecond: types.Float64Null(),
-				MaxRetries:        types.Int64Null(),
-				InitialBackoff:    types.StringNull(),
-				BackoffMultiplier: types.Float64Null(),
-				MaxBackoff:        types.StringNull(),
-				UserAgent:         types.StringNull(),
-				Debug:             types.BoolNull(),
+				APIKey:                     types.StringValue("test-key"),
+				BaseURL:                    types.StringNull(),
+				RequestsPerSecond:          types.Float64Null(),
+				RequestsBurst:              types.Int64Null(),
+				MaxRetries:                 types.Int64Null(),
+				InitialBackoff:             types.StringNull(),
+				BackoffMultiplier:          types.Float64Null(),
+				MaxBackoff:                 types.StringNull(),
+				RetryStrategy:              types.StringNull(),
+				RetryOnStatus:              types.ListNull(types.Int64Type),
+				MaxResponseBytes:           types.Int64Null(),
+				EventualConsistencyRetries: types.Int64Null(),
+				CircuitBreakerThreshold:    types.Int64Null(),
+				RequestTimeout:             types.StringNull(),
+				UserAgent:                  types.StringNull(),
+				Debug:                      types.BoolNull(),
 			},
 			expected: Config{
-				APIKey:            "test-key",
-				BaseURL:           "https://api.wormly.com",
-				RequestsPerSecond: 10.0,
-				MaxRetries:        3,
-				InitialBackoff:    time.Second,
-				BackoffMultiplier: 2.0,
-				MaxBackoff:        30 * time.Second,
-				UserAgent:         "terraform-provider-wormly/dev",
-				Debug:             false,
+				APIKey:                     "test-key",
+				BaseURL:                    "https://api.wormly.com",
+				RequestsPerSecond:          10.0,
+				RequestsBurst:              1,
+				MaxRetries:                 3,
+				InitialBackoff:             time.Second,
+				BackoffMultiplier:          2.0,
+				MaxBackoff:                 30 * time.Second,
+				RetryStrategy:              client.RetryStrategyExponential,
+				RetryOnStatus:              client.DefaultRetryOnStatus,
+				MaxResponseBytes:           client.DefaultMaxResponseBytes,
+				EventualConsistencyRetries: 3,
+				RequestTimeout:             30 * time.Second,
+				UserAgent:                  "terraform-provider-wormly/dev",
+				Debug:                      false,
 			},
 		},
 		{
 			name: "partial configuration uses defaults for missing values",
 			input: wormlyProviderModel{
-				APIKey:            types.StringValue("test-key"),
-				BaseURL:           types.StringValue("https://custom.api.com"),
-				RequestsPerSecond: types.Float64Null(),
-				MaxRetries:        types.Int64Value(5),
-				InitialBackoff:    types.StringNull(),
-				BackoffMultiplier: types.Float64Null(),
-				MaxBackoff:        types.StringValue("45s"),
-				UserAgent:         types.StringNull(),
-				Debug:             types.BoolNull(),
+				APIKey:                     types.StringValue("test-key"),
+				BaseURL:                    types.StringValue("https://custom.api.com"),
+				RequestsPerSecond:          types.Float64Null(),
+				RequestsBurst:              types.Int64Value(5),
+				MaxRetries:                 types.Int64Value(5),
+				InitialBackoff:             types.StringNull(),
+				BackoffMultiplier:          types.Float64Null(),
+				MaxBackoff:                 types.StringValue("45s"),
+				RetryStrategy:              types.StringValue("constant"),
+				RetryOnStatus:              types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(408)}),
+				MaxResponseBytes:           types.Int64Value(1 << 20),
+				EventualConsistencyRetries: types.Int64Value(0),
+				CircuitBreakerThreshold:    types.Int64Value(5),
+				RequestTimeout:             types.StringValue("90s"),
+				UserAgent:                  types.StringNull(),
+				Debug:                      types.BoolNull(),
 			},
 			expected: Config{
-				APIKey:            "test-key",
-				BaseURL:           "https://custom.api.com",
-				RequestsPerSecond: 10.0,
-				MaxRetries:        5,
-				InitialBackoff:    time.Second,
-				BackoffMultiplier: 2.0,
-				MaxBackoff:        45 * time.Second,
-				UserAgent:         "terraform-provider-wormly/dev",
-				Debug:             false,
+				APIKey:                     "test-key",
+				BaseURL:                    "https://custom.api.com",
+				RequestsPerSecond:          10.0,
+				RequestsBurst:              5,
+				MaxRetries:                 5,
+				InitialBackoff:             time.Second,
+				BackoffMultiplier:          2.0,
+				MaxBackoff:                 45 * time.Second,
+				RetryStrategy:              client.RetryStrategyConstant,
+				RetryOnStatus:              []int{408},
+				MaxResponseBytes:           1 << 20,
+				EventualConsistencyRetries: 0,
+				CircuitBreakerThreshold:    5,
+				RequestTimeout:             90 * time.Second,
+				UserAgent:                  "terraform-provider-wormly/dev",
+				Debug:                      false,
 			},
 		},
 	}
@@ -256,15 +749,21 @@ func TestProviderModel_Defaults(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// Build configuration with defaults (simulating the Configure method logic)
 			config := Config{
-				APIKey:            tt.input.APIKey.ValueString(),
-				BaseURL:           "https://api.wormly.com",
-				RequestsPerSecond: 10.0,
-				MaxRetries:        3,
-				InitialBackoff:    time.Second,
-				BackoffMultiplier: 2.0,
-				MaxBackoff:        30 * time.Second,
-				UserAgent:         "terraform-provider-wormly/dev",
-				Debug:             false,
+				APIKey:                     tt.input.APIKey.ValueString(),
+				BaseURL:                    "https://api.wormly.com",
+				RequestsPerSecond:          10.0,
+				RequestsBurst:              1,
+				MaxRetries:                 3,
+				InitialBackoff:             time.Second,
+				BackoffMultiplier:          2.0,
+				MaxBackoff:                 30 * time.Second,
+				RetryStrategy:              client.RetryStrategyExponential,
+				RetryOnStatus:              client.DefaultRetryOnStatus,
+				MaxResponseBytes:           client.DefaultMaxResponseBytes,
+				EventualConsistencyRetries: 3,
+				RequestTimeout:             30 * time.Second,
+				UserAgent:                  "terraform-provider-wormly/dev",
+				Debug:                      false,
 			}
 
 			// Override with configured values if provided
@@ -276,6 +775,10 @@ func TestProviderModel_Defaults(t *testing.T) {
 				config.RequestsPerSecond = tt.input.RequestsPerSecond.ValueFloat64()
 			}
 
+			if !tt.input.RequestsBurst.IsNull() && !tt.input.RequestsBurst.IsUnknown() {
+				config.RequestsBurst = int(tt.input.RequestsBurst.ValueInt64())
+			}
+
 			if !tt.input.MaxRetries.IsNull() && !tt.input.MaxRetries.IsUnknown() {
 				config.MaxRetries = int(tt.input.MaxRetries.ValueInt64())
 			}
@@ -296,6 +799,37 @@ func TestProviderModel_Defaults(t *testing.T) {
 				}
 			}
 
+			if !tt.input.RetryStrategy.IsNull() && !tt.input.RetryStrategy.IsUnknown() {
+				config.RetryStrategy = client.RetryStrategy(tt.input.RetryStrategy.ValueString())
+			}
+
+			if !tt.input.RetryOnStatus.IsNull() && !tt.input.RetryOnStatus.IsUnknown() {
+				var statusCodes []int64
+				tt.input.RetryOnStatus.ElementsAs(t.Context(), &statusCodes, false)
+				config.RetryOnStatus = make([]int, 0, len(statusCodes))
+				for _, statusCode := range statusCodes {
+					config.RetryOnStatus = append(config.RetryOnStatus, int(statusCode))
+				}
+			}
+
+			if !tt.input.MaxResponseBytes.IsNull() && !tt.input.MaxResponseBytes.IsUnknown() {
+				config.MaxResponseBytes = tt.input.MaxResponseBytes.ValueInt64()
+			}
+
+			if !tt.input.EventualConsistencyRetries.IsNull() && !tt.input.EventualConsistencyRetries.IsUnknown() {
+				config.EventualConsistencyRetries = int(tt.input.EventualConsistencyRetries.ValueInt64())
+			}
+
+			if !tt.input.CircuitBreakerThreshold.IsNull() && !tt.input.CircuitBreakerThreshold.IsUnknown() {
+				config.CircuitBreakerThreshold = int(tt.input.CircuitBreakerThreshold.ValueInt64())
+			}
+
+			if !tt.input.RequestTimeout.IsNull() && !tt.input.RequestTimeout.IsUnknown() {
+				if duration, err := time.ParseDuration(tt.input.RequestTimeout.ValueString()); err == nil {
+					config.RequestTimeout = duration
+				}
+			}
+
 			if !tt.input.UserAgent.IsNull() && !tt.input.UserAgent.IsUnknown() {
 				config.UserAgent = tt.input.UserAgent.ValueString()
 			}
@@ -314,6 +848,9 @@ func TestProviderModel_Defaults(t *testing.T) {
 			if config.RequestsPerSecond != tt.expected.RequestsPerSecond {
 				t.Errorf("RequestsPerSecond = %v, want %v", config.RequestsPerSecond, tt.expected.RequestsPerSecond)
 			}
+			if config.RequestsBurst != tt.expected.RequestsBurst {
+				t.Errorf("RequestsBurst = %v, want %v", config.RequestsBurst, tt.expected.RequestsBurst)
+			}
 			if config.MaxRetries != tt.expected.MaxRetries {
 				t.Errorf("MaxRetries = %v, want %v", config.MaxRetries, tt.expected.MaxRetries)
 			}
@@ -326,6 +863,15 @@ func TestProviderModel_Defaults(t *testing.T) {
 			if config.MaxBackoff != tt.expected.MaxBackoff {
 				t.Errorf("MaxBackoff = %v, want %v", config.MaxBackoff, tt.expected.MaxBackoff)
 			}
+			if config.RetryStrategy != tt.expected.RetryStrategy {
+				t.Errorf("RetryStrategy = %v, want %v", config.RetryStrategy, tt.expected.RetryStrategy)
+			}
+			if !slices.Equal(config.RetryOnStatus, tt.expected.RetryOnStatus) {
+				t.Errorf("RetryOnStatus = %v, want %v", config.RetryOnStatus, tt.expected.RetryOnStatus)
+			}
+			if config.RequestTimeout != tt.expected.RequestTimeout {
+				t.Errorf("RequestTimeout = %v, want %v", config.RequestTimeout, tt.expected.RequestTimeout)
+			}
 			if config.UserAgent != tt.expected.UserAgent {
 				t.Errorf("UserAgent = %v, want %v", config.UserAgent, tt.expected.UserAgent)
 			}
@@ -335,3 +881,791 @@ func TestProviderModel_Defaults(t *testing.T) {
 		})
 	}
 }
+
+func TestNewHTTPClient(t *testing.T) {
+	tests := []struct {
+		name               string
+		config             Config
+		expectedProxy      string
+		insecureSkipVerify bool
+		maxIdleConns       int
+		maxIdleConnsHost   int
+		expectError        bool
+	}{
+		{
+			name:   "defaults",
+			config: Config{RequestTimeout: 30 * time.Second},
+		},
+		{
+			name:             "idle connection pool",
+			config:           Config{RequestTimeout: 30 * time.Second, MaxIdleConns: 200, MaxIdleConnsPerHost: 20},
+			maxIdleConns:     200,
+			maxIdleConnsHost: 20,
+		},
+		{
+			name:          "proxy url",
+			config:        Config{RequestTimeout: 30 * time.Second, ProxyURL: "http://proxy.example.com:3128"},
+			expectedProxy: "http://proxy.example.com:3128",
+		},
+		{
+			name:               "insecure skip verify",
+			config:             Config{RequestTimeout: 30 * time.Second, InsecureSkipVerify: true},
+			insecureSkipVerify: true,
+		},
+		{
+			name:        "relative proxy url",
+			config:      Config{RequestTimeout: 30 * time.Second, ProxyURL: "proxy.example.com"},
+			expectError: true,
+		},
+		{
+			name:        "unparsable proxy url",
+			config:      Config{RequestTimeout: 30 * time.Second, ProxyURL: "http://[::1"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpClient, err := newHTTPClient(tt.config)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("newHTTPClient() should have returned an error but did not")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("newHTTPClient() returned unexpected error: %v", err)
+			}
+
+			if httpClient.Timeout != tt.config.RequestTimeout {
+				t.Errorf("Timeout = %v, want %v", httpClient.Timeout, tt.config.RequestTimeout)
+			}
+
+			transport, ok := httpClient.Transport.(*http.Transport)
+			if !ok {
+				t.Fatalf("Expected *http.Transport, got %T", httpClient.Transport)
+			}
+
+			if tt.expectedProxy != "" {
+				req, err := http.NewRequest("POST", "https://api.wormly.com", nil)
+				if err != nil {
+					t.Fatalf("Failed to create request: %v", err)
+				}
+				proxyURL, err := transport.Proxy(req)
+				if err != nil {
+					t.Fatalf("Proxy() returned error: %v", err)
+				}
+				if proxyURL == nil || proxyURL.String() != tt.expectedProxy {
+					t.Errorf("Proxy = %v, want %v", proxyURL, tt.expectedProxy)
+				}
+			}
+
+			insecure := transport.TLSClientConfig != nil && transport.TLSClientConfig.InsecureSkipVerify
+			if insecure != tt.insecureSkipVerify {
+				t.Errorf("InsecureSkipVerify = %v, want %v", insecure, tt.insecureSkipVerify)
+			}
+
+			if tt.maxIdleConns != 0 && transport.MaxIdleConns != tt.maxIdleConns {
+				t.Errorf("MaxIdleConns = %d, want %d", transport.MaxIdleConns, tt.maxIdleConns)
+			}
+			if tt.maxIdleConnsHost != 0 && transport.MaxIdleConnsPerHost != tt.maxIdleConnsHost {
+				t.Errorf("MaxIdleConnsPerHost = %d, want %d", transport.MaxIdleConnsPerHost, tt.maxIdleConnsHost)
+			}
+		})
+	}
+}
+
+func TestCheckRequestsPerSecond(t *testing.T) {
+	tests := []struct {
+		name          string
+		account       *client.AccountInfo
+		accountErr    error
+		expectWarning bool
+	}{
+		{
+			name:          "rate above account limit",
+			account:       &client.AccountInfo{Plan: "Basic", APIRateLimit: 2},
+			expectWarning: true,
+		},
+		{
+			name:    "rate within account limit",
+			account: &client.AccountInfo{Plan: "Pro", APIRateLimit: 10},
+		},
+		{
+			name:    "account limit unknown",
+			account: &client.AccountInfo{Plan: "Pro"},
+		},
+		{
+			name:       "account lookup fails",
+			accountErr: errors.New("API returned error code 1: Unknown command"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &client.MockAccountAPI{}
+			if tt.accountErr != nil {
+				mockClient.On("GetAccountInfo", mock.Anything).Return(nil, tt.accountErr)
+			} else {
+				mockClient.On("GetAccountInfo", mock.Anything).Return(tt.account, nil)
+			}
+
+			diags := checkRequestsPerSecond(t.Context(), mockClient, 5)
+
+			if diags.HasError() {
+				t.Fatalf("Unexpected errors: %v", diags)
+			}
+			if tt.expectWarning != (diags.WarningsCount() == 1) {
+				t.Errorf("Expected warning: %v, got diagnostics: %v", tt.expectWarning, diags)
+			}
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCheckBaseURL(t *testing.T) {
+	tests := []struct {
+		name          string
+		baseURL       string
+		expectWarning bool
+	}{
+		{name: "default API", baseURL: "https://api.wormly.com"},
+		{name: "regional API subdomain", baseURL: "https://api-eu.wormly.com"},
+		{name: "API path", baseURL: "https://gateway.example.com/api/"},
+		{name: "local mock server", baseURL: "http://127.0.0.1:8080"},
+		{name: "web UI", baseURL: "https://www.wormly.com", expectWarning: true},
+		{name: "bare domain", baseURL: "https://wormly.com", expectWarning: true},
+		{name: "relative URL", baseURL: "api.wormly.com", expectWarning: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := checkBaseURL(tt.baseURL)
+
+			if diags.HasError() {
+				t.Fatalf("Unexpected errors: %v", diags)
+			}
+			if tt.expectWarning != (diags.WarningsCount() == 1) {
+				t.Errorf("Expected warning: %v, got diagnostics: %v", tt.expectWarning, diags)
+			}
+		})
+	}
+}
+
+func TestProvider_Configure_WebUIBaseURLWarning(t *testing.T) {
+	p := New("test")
+
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(t.Context(), provider.SchemaRequest{}, schemaResp)
+	schemaType, ok := schemaResp.Schema.Type().TerraformType(t.Context()).(tftypes.Object)
+	if !ok {
+		t.Fatal("Expected the provider schema to be an object type")
+	}
+
+	values := make(map[string]tftypes.Value, len(schemaType.AttributeTypes))
+	for name, attrType := range schemaType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	values["api_key"] = tftypes.NewValue(tftypes.String, "test-api-key")
+	values["base_url"] = tftypes.NewValue(tftypes.String, "https://www.wormly.com")
+
+	configResp := &provider.ConfigureResponse{}
+	p.Configure(t.Context(), provider.ConfigureRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaType, values),
+		},
+	}, configResp)
+
+	if configResp.Diagnostics.HasError() {
+		t.Fatalf("Configure() returned unexpected errors: %v", configResp.Diagnostics)
+	}
+	if configResp.Diagnostics.WarningsCount() != 1 {
+		t.Fatalf("Expected one warning, got: %v", configResp.Diagnostics)
+	}
+	if summary := configResp.Diagnostics.Warnings()[0].Summary(); summary != "Base URL Does Not Look Like an API Endpoint" {
+		t.Errorf("Unexpected warning summary: %s", summary)
+	}
+	if configResp.ResourceData == nil {
+		t.Error("Configure() should still set ResourceData")
+	}
+}
+
+func TestProvider_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name              string
+		config            map[string]tftypes.Value
+		expectedAttribute string
+	}{
+		{
+			name: "defaults",
+		},
+		{
+			name: "valid retry settings",
+			config: map[string]tftypes.Value{
+				"max_retries":         tftypes.NewValue(tftypes.Number, 0),
+				"requests_per_second": tftypes.NewValue(tftypes.Number, 0.5),
+				"backoff_multiplier":  tftypes.NewValue(tftypes.Number, 1.0),
+				"initial_backoff":     tftypes.NewValue(tftypes.String, "5s"),
+				"max_backoff":         tftypes.NewValue(tftypes.String, "5s"),
+			},
+		},
+		{
+			name: "negative max retries",
+			config: map[string]tftypes.Value{
+				"max_retries": tftypes.NewValue(tftypes.Number, -1),
+			},
+			expectedAttribute: "max_retries",
+		},
+		{
+			name: "zero requests per second",
+			config: map[string]tftypes.Value{
+				"requests_per_second": tftypes.NewValue(tftypes.Number, 0),
+			},
+			expectedAttribute: "requests_per_second",
+		},
+		{
+			name: "negative requests per second",
+			config: map[string]tftypes.Value{
+				"requests_per_second": tftypes.NewValue(tftypes.Number, -2.5),
+			},
+			expectedAttribute: "requests_per_second",
+		},
+		{
+			name: "shrinking backoff multiplier",
+			config: map[string]tftypes.Value{
+				"backoff_multiplier": tftypes.NewValue(tftypes.Number, 0.5),
+			},
+			expectedAttribute: "backoff_multiplier",
+		},
+		{
+			name: "max backoff below initial backoff",
+			config: map[string]tftypes.Value{
+				"initial_backoff": tftypes.NewValue(tftypes.String, "10s"),
+				"max_backoff":     tftypes.NewValue(tftypes.String, "5s"),
+			},
+			expectedAttribute: "max_backoff",
+		},
+		{
+			name: "initial backoff above default max backoff",
+			config: map[string]tftypes.Value{
+				"initial_backoff": tftypes.NewValue(tftypes.String, "1m"),
+			},
+			expectedAttribute: "max_backoff",
+		},
+		{
+			name: "unparsable backoff left to configure",
+			config: map[string]tftypes.Value{
+				"initial_backoff": tftypes.NewValue(tftypes.String, "soon"),
+			},
+		},
+		{
+			name: "unknown backoff skipped",
+			config: map[string]tftypes.Value{
+				"initial_backoff": tftypes.NewValue(tftypes.String, "1m"),
+				"max_backoff":     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := New("test")
+
+			schemaResp := &provider.SchemaResponse{}
+			p.Schema(t.Context(), provider.SchemaRequest{}, schemaResp)
+			schemaType, ok := schemaResp.Schema.Type().TerraformType(t.Context()).(tftypes.Object)
+			if !ok {
+				t.Fatal("Expected the provider schema to be an object type")
+			}
+
+			values := make(map[string]tftypes.Value, len(schemaType.AttributeTypes))
+			for name, attrType := range schemaType.AttributeTypes {
+				values[name] = tftypes.NewValue(attrType, nil)
+			}
+			values["api_key"] = tftypes.NewValue(tftypes.String, "test-api-key")
+			for name, value := range tt.config {
+				values[name] = value
+			}
+
+			validator, ok := p.(provider.ProviderWithValidateConfig)
+			if !ok {
+				t.Fatal("Expected the provider to implement ValidateConfig")
+			}
+
+			resp := &provider.ValidateConfigResponse{}
+			validator.ValidateConfig(t.Context(), provider.ValidateConfigRequest{
+				Config: tfsdk.Config{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaType, values),
+				},
+			}, resp)
+
+			if tt.expectedAttribute == "" {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("ValidateConfig() returned unexpected errors: %v", resp.Diagnostics)
+				}
+				return
+			}
+
+			if resp.Diagnostics.ErrorsCount() != 1 {
+				t.Fatalf("Expected one error, got: %v", resp.Diagnostics)
+			}
+			withPath, ok := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath)
+			if !ok {
+				t.Fatalf("Expected an attribute error, got: %v", resp.Diagnostics.Errors()[0])
+			}
+			if !withPath.Path().Equal(path.Root(tt.expectedAttribute)) {
+				t.Errorf("Expected error on %s, got: %s", tt.expectedAttribute, withPath.Path())
+			}
+		})
+	}
+}
+
+func TestProvider_Configure_ReplacesClient(t *testing.T) {
+	p, ok := New("test").(*wormlyProvider)
+	if !ok {
+		t.Fatal("Expected New to return a *wormlyProvider")
+	}
+
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(t.Context(), provider.SchemaRequest{}, schemaResp)
+	schemaType, ok := schemaResp.Schema.Type().TerraformType(t.Context()).(tftypes.Object)
+	if !ok {
+		t.Fatal("Expected the provider schema to be an object type")
+	}
+
+	values := make(map[string]tftypes.Value, len(schemaType.AttributeTypes))
+	for name, attrType := range schemaType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	values["api_key"] = tftypes.NewValue(tftypes.String, "test-api-key")
+	req := provider.ConfigureRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaType, values),
+		},
+	}
+
+	var configured []any
+	for range 2 {
+		configResp := &provider.ConfigureResponse{}
+		p.Configure(t.Context(), req, configResp)
+		if configResp.Diagnostics.HasError() {
+			t.Fatalf("Configure() returned unexpected errors: %v", configResp.Diagnostics)
+		}
+		configured = append(configured, configResp.DataSourceData)
+	}
+
+	if configured[0] == configured[1] {
+		t.Error("Expected each Configure to create a new client")
+	}
+	if any(p.client) != configured[1] {
+		t.Error("Expected the provider to keep the latest client")
+	}
+}
+
+func TestDefaultUserAgent(t *testing.T) {
+	tests := []struct {
+		name             string
+		providerVersion  string
+		terraformVersion string
+		expected         string
+	}{
+		{name: "both versions", providerVersion: "1.2.3", terraformVersion: "1.7.0", expected: "terraform-provider-wormly/1.2.3 terraform/1.7.0"},
+		{name: "development build", providerVersion: "dev", terraformVersion: "1.7.0", expected: "terraform-provider-wormly/dev terraform/1.7.0"},
+		{name: "unknown terraform version", providerVersion: "1.2.3", expected: "terraform-provider-wormly/1.2.3"},
+		{name: "no provider version", terraformVersion: "1.7.0", expected: "terraform-provider-wormly/dev terraform/1.7.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := defaultUserAgent(tt.providerVersion, tt.terraformVersion); got != tt.expected {
+				t.Errorf("defaultUserAgent() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestProvider_Configure_UserAgent(t *testing.T) {
+	tests := []struct {
+		name      string
+		userAgent any
+		expected  string
+	}{
+		{name: "composed default", expected: "terraform-provider-wormly/1.2.3 terraform/1.7.0"},
+		{name: "explicit override", userAgent: "custom-agent/2.0", expected: "custom-agent/2.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received = r.Header.Get("User-Agent")
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, `{"errorcode": 0}`)
+			}))
+			defer server.Close()
+
+			p := New("1.2.3")
+
+			schemaResp := &provider.SchemaResponse{}
+			p.Schema(t.Context(), provider.SchemaRequest{}, schemaResp)
+			schemaType, ok := schemaResp.Schema.Type().TerraformType(t.Context()).(tftypes.Object)
+			if !ok {
+				t.Fatal("Expected the provider schema to be an object type")
+			}
+
+			values := make(map[string]tftypes.Value, len(schemaType.AttributeTypes))
+			for name, attrType := range schemaType.AttributeTypes {
+				values[name] = tftypes.NewValue(attrType, nil)
+			}
+			values["api_key"] = tftypes.NewValue(tftypes.String, "test-api-key")
+			values["base_url"] = tftypes.NewValue(tftypes.String, server.URL)
+			values["user_agent"] = tftypes.NewValue(tftypes.String, tt.userAgent)
+			// Setting requests_per_second makes Configure look up the account, which sends a request
+			values["requests_per_second"] = tftypes.NewValue(tftypes.Number, 5)
+
+			configResp := &provider.ConfigureResponse{}
+			p.Configure(t.Context(), provider.ConfigureRequest{
+				TerraformVersion: "1.7.0",
+				Config: tfsdk.Config{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaType, values),
+				},
+			}, configResp)
+
+			if configResp.Diagnostics.HasError() {
+				t.Fatalf("Configure() returned unexpected errors: %v", configResp.Diagnostics)
+			}
+			if received != tt.expected {
+				t.Errorf("Expected User-Agent %q, got %q", tt.expected, received)
+			}
+		})
+	}
+}
+
+// configureTestProvider configures a new provider with the given attributes
+// set and every other attribute null.
+func configureTestProvider(t *testing.T, attrs map[string]tftypes.Value) *provider.ConfigureResponse {
+	t.Helper()
+
+	p := New("test")
+
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(t.Context(), provider.SchemaRequest{}, schemaResp)
+	schemaType, ok := schemaResp.Schema.Type().TerraformType(t.Context()).(tftypes.Object)
+	if !ok {
+		t.Fatal("Expected the provider schema to be an object type")
+	}
+
+	values := make(map[string]tftypes.Value, len(schemaType.AttributeTypes))
+	for name, attrType := range schemaType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	values["api_key"] = tftypes.NewValue(tftypes.String, "test-api-key")
+	for name, value := range attrs {
+		values[name] = value
+	}
+
+	configResp := &provider.ConfigureResponse{}
+	p.Configure(t.Context(), provider.ConfigureRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaType, values),
+		},
+	}, configResp)
+
+	return configResp
+}
+
+func TestProvider_Configure_CommandTimeout(t *testing.T) {
+	tests := []struct {
+		name           string
+		commandTimeout any
+		expected       time.Duration
+		expectError    bool
+	}{
+		{name: "unset", expected: 0},
+		{name: "configured", commandTimeout: "2m", expected: 2 * time.Minute},
+		{name: "zero", commandTimeout: "0s", expectError: true},
+		{name: "not a duration", commandTimeout: "soon", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configResp := configureTestProvider(t, map[string]tftypes.Value{
+				"command_timeout": tftypes.NewValue(tftypes.String, tt.commandTimeout),
+			})
+
+			if tt.expectError {
+				if !configResp.Diagnostics.HasError() {
+					t.Fatal("Expected Configure() to return an error")
+				}
+				return
+			}
+			if configResp.Diagnostics.HasError() {
+				t.Fatalf("Configure() returned unexpected errors: %v", configResp.Diagnostics)
+			}
+
+			wormlyClient, ok := configResp.DataSourceData.(*client.Client)
+			if !ok {
+				t.Fatalf("Expected DataSourceData to be *client.Client, got %T", configResp.DataSourceData)
+			}
+			if wormlyClient.CommandTimeout() != tt.expected {
+				t.Errorf("Expected CommandTimeout %v, got %v", tt.expected, wormlyClient.CommandTimeout())
+			}
+		})
+	}
+}
+
+func TestProvider_Configure_ResponseFormat(t *testing.T) {
+	tests := []struct {
+		name           string
+		responseFormat any
+		expected       client.ResponseFormat
+		expectError    bool
+	}{
+		{name: "unset", expected: client.ResponseFormatJSON},
+		{name: "json", responseFormat: "json", expected: client.ResponseFormatJSON},
+		{name: "xml", responseFormat: "xml", expected: client.ResponseFormatXML},
+		{name: "unsupported", responseFormat: "yaml", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configResp := configureTestProvider(t, map[string]tftypes.Value{
+				"response_format": tftypes.NewValue(tftypes.String, tt.responseFormat),
+			})
+
+			if tt.expectError {
+				if !configResp.Diagnostics.HasError() {
+					t.Fatal("Expected Configure() to return an error")
+				}
+				if summary := configResp.Diagnostics.Errors()[0].Summary(); summary != "Invalid Response Format" {
+					t.Errorf("Expected an Invalid Response Format error, got %q", summary)
+				}
+				return
+			}
+			if configResp.Diagnostics.HasError() {
+				t.Fatalf("Configure() returned unexpected errors: %v", configResp.Diagnostics)
+			}
+
+			wormlyClient, ok := configResp.DataSourceData.(*client.Client)
+			if !ok {
+				t.Fatalf("Expected DataSourceData to be *client.Client, got %T", configResp.DataSourceData)
+			}
+			if wormlyClient.ResponseFormat() != tt.expected {
+				t.Errorf("Expected ResponseFormat %q, got %q", tt.expected, wormlyClient.ResponseFormat())
+			}
+		})
+	}
+}
+
+func TestProvider_Configure_MaxIdleConns(t *testing.T) {
+	tests := []struct {
+		name          string
+		attribute     string
+		value         int64
+		expectedError string
+	}{
+		{name: "max_idle_conns", attribute: "max_idle_conns", value: 200},
+		{name: "max_idle_conns_per_host", attribute: "max_idle_conns_per_host", value: 20},
+		{name: "zero max_idle_conns", attribute: "max_idle_conns", value: 0, expectedError: "Invalid Max Idle Connections"},
+		{name: "negative max_idle_conns_per_host", attribute: "max_idle_conns_per_host", value: -1, expectedError: "Invalid Max Idle Connections Per Host"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configResp := configureTestProvider(t, map[string]tftypes.Value{
+				tt.attribute: tftypes.NewValue(tftypes.Number, tt.value),
+			})
+
+			if tt.expectedError == "" {
+				if configResp.Diagnostics.HasError() {
+					t.Fatalf("Configure() returned unexpected errors: %v", configResp.Diagnostics)
+				}
+				return
+			}
+			if !configResp.Diagnostics.HasError() {
+				t.Fatal("Expected Configure() to return an error")
+			}
+			if summary := configResp.Diagnostics.Errors()[0].Summary(); summary != tt.expectedError {
+				t.Errorf("Expected a %s error, got %q", tt.expectedError, summary)
+			}
+		})
+	}
+}
+
+func TestProvider_Configure_APIKeyFromEnvironment(t *testing.T) {
+	tests := []struct {
+		name           string
+		apiKey         tftypes.Value
+		envAPIKey      string
+		expectedAPIKey string
+	}{
+		{name: "config only", apiKey: tftypes.NewValue(tftypes.String, "config-api-key"), expectedAPIKey: "config-api-key"},
+		{name: "config takes precedence", apiKey: tftypes.NewValue(tftypes.String, "config-api-key"), envAPIKey: "env-api-key", expectedAPIKey: "config-api-key"},
+		{name: "environment only", apiKey: tftypes.NewValue(tftypes.String, nil), envAPIKey: "env-api-key", expectedAPIKey: "env-api-key"},
+		{name: "empty config uses environment", apiKey: tftypes.NewValue(tftypes.String, ""), envAPIKey: "env-api-key", expectedAPIKey: "env-api-key"},
+		{name: "neither", apiKey: tftypes.NewValue(tftypes.String, nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(apiKeyEnvVar, tt.envAPIKey)
+
+			var sentAPIKey string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				sentAPIKey = r.FormValue("key")
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, `{"errorcode": 0, "plan": "Pro"}`)
+			}))
+			defer server.Close()
+
+			configResp := configureTestProvider(t, map[string]tftypes.Value{
+				"api_key":  tt.apiKey,
+				"base_url": tftypes.NewValue(tftypes.String, server.URL),
+			})
+
+			if tt.expectedAPIKey == "" {
+				if !configResp.Diagnostics.HasError() {
+					t.Fatal("Expected Configure() to return an error")
+				}
+				if summary := configResp.Diagnostics.Errors()[0].Summary(); summary != "Missing API Key Configuration" {
+					t.Errorf("Expected a Missing API Key Configuration error, got %q", summary)
+				}
+				return
+			}
+			if configResp.Diagnostics.HasError() {
+				t.Fatalf("Configure() returned unexpected errors: %v", configResp.Diagnostics)
+			}
+			apiClient, ok := configResp.DataSourceData.(*client.Client)
+			if !ok {
+				t.Fatalf("Expected a configured client, got %T", configResp.DataSourceData)
+			}
+			if _, err := apiClient.GetAccountInfo(t.Context()); err != nil {
+				t.Fatalf("GetAccountInfo() returned error: %v", err)
+			}
+			if sentAPIKey != tt.expectedAPIKey {
+				t.Errorf("Expected API key %q to be sent, got %q", tt.expectedAPIKey, sentAPIKey)
+			}
+		})
+	}
+}
+
+func TestProvider_Configure_DefaultTestInterval(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         any
+		expected      int
+		expectedError string
+	}{
+		{name: "unset", value: nil, expected: 0},
+		{name: "set", value: 300, expected: 300},
+		{name: "zero", value: 0, expectedError: "Invalid Default Test Interval"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configResp := configureTestProvider(t, map[string]tftypes.Value{
+				"default_test_interval": tftypes.NewValue(tftypes.Number, tt.value),
+			})
+
+			if tt.expectedError != "" {
+				if !configResp.Diagnostics.HasError() {
+					t.Fatal("Expected Configure() to return an error")
+				}
+				if summary := configResp.Diagnostics.Errors()[0].Summary(); summary != tt.expectedError {
+					t.Errorf("Expected a %s error, got %q", tt.expectedError, summary)
+				}
+				return
+			}
+			if configResp.Diagnostics.HasError() {
+				t.Fatalf("Configure() returned unexpected errors: %v", configResp.Diagnostics)
+			}
+
+			data, ok := configResp.ResourceData.(*providerData)
+			if !ok {
+				t.Fatalf("Expected ResourceData to be *providerData, got %T", configResp.ResourceData)
+			}
+			if data.DefaultTestInterval() != tt.expected {
+				t.Errorf("Expected DefaultTestInterval %d, got %d", tt.expected, data.DefaultTestInterval())
+			}
+		})
+	}
+}
+
+func TestProvider_Configure_VerifyCredentials(t *testing.T) {
+	tests := []struct {
+		name             string
+		verify           any
+		status           int
+		responseBody     string
+		expectedRequests int
+		expectedError    string
+	}{
+		{name: "unset", status: http.StatusOK, responseBody: `{"errorcode": 0}`},
+		{name: "valid key", verify: true, status: http.StatusOK, responseBody: `{"errorcode": 0}`, expectedRequests: 1},
+		{name: "invalid key", verify: true, status: http.StatusOK, responseBody: `{"errorcode": 3, "message": "Invalid API key"}`, expectedRequests: 1, expectedError: "Invalid API Key"},
+		{name: "unreachable API", verify: true, status: http.StatusBadGateway, responseBody: `bad gateway`, expectedRequests: 1, expectedError: "Unable to Verify Credentials"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, tt.responseBody)
+			}))
+			defer server.Close()
+
+			configResp := configureTestProvider(t, map[string]tftypes.Value{
+				"base_url":           tftypes.NewValue(tftypes.String, server.URL),
+				"max_retries":        tftypes.NewValue(tftypes.Number, 0),
+				"verify_credentials": tftypes.NewValue(tftypes.Bool, tt.verify),
+			})
+
+			if requests != tt.expectedRequests {
+				t.Errorf("Expected %d requests, got %d", tt.expectedRequests, requests)
+			}
+			if tt.expectedError == "" {
+				if configResp.Diagnostics.HasError() {
+					t.Fatalf("Configure() returned unexpected errors: %v", configResp.Diagnostics)
+				}
+				return
+			}
+			if !configResp.Diagnostics.HasError() {
+				t.Fatal("Expected Configure() to return an error")
+			}
+			if summary := configResp.Diagnostics.Errors()[0].Summary(); summary != tt.expectedError {
+				t.Errorf("Expected a %s error, got %q", tt.expectedError, summary)
+			}
+			if configResp.ResourceData != nil {
+				t.Errorf("Expected no client to be handed to resources, got %T", configResp.ResourceData)
+			}
+		})
+	}
+}
+
+func TestProvider_Configure_ResourcesAcceptResourceData(t *testing.T) {
+	configResp := configureTestProvider(t, nil)
+	if configResp.Diagnostics.HasError() {
+		t.Fatalf("Configure() returned unexpected errors: %v", configResp.Diagnostics)
+	}
+
+	for _, newResource := range New("test").Resources(t.Context()) {
+		r, ok := newResource().(resource.ResourceWithConfigure)
+		if !ok {
+			continue
+		}
+
+		resp := &resource.ConfigureResponse{}
+		r.Configure(t.Context(), resource.ConfigureRequest{ProviderData: configResp.ResourceData}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Errorf("%T rejected the provider's ResourceData: %v", r, resp.Diagnostics)
+		}
+	}
+}
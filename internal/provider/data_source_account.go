@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/radarnex/terraform-provider-wormly/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &accountDataSource{}
+	_ datasource.DataSourceWithConfigure = &accountDataSource{}
+)
+
+// NewAccountDataSource is a helper function to simplify the provider implementation.
+func NewAccountDataSource() datasource.DataSource {
+	return &accountDataSource{}
+}
+
+// accountDataSource is the data source implementation.
+type accountDataSource struct {
+	client client.AccountAPI
+}
+
+// accountDataSourceModel describes the data source data model.
+type accountDataSourceModel struct {
+	PlanName       types.String `tfsdk:"plan_name"`
+	SensorsUsed    types.Int64  `tfsdk:"sensors_used"`
+	SensorsAllowed types.Int64  `tfsdk:"sensors_allowed"`
+	AlertsMuted    types.Bool   `tfsdk:"alerts_muted"`
+}
+
+func (d *accountDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account"
+}
+
+func (d *accountDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Plan and sensor quota of the Wormly account the API key belongs to. Use it in a `precondition` to stop a plan before it exceeds the remaining sensor quota.",
+
+		Attributes: map[string]schema.Attribute{
+			"plan_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the account's Wormly plan",
+				Computed:            true,
+			},
+			"sensors_used": schema.Int64Attribute{
+				MarkdownDescription: "Number of sensors currently configured on the account",
+				Computed:            true,
+			},
+			"sensors_allowed": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of sensors the plan allows",
+				Computed:            true,
+			},
+			"alerts_muted": schema.BoolAttribute{
+				MarkdownDescription: "Whether alerts are muted globally for the account",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *accountDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.AccountAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.AccountAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *accountDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	account, err := d.client.GetAccount(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read account, got error: %s", err))
+		return
+	}
+
+	data := accountDataSourceModel{
+		PlanName:       types.StringValue(account.PlanName),
+		SensorsUsed:    types.Int64Value(int64(account.SensorsUsed)),
+		SensorsAllowed: types.Int64Value(int64(account.SensorsAllowed)),
+		AlertsMuted:    types.BoolValue(account.AlertsMuted),
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/radarnex/terraform-provider-wormly/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAccountDataSource_Configure_Error(t *testing.T) {
+	dataSource, ok := NewAccountDataSource().(*accountDataSource)
+	if !ok {
+		t.Fatal("Expected accountDataSource type")
+	}
+	resp := &datasource.ConfigureResponse{}
+
+	dataSource.Configure(t.Context(), datasource.ConfigureRequest{ProviderData: "invalid"}, resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), "Unexpected Data Source Configure Type")
+}
+
+func TestAccountDataSource_Read(t *testing.T) {
+	tests := []struct {
+		name          string
+		account       *client.Account
+		err           error
+		expectError   bool
+		expectedState accountDataSourceModel
+	}{
+		{
+			name: "success",
+			account: &client.Account{
+				PlanName:       "Professional",
+				SensorsUsed:    12,
+				SensorsAllowed: 50,
+				AlertsMuted:    true,
+			},
+			expectedState: accountDataSourceModel{
+				PlanName:       types.StringValue("Professional"),
+				SensorsUsed:    types.Int64Value(12),
+				SensorsAllowed: types.Int64Value(50),
+				AlertsMuted:    types.BoolValue(true),
+			},
+		},
+		{
+			name:        "client error",
+			err:         errors.New("connection refused"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &client.MockAccountAPI{}
+			mockClient.On("GetAccount", mock.Anything).Return(tt.account, tt.err)
+
+			dataSource := &accountDataSource{client: mockClient}
+			schemaResp := &datasource.SchemaResponse{}
+			dataSource.Schema(t.Context(), datasource.SchemaRequest{}, schemaResp)
+			schemaType := schemaResp.Schema.Type().TerraformType(t.Context())
+
+			req := datasource.ReadRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaType, nil)},
+			}
+			resp := &datasource.ReadResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaType, nil)},
+			}
+
+			dataSource.Read(t.Context(), req, resp)
+
+			if tt.expectError {
+				assert.True(t, resp.Diagnostics.HasError())
+				return
+			}
+			assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+
+			var state accountDataSourceModel
+			assert.False(t, resp.State.Get(t.Context(), &state).HasError())
+			assert.Equal(t, tt.expectedState, state)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
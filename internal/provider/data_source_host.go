@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -28,9 +29,12 @@ type hostDataSource struct {
 
 // hostDataSourceModel describes the data source data model.
 type hostDataSourceModel struct {
-	ID      types.Int64  `tfsdk:"id"`
-	Name    types.String `tfsdk:"name"`
-	Enabled types.Bool   `tfsdk:"enabled"`
+	ID              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	LastUptimeCheck types.String `tfsdk:"last_uptime_check"`
+	LastHealthCheck types.String `tfsdk:"last_health_check"`
+	LastUptimeError types.String `tfsdk:"last_uptime_error"`
 }
 
 func (d *hostDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -51,7 +55,19 @@ func (d *hostDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Computed:            true,
 			},
 			"enabled": schema.BoolAttribute{
-				MarkdownDescription: "Whether the host is enabled",
+				MarkdownDescription: "Whether uptime monitoring is enabled for the host",
+				Computed:            true,
+			},
+			"last_uptime_check": schema.StringAttribute{
+				MarkdownDescription: "Time of the last uptime check, in RFC3339 format. Null if the host has never been checked",
+				Computed:            true,
+			},
+			"last_health_check": schema.StringAttribute{
+				MarkdownDescription: "Time of the last health check, in RFC3339 format. Null if the host has never been checked",
+				Computed:            true,
+			},
+			"last_uptime_error": schema.StringAttribute{
+				MarkdownDescription: "Time of the last uptime error, in RFC3339 format. Null if the host has never had one",
 				Computed:            true,
 			},
 		},
@@ -99,7 +115,18 @@ func (d *hostDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	// Map response body to schema and populate Computed attribute values
 	data.Name = types.StringValue(host.Name)
 	data.Enabled = types.BoolValue(host.Enabled)
+	data.LastUptimeCheck = timestampValue(host.LastUptimeCheck)
+	data.LastHealthCheck = timestampValue(host.LastHealthCheck)
+	data.LastUptimeError = timestampValue(host.LastUptimeError)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// timestampValue formats an optional time as an RFC3339 string, or null when it is absent.
+func timestampValue(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.Format(time.RFC3339))
+}
@@ -2,8 +2,12 @@ package provider
 
 import (
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/radarnex/terraform-provider-wormly/internal/client"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -106,3 +110,48 @@ func TestHostDataSource_Read(t *testing.T) {
 	// Verify mock expectations
 	mockClient.AssertExpectations(t)
 }
+
+func TestHostDataSource_Read_StatusTimestamps(t *testing.T) {
+	checkedAt := time.Date(2025, 6, 1, 12, 30, 0, 0, time.UTC)
+
+	mockClient := &client.MockHostAPI{}
+	mockClient.On("GetHost", mock.Anything, 1).Return(&client.Host{
+		ID:              1,
+		Name:            "test-host",
+		Enabled:         true,
+		LastUptimeCheck: &checkedAt,
+		LastHealthCheck: &checkedAt,
+		// LastUptimeError is nil: the API reported null or -1
+	}, nil)
+
+	dataSource := &hostDataSource{client: mockClient}
+	schemaResp := &datasource.SchemaResponse{}
+	dataSource.Schema(t.Context(), datasource.SchemaRequest{}, schemaResp)
+	schemaType := schemaResp.Schema.Type().TerraformType(t.Context())
+
+	req := datasource.ReadRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+				"id":                tftypes.NewValue(tftypes.Number, 1),
+				"name":              tftypes.NewValue(tftypes.String, nil),
+				"enabled":           tftypes.NewValue(tftypes.Bool, nil),
+				"last_uptime_check": tftypes.NewValue(tftypes.String, nil),
+				"last_health_check": tftypes.NewValue(tftypes.String, nil),
+				"last_uptime_error": tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+	}
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaType, nil)},
+	}
+
+	dataSource.Read(t.Context(), req, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+	var state hostDataSourceModel
+	assert.False(t, resp.State.Get(t.Context(), &state).HasError())
+	assert.Equal(t, types.StringValue("2025-06-01T12:30:00Z"), state.LastUptimeCheck)
+	assert.Equal(t, types.StringValue("2025-06-01T12:30:00Z"), state.LastHealthCheck)
+	assert.True(t, state.LastUptimeError.IsNull())
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/radarnex/terraform-provider-wormly/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &scheduledDowntimePeriodsDataSource{}
+	_ datasource.DataSourceWithConfigure = &scheduledDowntimePeriodsDataSource{}
+)
+
+// NewScheduledDowntimePeriodsDataSource is a helper function to simplify the provider implementation.
+func NewScheduledDowntimePeriodsDataSource() datasource.DataSource {
+	return &scheduledDowntimePeriodsDataSource{}
+}
+
+// scheduledDowntimePeriodsDataSource lists the scheduled downtime periods of a host.
+type scheduledDowntimePeriodsDataSource struct {
+	client client.ScheduledDowntimePeriodAPI
+}
+
+// scheduledDowntimePeriodsDataSourceModel describes the data source data model.
+type scheduledDowntimePeriodsDataSourceModel struct {
+	HostID     types.Int64                              `tfsdk:"host_id"`
+	Recurrence types.String                             `tfsdk:"recurrence"`
+	Periods    []scheduledDowntimePeriodDataSourceModel `tfsdk:"periods"`
+}
+
+// scheduledDowntimePeriodDataSourceModel describes a single period.
+type scheduledDowntimePeriodDataSourceModel struct {
+	ID         types.Int64  `tfsdk:"id"`
+	Start      types.String `tfsdk:"start"`
+	End        types.String `tfsdk:"end"`
+	Timezone   types.String `tfsdk:"timezone"`
+	Recurrence types.String `tfsdk:"recurrence"`
+	On         types.String `tfsdk:"on"`
+}
+
+func (d *scheduledDowntimePeriodsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scheduled_downtime_periods"
+}
+
+func (d *scheduledDowntimePeriodsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the scheduled downtime periods of a host, optionally only those with a given recurrence.",
+
+		Attributes: map[string]schema.Attribute{
+			"host_id": schema.Int64Attribute{
+				MarkdownDescription: "Identifier of the host to list downtime periods for",
+				Required:            true,
+			},
+			"recurrence": schema.StringAttribute{
+				MarkdownDescription: "Only list periods with this recurrence. Must be one of ONCEONLY, DAILY, WEEKLY, or MONTHLY",
+				Optional:            true,
+				Validators: []validator.String{
+					stringOneOfValidator{values: downtimeRecurrences},
+				},
+			},
+			"periods": schema.ListNestedAttribute{
+				MarkdownDescription: "Scheduled downtime periods of the host, ordered by identifier",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Period identifier",
+							Computed:            true,
+						},
+						"start": schema.StringAttribute{
+							MarkdownDescription: "Start time of the downtime in HH:mm format",
+							Computed:            true,
+						},
+						"end": schema.StringAttribute{
+							MarkdownDescription: "End time of the downtime in HH:mm format",
+							Computed:            true,
+						},
+						"timezone": schema.StringAttribute{
+							MarkdownDescription: "Timezone of the start and end times",
+							Computed:            true,
+						},
+						"recurrence": schema.StringAttribute{
+							MarkdownDescription: "Recurrence pattern",
+							Computed:            true,
+						},
+						"on": schema.StringAttribute{
+							MarkdownDescription: "Day of the downtime: a date for ONCEONLY, a weekday for WEEKLY, or a day of the month for MONTHLY. Empty for DAILY",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *scheduledDowntimePeriodsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.ScheduledDowntimePeriodAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.ScheduledDowntimePeriodAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *scheduledDowntimePeriodsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data scheduledDowntimePeriodsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostID := int(data.HostID.ValueInt64())
+	periods, err := d.client.GetScheduledDowntimePeriods(ctx, hostID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read scheduled downtime periods, got error: %s", err))
+		return
+	}
+
+	// The client upper-cases recurrences, so they compare directly with the validated filter
+	if !data.Recurrence.IsNull() {
+		periods = slices.DeleteFunc(periods, func(period client.ScheduledDowntimePeriod) bool {
+			return period.Recurrence != data.Recurrence.ValueString()
+		})
+	}
+	slices.SortFunc(periods, func(a, b client.ScheduledDowntimePeriod) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	data.Periods = make([]scheduledDowntimePeriodDataSourceModel, len(periods))
+	for i, period := range periods {
+		data.Periods[i] = scheduledDowntimePeriodDataSourceModel{
+			ID:         types.Int64Value(int64(period.ID)),
+			Start:      types.StringValue(period.Start),
+			End:        types.StringValue(period.End),
+			Timezone:   types.StringValue(period.Timezone),
+			Recurrence: types.StringValue(period.Recurrence),
+			On:         types.StringValue(period.On),
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/radarnex/terraform-provider-wormly/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestScheduledDowntimePeriodsDataSource_Read(t *testing.T) {
+	tests := []struct {
+		name        string
+		recurrence  any
+		expectedIDs []int64
+	}{
+		{name: "all periods", expectedIDs: []int64{1, 2, 3, 4}},
+		{name: "weekly only", recurrence: "WEEKLY", expectedIDs: []int64{2, 4}},
+		{name: "no matching periods", recurrence: "MONTHLY", expectedIDs: []int64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &client.MockScheduledDowntimePeriodAPI{}
+			mockClient.On("GetScheduledDowntimePeriods", mock.Anything, 123).Return([]client.ScheduledDowntimePeriod{
+				{ID: 4, HostID: 123, Start: "02:00", End: "03:00", Timezone: "GMT", Recurrence: "WEEKLY", On: "Sunday"},
+				{ID: 1, HostID: 123, Start: "01:00", End: "02:00", Timezone: "GMT", Recurrence: "DAILY"},
+				{ID: 3, HostID: 123, Start: "00:00", End: "06:00", Timezone: "GMT", Recurrence: "ONCEONLY", On: "2025-12-24"},
+				{ID: 2, HostID: 123, Start: "22:00", End: "23:00", Timezone: "GMT", Recurrence: "WEEKLY", On: "Friday"},
+			}, nil)
+
+			d := &scheduledDowntimePeriodsDataSource{client: mockClient}
+			schemaResp := &datasource.SchemaResponse{}
+			d.Schema(t.Context(), datasource.SchemaRequest{}, schemaResp)
+			schemaType := schemaResp.Schema.Type().TerraformType(t.Context()).(tftypes.Object)
+
+			req := datasource.ReadRequest{
+				Config: tfsdk.Config{
+					Schema: schemaResp.Schema,
+					Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+						"host_id":    tftypes.NewValue(tftypes.Number, 123),
+						"recurrence": tftypes.NewValue(tftypes.String, tt.recurrence),
+						"periods":    tftypes.NewValue(schemaType.AttributeTypes["periods"], nil),
+					}),
+				},
+			}
+			resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+			d.Read(t.Context(), req, resp)
+
+			assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+			var state scheduledDowntimePeriodsDataSourceModel
+			assert.False(t, resp.State.Get(t.Context(), &state).HasError())
+			ids := make([]int64, len(state.Periods))
+			for i, period := range state.Periods {
+				ids[i] = period.ID.ValueInt64()
+				if tt.recurrence != nil {
+					assert.Equal(t, tt.recurrence, period.Recurrence.ValueString())
+				}
+			}
+			assert.Equal(t, tt.expectedIDs, ids)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestScheduledDowntimePeriodsDataSource_RecurrenceValidation(t *testing.T) {
+	schemaResp := &datasource.SchemaResponse{}
+	NewScheduledDowntimePeriodsDataSource().Schema(t.Context(), datasource.SchemaRequest{}, schemaResp)
+	validators := schemaResp.Schema.Attributes["recurrence"].(interface{ StringValidators() []validator.String }).StringValidators()
+
+	for value, valid := range map[string]bool{"ONCEONLY": true, "DAILY": true, "WEEKLY": true, "MONTHLY": true, "weekly": false, "YEARLY": false} {
+		resp := &validator.StringResponse{}
+		for _, v := range validators {
+			v.ValidateString(t.Context(), validator.StringRequest{Path: path.Root("recurrence"), ConfigValue: types.StringValue(value)}, resp)
+		}
+		assert.Equal(t, !valid, resp.Diagnostics.HasError(), "recurrence %q", value)
+	}
+}
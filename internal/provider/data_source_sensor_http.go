@@ -3,10 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/radarnex/terraform-provider-wormly/internal/client"
 )
 
@@ -34,10 +37,31 @@ type sensorHTTPDataSourceModel struct {
 
 // sensorHTTPDataSourceSensorModel describes the sensor data model.
 type sensorHTTPDataSourceSensorModel struct {
-	ID       types.Int64             `tfsdk:"id"`
-	NiceName types.String            `tfsdk:"nice_name"`
-	Enabled  types.Bool              `tfsdk:"enabled"`
-	Params   map[string]types.String `tfsdk:"params"`
+	ID       types.Int64                     `tfsdk:"id"`
+	NiceName types.String                    `tfsdk:"nice_name"`
+	Enabled  types.Bool                      `tfsdk:"enabled"`
+	Params   sensorHTTPDataSourceParamsModel `tfsdk:"params"`
+
+	SSLIssuer        types.String `tfsdk:"ssl_issuer"`
+	SSLExpiresAt     types.String `tfsdk:"ssl_expires_at"`
+	SSLDaysRemaining types.Int64  `tfsdk:"ssl_days_remaining"`
+}
+
+// sensorHTTPDataSourceParamsModel describes the typed sensor parameters.
+type sensorHTTPDataSourceParamsModel struct {
+	URL                  types.String `tfsdk:"url"`
+	Timeout              types.Int64  `tfsdk:"timeout"`
+	ResponseCode         types.String `tfsdk:"response_code"`
+	VerifySSLCert        types.Bool   `tfsdk:"verify_ssl_cert"`
+	SearchHeaders        types.Bool   `tfsdk:"search_headers"`
+	ExpectedText         types.String `tfsdk:"expected_text"`
+	UnwantedText         types.String `tfsdk:"unwanted_text"`
+	SSLValidity          types.Int64  `tfsdk:"ssl_validity"`
+	Cookies              types.String `tfsdk:"cookies"`
+	PostParams           types.String `tfsdk:"post_params"`
+	CustomRequestHeaders types.String `tfsdk:"custom_request_headers"`
+	UserAgent            types.String `tfsdk:"user_agent"`
+	ForceResolve         types.String `tfsdk:"force_resolve"`
 }
 
 func (d *sensorHTTPDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -70,9 +94,21 @@ func (d *sensorHTTPDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 							MarkdownDescription: "Whether the sensor is enabled",
 							Computed:            true,
 						},
-						"params": schema.MapAttribute{
+						"params": schema.SingleNestedAttribute{
 							MarkdownDescription: "Sensor parameters",
-							ElementType:         types.StringType,
+							Computed:            true,
+							Attributes:          sensorHTTPDataSourceParamsAttributes(),
+						},
+						"ssl_issuer": schema.StringAttribute{
+							MarkdownDescription: "Issuer of the SSL certificate seen by the latest check. Null for non-HTTPS sensors, sensors that have not been checked yet, or when the latest result cannot be read.",
+							Computed:            true,
+						},
+						"ssl_expires_at": schema.StringAttribute{
+							MarkdownDescription: "Expiry of the SSL certificate seen by the latest check, in RFC 3339 format. Null for non-HTTPS sensors, sensors that have not been checked yet, or when the latest result cannot be read.",
+							Computed:            true,
+						},
+						"ssl_days_remaining": schema.Int64Attribute{
+							MarkdownDescription: "Whole days until the SSL certificate seen by the latest check expires. Null for non-HTTPS sensors, sensors that have not been checked yet, or when the latest result cannot be read.",
 							Computed:            true,
 						},
 					},
@@ -82,6 +118,64 @@ func (d *sensorHTTPDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 	}
 }
 
+// sensorHTTPDataSourceParamsAttributes returns the schema of the typed sensor parameters.
+func sensorHTTPDataSourceParamsAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"url": schema.StringAttribute{
+			MarkdownDescription: "URL to monitor",
+			Computed:            true,
+		},
+		"timeout": schema.Int64Attribute{
+			MarkdownDescription: "Timeout in seconds",
+			Computed:            true,
+		},
+		"response_code": schema.StringAttribute{
+			MarkdownDescription: "Expected HTTP response code",
+			Computed:            true,
+		},
+		"verify_ssl_cert": schema.BoolAttribute{
+			MarkdownDescription: "Whether to verify SSL certificate",
+			Computed:            true,
+		},
+		"search_headers": schema.BoolAttribute{
+			MarkdownDescription: "Whether to search headers for `expected_text` and `unwanted_text`",
+			Computed:            true,
+		},
+		"expected_text": schema.StringAttribute{
+			MarkdownDescription: "Expected text in response",
+			Computed:            true,
+		},
+		"unwanted_text": schema.StringAttribute{
+			MarkdownDescription: "Unwanted text in response",
+			Computed:            true,
+		},
+		"ssl_validity": schema.Int64Attribute{
+			MarkdownDescription: "SSL validity period in days",
+			Computed:            true,
+		},
+		"cookies": schema.StringAttribute{
+			MarkdownDescription: "Cookies to send with request",
+			Computed:            true,
+		},
+		"post_params": schema.StringAttribute{
+			MarkdownDescription: "POST parameters",
+			Computed:            true,
+		},
+		"custom_request_headers": schema.StringAttribute{
+			MarkdownDescription: "Custom request headers",
+			Computed:            true,
+		},
+		"user_agent": schema.StringAttribute{
+			MarkdownDescription: "User agent string",
+			Computed:            true,
+		},
+		"force_resolve": schema.StringAttribute{
+			MarkdownDescription: "Force resolve to specific IP",
+			Computed:            true,
+		},
+	}
+}
+
 func (d *sensorHTTPDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -123,29 +217,64 @@ func (d *sensorHTTPDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	// Map response body to schema and populate Computed attribute values
 	data.Sensors = make([]sensorHTTPDataSourceSensorModel, len(sensors))
 	for i, sensor := range sensors {
-		params := make(map[string]types.String)
-		params["url"] = types.StringValue(sensor.URL)
-		params["timeout"] = types.StringValue(fmt.Sprintf("%d", sensor.Timeout))
-		params["response_code"] = types.StringValue(sensor.ResponseCode)
-		params["verify_ssl_cert"] = types.StringValue(fmt.Sprintf("%t", sensor.VerifySSLCert))
-		params["search_headers"] = types.StringValue(fmt.Sprintf("%t", sensor.SearchHeaders))
-		params["expected_text"] = types.StringValue(sensor.ExpectedText)
-		params["unwanted_text"] = types.StringValue(sensor.UnwantedText)
-		params["ssl_validity"] = types.StringValue(fmt.Sprintf("%d", sensor.SSLValidity))
-		params["cookies"] = types.StringValue(sensor.Cookies)
-		params["post_params"] = types.StringValue(sensor.PostParams)
-		params["custom_request_headers"] = types.StringValue(sensor.CustomRequestHeaders)
-		params["user_agent"] = types.StringValue(sensor.UserAgent)
-		params["force_resolve"] = types.StringValue(sensor.ForceResolve)
-
 		data.Sensors[i] = sensorHTTPDataSourceSensorModel{
 			ID:       types.Int64Value(int64(sensor.ID)),
 			NiceName: types.StringValue(sensor.NiceName),
 			Enabled:  types.BoolValue(sensor.Enabled),
-			Params:   params,
+			Params:   sensorHTTPDataSourceParamsFromAPI(sensor),
+		}
+
+		// SSL details are only available for HTTPS sensors
+		var result *client.SensorHTTPResult
+		if strings.HasPrefix(strings.ToLower(sensor.URL), "https://") {
+			// The SSL details are best effort, leave them null rather than failing the read
+			result, err = d.client.GetSensorHTTPLatestResult(ctx, sensor.ID)
+			if err != nil {
+				tflog.Debug(ctx, "Unable to read latest result for HTTP sensor, leaving SSL details unset", map[string]interface{}{
+					"sensor_id": sensor.ID,
+					"error":     err.Error(),
+				})
+				result = nil
+			}
 		}
+		setSensorHTTPSSLDetails(&data.Sensors[i], result, time.Now())
 	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// sensorHTTPDataSourceParamsFromAPI maps the parameters of a sensor into the model.
+func sensorHTTPDataSourceParamsFromAPI(sensor *client.SensorHTTP) sensorHTTPDataSourceParamsModel {
+	return sensorHTTPDataSourceParamsModel{
+		URL:                  types.StringValue(sensor.URL),
+		Timeout:              types.Int64Value(int64(sensor.Timeout)),
+		ResponseCode:         types.StringValue(sensor.ResponseCode),
+		VerifySSLCert:        types.BoolValue(sensor.VerifySSLCert),
+		SearchHeaders:        types.BoolValue(sensor.SearchHeaders),
+		ExpectedText:         types.StringValue(sensor.ExpectedText),
+		UnwantedText:         types.StringValue(sensor.UnwantedText),
+		SSLValidity:          types.Int64Value(int64(sensor.SSLValidity)),
+		Cookies:              types.StringValue(sensor.Cookies),
+		PostParams:           types.StringValue(sensor.PostParams),
+		CustomRequestHeaders: types.StringValue(sensor.CustomRequestHeaders),
+		UserAgent:            types.StringValue(sensor.UserAgent),
+		ForceResolve:         types.StringValue(sensor.ForceResolve),
+	}
+}
+
+// setSensorHTTPSSLDetails maps the certificate details of a sensor check result into the model.
+// The attributes are null when there is no result or the result carries no certificate.
+func setSensorHTTPSSLDetails(model *sensorHTTPDataSourceSensorModel, result *client.SensorHTTPResult, now time.Time) {
+	model.SSLIssuer = types.StringNull()
+	model.SSLExpiresAt = types.StringNull()
+	model.SSLDaysRemaining = types.Int64Null()
+
+	if result == nil || result.SSLExpiry == nil {
+		return
+	}
+
+	model.SSLIssuer = types.StringValue(result.SSLIssuer)
+	model.SSLExpiresAt = types.StringValue(result.SSLExpiry.Format(time.RFC3339))
+	model.SSLDaysRemaining = types.Int64Value(int64(result.SSLExpiry.Sub(now) / (24 * time.Hour)))
+}
@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/radarnex/terraform-provider-wormly/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &sensorHTTPLookupDataSource{}
+	_ datasource.DataSourceWithConfigure = &sensorHTTPLookupDataSource{}
+)
+
+// NewSensorHTTPLookupDataSource is a helper function to simplify the provider implementation.
+func NewSensorHTTPLookupDataSource() datasource.DataSource {
+	return &sensorHTTPLookupDataSource{}
+}
+
+// sensorHTTPLookupDataSource is the data source implementation.
+type sensorHTTPLookupDataSource struct {
+	client client.SensorHTTPAPI
+}
+
+// sensorHTTPLookupDataSourceModel describes the data source data model.
+type sensorHTTPLookupDataSourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	HostID               types.Int64  `tfsdk:"host_id"`
+	SensorID             types.Int64  `tfsdk:"sensor_id"`
+	URL                  types.String `tfsdk:"url"`
+	NiceName             types.String `tfsdk:"nice_name"`
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	Timeout              types.Int64  `tfsdk:"timeout"`
+	ResponseCode         types.String `tfsdk:"response_code"`
+	VerifySSLCert        types.Bool   `tfsdk:"verify_ssl_cert"`
+	SearchHeaders        types.Bool   `tfsdk:"search_headers"`
+	ExpectedText         types.String `tfsdk:"expected_text"`
+	UnwantedText         types.String `tfsdk:"unwanted_text"`
+	SSLValidity          types.Int64  `tfsdk:"ssl_validity"`
+	Cookies              types.String `tfsdk:"cookies"`
+	PostParams           types.String `tfsdk:"post_params"`
+	CustomRequestHeaders types.String `tfsdk:"custom_request_headers"`
+	UserAgent            types.String `tfsdk:"user_agent"`
+	ForceResolve         types.String `tfsdk:"force_resolve"`
+}
+
+func (d *sensorHTTPLookupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sensor_http_lookup"
+}
+
+func (d *sensorHTTPLookupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a single Wormly HTTP sensor by its `<host_id>/<sensor_id>` identifier, as used by the `wormly_sensor_http` resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Sensor identifier in the format `<host_id>/<sensor_id>`",
+				Required:            true,
+			},
+			"host_id": schema.Int64Attribute{
+				MarkdownDescription: "Host identifier",
+				Computed:            true,
+			},
+			"sensor_id": schema.Int64Attribute{
+				MarkdownDescription: "Sensor identifier within the host",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL to monitor",
+				Computed:            true,
+			},
+			"nice_name": schema.StringAttribute{
+				MarkdownDescription: "Sensor nice name",
+				Computed:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the sensor is enabled",
+				Computed:            true,
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: "Timeout in seconds",
+				Computed:            true,
+			},
+			"response_code": schema.StringAttribute{
+				MarkdownDescription: "Expected HTTP response code",
+				Computed:            true,
+			},
+			"verify_ssl_cert": schema.BoolAttribute{
+				MarkdownDescription: "Whether to verify SSL certificate",
+				Computed:            true,
+			},
+			"search_headers": schema.BoolAttribute{
+				MarkdownDescription: "Whether to search headers for `expected_text` and `unwanted_text`",
+				Computed:            true,
+			},
+			"expected_text": schema.StringAttribute{
+				MarkdownDescription: "Expected text in response",
+				Computed:            true,
+			},
+			"unwanted_text": schema.StringAttribute{
+				MarkdownDescription: "Unwanted text in response",
+				Computed:            true,
+			},
+			"ssl_validity": schema.Int64Attribute{
+				MarkdownDescription: "SSL validity period in days",
+				Computed:            true,
+			},
+			"cookies": schema.StringAttribute{
+				MarkdownDescription: "Cookies to send with request",
+				Computed:            true,
+			},
+			"post_params": schema.StringAttribute{
+				MarkdownDescription: "POST parameters",
+				Computed:            true,
+			},
+			"custom_request_headers": schema.StringAttribute{
+				MarkdownDescription: "Custom request headers",
+				Computed:            true,
+			},
+			"user_agent": schema.StringAttribute{
+				MarkdownDescription: "User agent string",
+				Computed:            true,
+			},
+			"force_resolve": schema.StringAttribute{
+				MarkdownDescription: "Force resolve to specific IP",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *sensorHTTPLookupDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.SensorHTTPAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.SensorHTTPAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *sensorHTTPLookupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data sensorHTTPLookupDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostID, sensorID, err := parseSensorID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid Sensor ID",
+			fmt.Sprintf("Unable to parse sensor ID %q: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	// Read API call logic
+	sensor, err := d.client.GetSensorHTTP(ctx, hostID, sensorID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read HTTP sensor %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	data.HostID = types.Int64Value(int64(hostID))
+	data.SensorID = types.Int64Value(int64(sensor.ID))
+	data.URL = types.StringValue(sensor.URL)
+	data.NiceName = types.StringValue(sensor.NiceName)
+	data.Enabled = types.BoolValue(sensor.Enabled)
+	data.Timeout = types.Int64Value(int64(sensor.Timeout))
+	data.ResponseCode = types.StringValue(sensor.ResponseCode)
+	data.VerifySSLCert = types.BoolValue(sensor.VerifySSLCert)
+	data.SearchHeaders = types.BoolValue(sensor.SearchHeaders)
+	data.ExpectedText = types.StringValue(sensor.ExpectedText)
+	data.UnwantedText = types.StringValue(sensor.UnwantedText)
+	data.SSLValidity = types.Int64Value(int64(sensor.SSLValidity))
+	data.Cookies = types.StringValue(sensor.Cookies)
+	data.PostParams = types.StringValue(sensor.PostParams)
+	data.CustomRequestHeaders = types.StringValue(sensor.CustomRequestHeaders)
+	data.UserAgent = types.StringValue(sensor.UserAgent)
+	data.ForceResolve = types.StringValue(sensor.ForceResolve)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
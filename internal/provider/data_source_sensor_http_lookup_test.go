@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/radarnex/terraform-provider-wormly/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSensorHTTPLookupDataSource_Configure(t *testing.T) {
+	dataSource := &sensorHTTPLookupDataSource{}
+	mockClient := &client.MockSensorHTTPAPI{}
+
+	resp := &datasource.ConfigureResponse{}
+	dataSource.Configure(t.Context(), datasource.ConfigureRequest{ProviderData: mockClient}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.Equal(t, mockClient, dataSource.client)
+}
+
+func TestSensorHTTPLookupDataSource_Configure_Error(t *testing.T) {
+	dataSource := &sensorHTTPLookupDataSource{}
+
+	resp := &datasource.ConfigureResponse{}
+	dataSource.Configure(t.Context(), datasource.ConfigureRequest{ProviderData: "invalid"}, resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), "Unexpected Data Source Configure Type")
+}
+
+// newSensorHTTPLookupTestRequest builds a read request for the given sensor ID.
+func newSensorHTTPLookupTestRequest(t *testing.T, d *sensorHTTPLookupDataSource, id string) (datasource.ReadRequest, *datasource.ReadResponse) {
+	t.Helper()
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(t.Context(), datasource.SchemaRequest{}, schemaResp)
+	schemaType, ok := schemaResp.Schema.Type().TerraformType(t.Context()).(tftypes.Object)
+	if !ok {
+		t.Fatal("Expected the data source schema to be an object type")
+	}
+
+	values := make(map[string]tftypes.Value, len(schemaType.AttributeTypes))
+	for name, attrType := range schemaType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	values["id"] = tftypes.NewValue(tftypes.String, id)
+	raw := tftypes.NewValue(schemaType, values)
+
+	return datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw}},
+		&datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: raw}}
+}
+
+func TestSensorHTTPLookupDataSource_Read(t *testing.T) {
+	mockClient := &client.MockSensorHTTPAPI{}
+	mockClient.On("GetSensorHTTP", mock.Anything, 123, 456).Return(&client.SensorHTTP{
+		ID:                   456,
+		HostID:               123,
+		URL:                  "https://example.org",
+		NiceName:             "Homepage",
+		Enabled:              true,
+		Timeout:              60,
+		ResponseCode:         "200",
+		VerifySSLCert:        true,
+		SearchHeaders:        true,
+		ExpectedText:         "Success",
+		UnwantedText:         "Error",
+		SSLValidity:          14,
+		Cookies:              "session=abc123",
+		PostParams:           "user=test",
+		CustomRequestHeaders: "X-Probe: 1",
+		UserAgent:            "Custom Agent",
+		ForceResolve:         "127.0.0.1",
+	}, nil)
+
+	dataSource := &sensorHTTPLookupDataSource{client: mockClient}
+	req, resp := newSensorHTTPLookupTestRequest(t, dataSource, "123/456")
+
+	dataSource.Read(t.Context(), req, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+
+	var data sensorHTTPLookupDataSourceModel
+	assert.False(t, resp.State.Get(t.Context(), &data).HasError())
+	assert.Equal(t, "123/456", data.ID.ValueString())
+	assert.Equal(t, int64(123), data.HostID.ValueInt64())
+	assert.Equal(t, int64(456), data.SensorID.ValueInt64())
+	assert.Equal(t, "https://example.org", data.URL.ValueString())
+	assert.Equal(t, "Homepage", data.NiceName.ValueString())
+	assert.True(t, data.Enabled.ValueBool())
+	assert.Equal(t, int64(60), data.Timeout.ValueInt64())
+	assert.Equal(t, "200", data.ResponseCode.ValueString())
+	assert.True(t, data.VerifySSLCert.ValueBool())
+	assert.True(t, data.SearchHeaders.ValueBool())
+	assert.Equal(t, "Success", data.ExpectedText.ValueString())
+	assert.Equal(t, "Error", data.UnwantedText.ValueString())
+	assert.Equal(t, int64(14), data.SSLValidity.ValueInt64())
+	assert.Equal(t, "session=abc123", data.Cookies.ValueString())
+	assert.Equal(t, "user=test", data.PostParams.ValueString())
+	assert.Equal(t, "X-Probe: 1", data.CustomRequestHeaders.ValueString())
+	assert.Equal(t, "Custom Agent", data.UserAgent.ValueString())
+	assert.Equal(t, "127.0.0.1", data.ForceResolve.ValueString())
+	mockClient.AssertExpectations(t)
+}
+
+func TestSensorHTTPLookupDataSource_Read_Errors(t *testing.T) {
+	t.Run("invalid id", func(t *testing.T) {
+		dataSource := &sensorHTTPLookupDataSource{client: &client.MockSensorHTTPAPI{}}
+		req, resp := newSensorHTTPLookupTestRequest(t, dataSource, "456")
+
+		dataSource.Read(t.Context(), req, resp)
+
+		assert.True(t, resp.Diagnostics.HasError())
+		assert.Equal(t, "Invalid Sensor ID", resp.Diagnostics.Errors()[0].Summary())
+	})
+
+	t.Run("sensor not found", func(t *testing.T) {
+		mockClient := &client.MockSensorHTTPAPI{}
+		mockClient.On("GetSensorHTTP", mock.Anything, 123, 456).Return(nil, errors.New("sensor with ID 456 not found"))
+
+		dataSource := &sensorHTTPLookupDataSource{client: mockClient}
+		req, resp := newSensorHTTPLookupTestRequest(t, dataSource, "123/456")
+
+		dataSource.Read(t.Context(), req, resp)
+
+		assert.True(t, resp.Diagnostics.HasError())
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "not found")
+		mockClient.AssertExpectations(t)
+	})
+}
@@ -1,9 +1,17 @@
 package provider
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/radarnex/terraform-provider-wormly/internal/client"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -121,29 +129,215 @@ func TestSensorHTTPDataSource_Read(t *testing.T) {
 	}
 	mockClient.On("ListSensorHTTP", mock.Anything, 123).Return(expectedSensors, nil)
 
+	mockClient.On("GetSensorHTTPLatestResult", mock.Anything, mock.Anything).Return(nil, nil)
+
 	// Create data source with mock client
 	dataSource := &sensorHTTPDataSource{
 		client: mockClient,
 	}
 
-	// Test the client call directly
-	ctx := t.Context()
-	hostID := 123
-	sensors, err := dataSource.client.ListSensorHTTP(ctx, hostID)
-	assert.NoError(t, err)
-	assert.Equal(t, expectedSensors, sensors)
-	assert.Len(t, sensors, 2)
+	req, resp := newSensorHTTPDataSourceReadRequest(t, dataSource, 123)
+	dataSource.Read(t.Context(), req, resp)
+	assert.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+	var state sensorHTTPDataSourceModel
+	assert.False(t, resp.State.Get(t.Context(), &state).HasError())
+	assert.Len(t, state.Sensors, 2)
 
 	// Verify first sensor
-	assert.Equal(t, 1, sensors[0].ID)
-	assert.Equal(t, "Test Sensor 1", sensors[0].NiceName)
-	assert.Equal(t, "https://example.com", sensors[0].URL)
+	assert.Equal(t, int64(1), state.Sensors[0].ID.ValueInt64())
+	assert.Equal(t, "Test Sensor 1", state.Sensors[0].NiceName.ValueString())
+	assert.Equal(t, sensorHTTPDataSourceParamsModel{
+		URL:                  types.StringValue("https://example.com"),
+		Timeout:              types.Int64Value(30),
+		ResponseCode:         types.StringValue("200"),
+		VerifySSLCert:        types.BoolValue(true),
+		SearchHeaders:        types.BoolValue(false),
+		ExpectedText:         types.StringValue(""),
+		UnwantedText:         types.StringValue(""),
+		SSLValidity:          types.Int64Value(30),
+		Cookies:              types.StringValue(""),
+		PostParams:           types.StringValue(""),
+		CustomRequestHeaders: types.StringValue(""),
+		UserAgent:            types.StringValue(""),
+		ForceResolve:         types.StringValue(""),
+	}, state.Sensors[0].Params)
 
 	// Verify second sensor
-	assert.Equal(t, 2, sensors[1].ID)
-	assert.Equal(t, "Test Sensor 2", sensors[1].NiceName)
-	assert.Equal(t, "https://example.org", sensors[1].URL)
+	assert.Equal(t, int64(2), state.Sensors[1].ID.ValueInt64())
+	assert.Equal(t, "Test Sensor 2", state.Sensors[1].NiceName.ValueString())
+	assert.Equal(t, sensorHTTPDataSourceParamsModel{
+		URL:                  types.StringValue("https://example.org"),
+		Timeout:              types.Int64Value(60),
+		ResponseCode:         types.StringValue("200"),
+		VerifySSLCert:        types.BoolValue(false),
+		SearchHeaders:        types.BoolValue(true),
+		ExpectedText:         types.StringValue("Success"),
+		UnwantedText:         types.StringValue("Error"),
+		SSLValidity:          types.Int64Value(14),
+		Cookies:              types.StringValue("session=abc123"),
+		PostParams:           types.StringValue("user=test"),
+		CustomRequestHeaders: types.StringValue("X-API-Key: secret"),
+		UserAgent:            types.StringValue("Custom Agent"),
+		ForceResolve:         types.StringValue("127.0.0.1"),
+	}, state.Sensors[1].Params)
 
 	// Verify mock expectations
 	mockClient.AssertExpectations(t)
 }
+
+func TestSensorHTTPDataSource_Read_LatestResultError(t *testing.T) {
+	mockClient := &client.MockSensorHTTPAPI{}
+	mockClient.On("ListSensorHTTP", mock.Anything, 123).Return([]*client.SensorHTTP{
+		{ID: 1, HostID: 123, URL: "https://example.com", NiceName: "Secure", Enabled: true},
+		{ID: 2, HostID: 123, URL: "https://example.org", NiceName: "Also Secure", Enabled: true},
+	}, nil)
+	mockClient.On("GetSensorHTTPLatestResult", mock.Anything, 1).Return(nil, errors.New("API returned error code 5: Results unavailable"))
+	expiry := time.Now().Add(30 * 24 * time.Hour).UTC().Truncate(time.Second)
+	mockClient.On("GetSensorHTTPLatestResult", mock.Anything, 2).Return(&client.SensorHTTPResult{SSLIssuer: "Example CA", SSLExpiry: &expiry}, nil)
+
+	dataSource := &sensorHTTPDataSource{client: mockClient}
+
+	req, resp := newSensorHTTPDataSourceReadRequest(t, dataSource, 123)
+	dataSource.Read(t.Context(), req, resp)
+	assert.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+	var state sensorHTTPDataSourceModel
+	assert.False(t, resp.State.Get(t.Context(), &state).HasError())
+	if assert.Len(t, state.Sensors, 2) {
+		assert.Equal(t, "Secure", state.Sensors[0].NiceName.ValueString())
+		assert.True(t, state.Sensors[0].SSLIssuer.IsNull())
+		assert.True(t, state.Sensors[0].SSLExpiresAt.IsNull())
+		assert.True(t, state.Sensors[0].SSLDaysRemaining.IsNull())
+		assert.Equal(t, "Example CA", state.Sensors[1].SSLIssuer.ValueString())
+	}
+	mockClient.AssertExpectations(t)
+}
+
+func TestSensorHTTPDataSource_Read_DisabledSensorWithoutParams(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("cmd") {
+		case "getHostSensors":
+			fmt.Fprint(w, `{"errorcode": 0, "sensors": [{"hsid": "7", "sensorid": "2", "enabled": "0", "nicename": "Disabled", "params": null}]}`)
+		case "getSensorParams":
+			fmt.Fprint(w, `{"errorcode": 0, "params": {"url": "https://disabled.example.com"}}`)
+		case "getSensorResults":
+			fmt.Fprint(w, `{"errorcode": 0, "results": []}`)
+		default:
+			t.Errorf("Unexpected command %q", r.FormValue("cmd"))
+		}
+	}))
+	defer server.Close()
+
+	apiClient, err := client.New(client.Options{
+		HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 10.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+	})
+	assert.NoError(t, err)
+
+	dataSource := &sensorHTTPDataSource{client: apiClient}
+
+	req, resp := newSensorHTTPDataSourceReadRequest(t, dataSource, 123)
+	dataSource.Read(t.Context(), req, resp)
+	assert.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+	var state sensorHTTPDataSourceModel
+	assert.False(t, resp.State.Get(t.Context(), &state).HasError())
+	assert.Len(t, state.Sensors, 1)
+	assert.False(t, state.Sensors[0].Enabled.ValueBool())
+	assert.Equal(t, "https://disabled.example.com", state.Sensors[0].Params.URL.ValueString())
+	assert.True(t, state.Sensors[0].SSLExpiresAt.IsNull())
+}
+
+// newSensorHTTPDataSourceReadRequest builds a read request for the sensors of hostID.
+func newSensorHTTPDataSourceReadRequest(t *testing.T, dataSource *sensorHTTPDataSource, hostID int) (datasource.ReadRequest, *datasource.ReadResponse) {
+	t.Helper()
+
+	schemaResp := &datasource.SchemaResponse{}
+	dataSource.Schema(t.Context(), datasource.SchemaRequest{}, schemaResp)
+	schemaType := schemaResp.Schema.Type().TerraformType(t.Context())
+	objectType, ok := schemaType.(tftypes.Object)
+	if !ok {
+		t.Fatal("Expected object schema type")
+	}
+	sensorsType := objectType.AttributeTypes["sensors"]
+
+	req := datasource.ReadRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+				"host_id": tftypes.NewValue(tftypes.Number, hostID),
+				"sensors": tftypes.NewValue(sensorsType, nil),
+			}),
+		},
+	}
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaType, nil),
+		},
+	}
+
+	return req, resp
+}
+
+func TestSetSensorHTTPSSLDetails(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	expiry := time.Date(2026, 3, 2, 18, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name                  string
+		result                *client.SensorHTTPResult
+		expectedIssuer        types.String
+		expectedExpiresAt     types.String
+		expectedDaysRemaining types.Int64
+	}{
+		{
+			name: "certificate details",
+			result: &client.SensorHTTPResult{
+				HSID:      1,
+				CheckedAt: now,
+				SSLIssuer: "Let's Encrypt",
+				SSLExpiry: &expiry,
+			},
+			expectedIssuer:        types.StringValue("Let's Encrypt"),
+			expectedExpiresAt:     types.StringValue("2026-03-02T18:00:00Z"),
+			expectedDaysRemaining: types.Int64Value(60),
+		},
+		{
+			name:                  "never checked",
+			result:                nil,
+			expectedIssuer:        types.StringNull(),
+			expectedExpiresAt:     types.StringNull(),
+			expectedDaysRemaining: types.Int64Null(),
+		},
+		{
+			name:                  "no certificate",
+			result:                &client.SensorHTTPResult{HSID: 1, CheckedAt: now},
+			expectedIssuer:        types.StringNull(),
+			expectedExpiresAt:     types.StringNull(),
+			expectedDaysRemaining: types.Int64Null(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			model := sensorHTTPDataSourceSensorModel{SSLIssuer: types.StringValue("stale")}
+			setSensorHTTPSSLDetails(&model, tt.result, now)
+
+			assert.Equal(tt.expectedIssuer, model.SSLIssuer)
+			assert.Equal(tt.expectedExpiresAt, model.SSLExpiresAt)
+			assert.Equal(tt.expectedDaysRemaining, model.SSLDaysRemaining)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/radarnex/terraform-provider-wormly/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ datasource.DataSource = &sensorTypesDataSource{}
+
+// NewSensorTypesDataSource is a helper function to simplify the provider implementation.
+func NewSensorTypesDataSource() datasource.DataSource {
+	return &sensorTypesDataSource{}
+}
+
+// sensorTypesDataSource exposes the static sensor type ID to name mapping. It
+// makes no API calls, so it needs no client.
+type sensorTypesDataSource struct{}
+
+// sensorTypesDataSourceModel describes the data source data model.
+type sensorTypesDataSourceModel struct {
+	Names types.Map `tfsdk:"names"`
+}
+
+func (d *sensorTypesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sensor_types"
+}
+
+func (d *sensorTypesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Maps Wormly sensor type identifiers to their names, such as `2` to `http`. The mapping is built into the provider and reads nothing from the API.",
+
+		Attributes: map[string]schema.Attribute{
+			"names": schema.MapAttribute{
+				MarkdownDescription: "Sensor type names keyed by sensor type identifier",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *sensorTypesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data sensorTypesDataSourceModel
+
+	names, diags := types.MapValueFrom(ctx, types.StringType, client.SensorTypeNames)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Names = names
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
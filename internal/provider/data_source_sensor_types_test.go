@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSensorTypesDataSource_Read(t *testing.T) {
+	d := NewSensorTypesDataSource()
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(t.Context(), datasource.SchemaRequest{}, schemaResp)
+	schemaType := schemaResp.Schema.Type().TerraformType(t.Context())
+
+	req := datasource.ReadRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+				"names": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			}),
+		},
+	}
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	d.Read(t.Context(), req, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+	var names map[string]string
+	var state sensorTypesDataSourceModel
+	assert.False(t, resp.State.Get(t.Context(), &state).HasError())
+	assert.False(t, state.Names.ElementsAs(t.Context(), &names, false).HasError())
+	assert.Equal(t, "http", names["2"])
+	assert.Equal(t, "ping", names["1"])
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/radarnex/terraform-provider-wormly/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &sensorsHTTPMultiDataSource{}
+	_ datasource.DataSourceWithConfigure = &sensorsHTTPMultiDataSource{}
+)
+
+// NewSensorsHTTPMultiDataSource is a helper function to simplify the provider implementation.
+func NewSensorsHTTPMultiDataSource() datasource.DataSource {
+	return &sensorsHTTPMultiDataSource{}
+}
+
+// sensorsHTTPMultiDataSource lists the HTTP sensors of several hosts at once.
+type sensorsHTTPMultiDataSource struct {
+	client client.SensorHTTPAPI
+}
+
+// sensorsHTTPMultiDataSourceModel describes the data source data model.
+type sensorsHTTPMultiDataSourceModel struct {
+	HostIDs       types.Set                                 `tfsdk:"host_ids"`
+	SensorsByHost map[string]sensorsHTTPMultiDataSourceHost `tfsdk:"sensors_by_host"`
+}
+
+// sensorsHTTPMultiDataSourceHost describes the sensors of one host.
+type sensorsHTTPMultiDataSourceHost struct {
+	Sensors []sensorsHTTPMultiDataSourceSensorModel `tfsdk:"sensors"`
+}
+
+// sensorsHTTPMultiDataSourceSensorModel describes the sensor data model.
+type sensorsHTTPMultiDataSourceSensorModel struct {
+	ID       types.Int64                     `tfsdk:"id"`
+	NiceName types.String                    `tfsdk:"nice_name"`
+	Enabled  types.Bool                      `tfsdk:"enabled"`
+	Params   sensorHTTPDataSourceParamsModel `tfsdk:"params"`
+}
+
+func (d *sensorsHTTPMultiDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sensors_http_multi"
+}
+
+func (d *sensorsHTTPMultiDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the HTTP sensors of several hosts, fetching the hosts concurrently within the provider's rate limit. " +
+			"Use `wormly_sensor_http` for the SSL certificate details of a host's sensors.",
+
+		Attributes: map[string]schema.Attribute{
+			"host_ids": schema.SetAttribute{
+				MarkdownDescription: "Identifiers of the hosts to list sensors for",
+				ElementType:         types.Int64Type,
+				Required:            true,
+			},
+			"sensors_by_host": schema.MapNestedAttribute{
+				MarkdownDescription: "HTTP sensors keyed by host identifier. Sensors are ordered by identifier",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"sensors": schema.ListNestedAttribute{
+							MarkdownDescription: "List of HTTP sensors for the host",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.Int64Attribute{
+										MarkdownDescription: "Sensor identifier",
+										Computed:            true,
+									},
+									"nice_name": schema.StringAttribute{
+										MarkdownDescription: "Sensor nice name",
+										Computed:            true,
+									},
+									"enabled": schema.BoolAttribute{
+										MarkdownDescription: "Whether the sensor is enabled",
+										Computed:            true,
+									},
+									"params": schema.SingleNestedAttribute{
+										MarkdownDescription: "Sensor parameters",
+										Computed:            true,
+										Attributes:          sensorHTTPDataSourceParamsAttributes(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *sensorsHTTPMultiDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.SensorHTTPAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.SensorHTTPAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *sensorsHTTPMultiDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data sensorsHTTPMultiDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ids []int64
+	resp.Diagnostics.Append(data.HostIDs.ElementsAs(ctx, &ids, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch in a stable order so repeated reads issue the same requests
+	hostIDs := make([]int, len(ids))
+	for i, id := range ids {
+		hostIDs[i] = int(id)
+	}
+	slices.Sort(hostIDs)
+
+	sensorsByHost, err := d.client.ListSensorsHTTPBatch(ctx, hostIDs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read sensors, got error: %s", err))
+		return
+	}
+
+	data.SensorsByHost = make(map[string]sensorsHTTPMultiDataSourceHost, len(hostIDs))
+	for i, hostID := range hostIDs {
+		sensors := slices.SortedFunc(slices.Values(sensorsByHost[i]), func(a, b *client.SensorHTTP) int {
+			return cmp.Compare(a.ID, b.ID)
+		})
+
+		host := sensorsHTTPMultiDataSourceHost{
+			Sensors: make([]sensorsHTTPMultiDataSourceSensorModel, len(sensors)),
+		}
+		for j, sensor := range sensors {
+			host.Sensors[j] = sensorsHTTPMultiDataSourceSensorModel{
+				ID:       types.Int64Value(int64(sensor.ID)),
+				NiceName: types.StringValue(sensor.NiceName),
+				Enabled:  types.BoolValue(sensor.Enabled),
+				Params:   sensorHTTPDataSourceParamsFromAPI(sensor),
+			}
+		}
+		data.SensorsByHost[strconv.Itoa(hostID)] = host
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
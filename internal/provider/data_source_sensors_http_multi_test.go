@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/radarnex/terraform-provider-wormly/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSensorsHTTPMultiDataSource_Configure(t *testing.T) {
+	dataSource := &sensorsHTTPMultiDataSource{}
+	mockClient := &client.MockSensorHTTPAPI{}
+
+	resp := &datasource.ConfigureResponse{}
+	dataSource.Configure(t.Context(), datasource.ConfigureRequest{ProviderData: mockClient}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.Equal(t, mockClient, dataSource.client)
+}
+
+func TestSensorsHTTPMultiDataSource_Configure_Error(t *testing.T) {
+	dataSource := &sensorsHTTPMultiDataSource{}
+
+	resp := &datasource.ConfigureResponse{}
+	dataSource.Configure(t.Context(), datasource.ConfigureRequest{ProviderData: "invalid"}, resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), "Unexpected Data Source Configure Type")
+}
+
+func TestSensorsHTTPMultiDataSource_Read(t *testing.T) {
+	mockClient := &client.MockSensorHTTPAPI{}
+	mockClient.On("ListSensorsHTTPBatch", mock.Anything, []int{7, 12, 30}).Return([][]*client.SensorHTTP{
+		{
+			{ID: 72, HostID: 7, URL: "https://b.example.com", NiceName: "B", Enabled: true},
+			{ID: 71, HostID: 7, URL: "https://a.example.com", NiceName: "A", Enabled: false},
+		},
+		{
+			{ID: 121, HostID: 12, URL: "https://c.example.com", NiceName: "C", Enabled: true, Timeout: 30},
+		},
+		nil,
+	}, nil)
+
+	dataSource := &sensorsHTTPMultiDataSource{client: mockClient}
+	req, resp := newSensorsHTTPMultiDataSourceReadRequest(t, dataSource, 30, 7, 12)
+	dataSource.Read(t.Context(), req, resp)
+	assert.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+	var state sensorsHTTPMultiDataSourceModel
+	assert.False(t, resp.State.Get(t.Context(), &state).HasError())
+	assert.Len(t, state.SensorsByHost, 3)
+
+	if host := state.SensorsByHost["7"]; assert.Len(t, host.Sensors, 2) {
+		assert.Equal(t, int64(71), host.Sensors[0].ID.ValueInt64(), "Expected sensors ordered by ID")
+		assert.Equal(t, "A", host.Sensors[0].NiceName.ValueString())
+		assert.False(t, host.Sensors[0].Enabled.ValueBool())
+		assert.Equal(t, int64(72), host.Sensors[1].ID.ValueInt64())
+	}
+	if host := state.SensorsByHost["12"]; assert.Len(t, host.Sensors, 1) {
+		assert.Equal(t, "https://c.example.com", host.Sensors[0].Params.URL.ValueString())
+		assert.Equal(t, int64(30), host.Sensors[0].Params.Timeout.ValueInt64())
+	}
+	assert.Empty(t, state.SensorsByHost["30"].Sensors)
+	mockClient.AssertExpectations(t)
+}
+
+func TestSensorsHTTPMultiDataSource_Read_Error(t *testing.T) {
+	mockClient := &client.MockSensorHTTPAPI{}
+	mockClient.On("ListSensorsHTTPBatch", mock.Anything, []int{7, 12}).
+		Return([][]*client.SensorHTTP{{}, nil}, errors.New("host 12: API returned error code 1: Invalid hostid"))
+
+	dataSource := &sensorsHTTPMultiDataSource{client: mockClient}
+	req, resp := newSensorsHTTPMultiDataSourceReadRequest(t, dataSource, 12, 7)
+	dataSource.Read(t.Context(), req, resp)
+
+	if assert.True(t, resp.Diagnostics.HasError()) {
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "host 12")
+	}
+}
+
+// newSensorsHTTPMultiDataSourceReadRequest builds a read request for the sensors of hostIDs.
+func newSensorsHTTPMultiDataSourceReadRequest(t *testing.T, dataSource *sensorsHTTPMultiDataSource, hostIDs ...int) (datasource.ReadRequest, *datasource.ReadResponse) {
+	t.Helper()
+
+	schemaResp := &datasource.SchemaResponse{}
+	dataSource.Schema(t.Context(), datasource.SchemaRequest{}, schemaResp)
+	schemaType := schemaResp.Schema.Type().TerraformType(t.Context())
+	objectType, ok := schemaType.(tftypes.Object)
+	if !ok {
+		t.Fatal("Expected object schema type")
+	}
+
+	ids := make([]tftypes.Value, len(hostIDs))
+	for i, hostID := range hostIDs {
+		ids[i] = tftypes.NewValue(tftypes.Number, hostID)
+	}
+
+	req := datasource.ReadRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+				"host_ids":        tftypes.NewValue(objectType.AttributeTypes["host_ids"], ids),
+				"sensors_by_host": tftypes.NewValue(objectType.AttributeTypes["sensors_by_host"], nil),
+			}),
+		},
+	}
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaType, nil),
+		},
+	}
+
+	return req, resp
+}
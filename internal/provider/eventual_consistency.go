@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// eventualConsistencyDelay is the wait between reads of an object the API does not return yet.
+var eventualConsistencyDelay = time.Second
+
+// eventualConsistencySettings is implemented by provider data that carries the
+// eventual_consistency_retries setting.
+type eventualConsistencySettings interface {
+	EventualConsistencyRetries() int
+}
+
+// eventualConsistencyRetriesFrom returns the configured eventual consistency
+// retries, or 0 when the provider data does not carry the setting.
+func eventualConsistencyRetriesFrom(providerData any) int {
+	if settings, ok := providerData.(eventualConsistencySettings); ok {
+		return settings.EventualConsistencyRetries()
+	}
+	return 0
+}
+
+// retryWhileNotFound calls read until it returns anything but a not-found error,
+// retrying up to retries times. Wormly can take a moment to return an object it
+// just created, so a not-found right after a create is not necessarily final.
+func retryWhileNotFound[T any](ctx context.Context, retries int, read func() (T, error)) (T, error) {
+	result, err := read()
+	for attempt := 1; attempt <= retries && isNotFoundError(err); attempt++ {
+		tflog.Debug(ctx, "Object not found yet, retrying read", map[string]interface{}{
+			"attempt": attempt,
+			"error":   err.Error(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return result, err
+		case <-time.After(eventualConsistencyDelay):
+		}
+
+		result, err = read()
+	}
+	return result, err
+}
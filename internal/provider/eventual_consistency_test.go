@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/radarnex/terraform-provider-wormly/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// withoutEventualConsistencyDelay removes the wait between reads for the duration of the test.
+func withoutEventualConsistencyDelay(t *testing.T) {
+	t.Helper()
+
+	delay := eventualConsistencyDelay
+	eventualConsistencyDelay = 0
+	t.Cleanup(func() { eventualConsistencyDelay = delay })
+}
+
+func TestEventualConsistencyRetriesFrom(t *testing.T) {
+	assert.Equal(t, 5, eventualConsistencyRetriesFrom(&providerData{Client: &client.Client{}, eventualConsistencyRetries: 5}))
+	assert.Equal(t, 0, eventualConsistencyRetriesFrom(&client.MockSensorHTTPAPI{}))
+}
+
+func TestSensorHTTPResource_Create_EventualConsistency(t *testing.T) {
+	withoutEventualConsistencyDelay(t)
+
+	tests := []struct {
+		name          string
+		retries       int
+		expectWarning bool
+	}{
+		{name: "no tolerance", retries: 0, expectWarning: true},
+		{name: "tolerates delayed visibility", retries: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &client.MockSensorHTTPAPI{}
+			mockClient.On("CreateSensorHTTP", mock.Anything, mock.Anything).Return(&client.SensorHTTP{ID: 456, HostID: 123}, nil)
+			mockClient.On("DisableSensorHTTP", mock.Anything, 456).Return(nil)
+			// The sensor only becomes visible on the second read
+			mockClient.On("GetSensorHTTP", mock.Anything, 123, 456).
+				Return(nil, fmt.Errorf("HTTP sensor with ID 456 %w for host 123", client.ErrNotFound)).Once()
+			mockClient.On("GetSensorHTTP", mock.Anything, 123, 456).
+				Return(&client.SensorHTTP{ID: 456, HostID: 123, URL: "https://example.com"}, nil)
+
+			r := &sensorHTTPResource{client: mockClient, eventualConsistencyRetries: tt.retries}
+			config := newSensorHTTPTestConfig(t, r, map[string]tftypes.Value{
+				"host_id": tftypes.NewValue(tftypes.Number, 123),
+				"url":     tftypes.NewValue(tftypes.String, "https://example.com"),
+			})
+			resp := &frameworkresource.CreateResponse{State: tfsdk.State{Schema: config.Schema}}
+
+			r.Create(t.Context(), frameworkresource.CreateRequest{
+				Plan: tfsdk.Plan{Schema: config.Schema, Raw: config.Raw},
+			}, resp)
+
+			assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+			if tt.expectWarning {
+				// The sensor exists, so it stays in state for the next refresh
+				assert.Equal(t, 1, resp.Diagnostics.WarningsCount())
+				assert.False(t, resp.State.Raw.IsNull())
+				mockClient.AssertNumberOfCalls(t, "GetSensorHTTP", 1)
+				return
+			}
+			mockClient.AssertNumberOfCalls(t, "GetSensorHTTP", 2)
+		})
+	}
+}
+
+func TestScheduledDowntimePeriodResource_Read_EventualConsistency(t *testing.T) {
+	withoutEventualConsistencyDelay(t)
+
+	tests := []struct {
+		name          string
+		retries       int
+		expectRemoved bool
+	}{
+		{name: "no tolerance", retries: 0, expectRemoved: true},
+		{name: "tolerates delayed visibility", retries: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &client.MockScheduledDowntimePeriodAPI{}
+			// The period only becomes visible on the third read
+			mockClient.On("GetScheduledDowntimePeriod", mock.Anything, 12345, 123).
+				Return(nil, fmt.Errorf("scheduled downtime period with ID 123 %w", client.ErrNotFound)).Twice()
+			mockClient.On("GetScheduledDowntimePeriod", mock.Anything, 12345, 123).Return(&client.ScheduledDowntimePeriod{
+				ID:         123,
+				HostID:     12345,
+				Start:      "22:00",
+				End:        "06:00",
+				Timezone:   "GMT",
+				Recurrence: "DAILY",
+			}, nil)
+
+			r := &scheduledDowntimePeriodResource{client: mockClient, eventualConsistencyRetries: tt.retries}
+			config := newScheduledDowntimePeriodTestConfig(t, r, "DAILY", nil)
+			state := tfsdk.State{Schema: config.Schema, Raw: config.Raw}
+			assert.False(t, state.SetAttribute(t.Context(), path.Root("id"), "123").HasError())
+			resp := &frameworkresource.ReadResponse{State: state}
+
+			r.Read(t.Context(), frameworkresource.ReadRequest{State: state}, resp)
+
+			assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+			assert.Equal(t, tt.expectRemoved, resp.State.Raw.IsNull())
+		})
+	}
+}
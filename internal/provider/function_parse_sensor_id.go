@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &parseSensorIDFunction{}
+
+// parseSensorIDFunction splits a composite HTTP sensor ID into its components.
+type parseSensorIDFunction struct{}
+
+// parseSensorIDFunctionResult describes the parse_sensor_id return object.
+type parseSensorIDFunctionResult struct {
+	HostID   types.Int64 `tfsdk:"host_id"`
+	SensorID types.Int64 `tfsdk:"sensor_id"`
+}
+
+// NewParseSensorIDFunction creates a new parse_sensor_id function.
+func NewParseSensorIDFunction() function.Function {
+	return &parseSensorIDFunction{}
+}
+
+func (f *parseSensorIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_sensor_id"
+}
+
+func (f *parseSensorIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse a sensor identifier",
+		MarkdownDescription: "Parses a `wormly_sensor_http` identifier in format `<host_id>/<sensor_id>` into an object with `host_id` and `sensor_id` attributes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "Sensor identifier in format <host_id>/<sensor_id>",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"host_id":   types.Int64Type,
+				"sensor_id": types.Int64Type,
+			},
+		},
+	}
+}
+
+func (f *parseSensorIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	hostID, sensorID, err := parseSensorID(id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unable to parse sensor ID %q: %s", id, err))
+		return
+	}
+
+	if hostID <= 0 {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("host_id must be a positive integer, got: %d", hostID))
+		return
+	}
+
+	if sensorID <= 0 {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("sensor_id must be a positive integer, got: %d", sensorID))
+		return
+	}
+
+	result := parseSensorIDFunctionResult{
+		HostID:   types.Int64Value(int64(hostID)),
+		SensorID: types.Int64Value(int64(sensorID)),
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, &result))
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSensorIDFunction_Run(t *testing.T) {
+	attrTypes := map[string]attr.Type{
+		"host_id":   types.Int64Type,
+		"sensor_id": types.Int64Type,
+	}
+
+	tests := []struct {
+		name           string
+		id             string
+		expectedHost   int64
+		expectedSensor int64
+		expectError    bool
+	}{
+		{
+			name:           "valid ID",
+			id:             "123/456",
+			expectedHost:   123,
+			expectedSensor: 456,
+		},
+		{
+			name:        "invalid format - no slash",
+			id:          "123456",
+			expectError: true,
+		},
+		{
+			name:        "invalid format - too many parts",
+			id:          "123/456/789",
+			expectError: true,
+		},
+		{
+			name:        "invalid host ID",
+			id:          "abc/456",
+			expectError: true,
+		},
+		{
+			name:        "invalid sensor ID",
+			id:          "123/def",
+			expectError: true,
+		},
+		{
+			name:        "negative host ID",
+			id:          "-1/456",
+			expectError: true,
+		},
+		{
+			name:        "zero host ID",
+			id:          "0/456",
+			expectError: true,
+		},
+		{
+			name:        "negative sensor ID",
+			id:          "123/-1",
+			expectError: true,
+		},
+		{
+			name:        "zero sensor ID",
+			id:          "123/0",
+			expectError: true,
+		},
+		{
+			name:        "empty ID",
+			id:          "",
+			expectError: true,
+		},
+		{
+			name:        "missing host ID",
+			id:          "/456",
+			expectError: true,
+		},
+		{
+			name:        "missing sensor ID",
+			id:          "123/",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.id)}),
+			}
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.ObjectUnknown(attrTypes)),
+			}
+
+			NewParseSensorIDFunction().Run(t.Context(), req, resp)
+
+			if tt.expectError {
+				assert.NotNil(t, resp.Error)
+				return
+			}
+
+			assert.Nil(t, resp.Error)
+			expected := types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"host_id":   types.Int64Value(tt.expectedHost),
+				"sensor_id": types.Int64Value(tt.expectedSensor),
+			})
+			assert.Equal(t, expected, resp.Result.Value())
+		})
+	}
+}
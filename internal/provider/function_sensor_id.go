@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &sensorIDFunction{}
+
+// sensorIDFunction builds a composite HTTP sensor ID.
+type sensorIDFunction struct{}
+
+// NewSensorIDFunction creates a new sensor_id function.
+func NewSensorIDFunction() function.Function {
+	return &sensorIDFunction{}
+}
+
+func (f *sensorIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "sensor_id"
+}
+
+func (f *sensorIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build a sensor identifier",
+		MarkdownDescription: "Builds a `wormly_sensor_http` identifier in format `<host_id>/<sensor_id>`.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:                "host_id",
+				MarkdownDescription: "Host identifier",
+			},
+			function.Int64Parameter{
+				Name:                "sensor_id",
+				MarkdownDescription: "Sensor identifier (HSID)",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *sensorIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var hostID, sensorID int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &hostID, &sensorID))
+	if resp.Error != nil {
+		return
+	}
+
+	if hostID <= 0 {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("host_id must be a positive integer, got: %d", hostID))
+		return
+	}
+	if sensorID <= 0 {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("sensor_id must be a positive integer, got: %d", sensorID))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, formatSensorID(int(hostID), int(sensorID))))
+}
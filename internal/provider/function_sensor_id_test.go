@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSensorIDFunction_Run(t *testing.T) {
+	tests := []struct {
+		name        string
+		hostID      int64
+		sensorID    int64
+		expected    string
+		expectError bool
+	}{
+		{
+			name:     "valid IDs",
+			hostID:   123,
+			sensorID: 456,
+			expected: "123/456",
+		},
+		{
+			name:        "zero host ID",
+			hostID:      0,
+			sensorID:    456,
+			expectError: true,
+		},
+		{
+			name:        "negative sensor ID",
+			hostID:      123,
+			sensorID:    -1,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.Int64Value(tt.hostID),
+					types.Int64Value(tt.sensorID),
+				}),
+			}
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+
+			NewSensorIDFunction().Run(t.Context(), req, resp)
+
+			if tt.expectError {
+				assert.NotNil(t, resp.Error)
+				return
+			}
+
+			assert.Nil(t, resp.Error)
+			assert.Equal(t, types.StringValue(tt.expected), resp.Result.Value())
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"maps"
+	"testing"
+
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// resourceTestValue is a raw value of a resource schema, used to build the
+// config, plan and state of resource requests in unit tests.
+type resourceTestValue struct {
+	schema schema.Schema
+	raw    tftypes.Value
+}
+
+// newResourceTestValue builds a value of the resource schema with the given
+// attribute values; every other attribute is null.
+func newResourceTestValue(t *testing.T, r frameworkresource.Resource, attributes map[string]tftypes.Value) resourceTestValue {
+	t.Helper()
+
+	schemaResp := &frameworkresource.SchemaResponse{}
+	r.Schema(t.Context(), frameworkresource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+	schemaType, ok := schemaResp.Schema.Type().TerraformType(t.Context()).(tftypes.Object)
+	if !ok {
+		t.Fatal("Expected the resource schema to be an object type")
+	}
+
+	values := make(map[string]tftypes.Value, len(schemaType.AttributeTypes))
+	for name, attrType := range schemaType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	for name, value := range attributes {
+		if _, ok := schemaType.AttributeTypes[name]; !ok {
+			t.Fatalf("Attribute %q is not in the resource schema", name)
+		}
+		values[name] = value
+	}
+
+	return resourceTestValue{schema: schemaResp.Schema, raw: tftypes.NewValue(schemaType, values)}
+}
+
+func (v resourceTestValue) Config() tfsdk.Config {
+	return tfsdk.Config{Schema: v.schema, Raw: v.raw}
+}
+
+func (v resourceTestValue) Plan() tfsdk.Plan {
+	return tfsdk.Plan{Schema: v.schema, Raw: v.raw}
+}
+
+func (v resourceTestValue) State() tfsdk.State {
+	return tfsdk.State{Schema: v.schema, Raw: v.raw}
+}
+
+// withTestAttributes returns a copy of the raw object value with the given
+// attributes replaced, such as a plan that changes some attributes of a state.
+func withTestAttributes(t *testing.T, raw tftypes.Value, overrides map[string]tftypes.Value) tftypes.Value {
+	t.Helper()
+
+	attributes := map[string]tftypes.Value{}
+	if err := raw.As(&attributes); err != nil {
+		t.Fatalf("Unable to read the resource value: %s", err)
+	}
+
+	// Copy the attributes, As shares the map backing the value
+	attributes = maps.Clone(attributes)
+	for name, value := range overrides {
+		if _, ok := attributes[name]; !ok {
+			t.Fatalf("Attribute %q is not in the resource schema", name)
+		}
+		attributes[name] = value
+	}
+
+	return tftypes.NewValue(raw.Type(), attributes)
+}
+
+// numberSetTestValue builds a raw set of numbers, such as host IDs.
+func numberSetTestValue(numbers []int) tftypes.Value {
+	values := make([]tftypes.Value, 0, len(numbers))
+	for _, number := range numbers {
+		values = append(values, tftypes.NewValue(tftypes.Number, number))
+	}
+	return tftypes.NewValue(tftypes.Set{ElementType: tftypes.Number}, values)
+}
@@ -2,12 +2,22 @@ package provider
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
 	"log"
+	"net"
 	"net/http"
+	"net/url"
 	"os"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -15,34 +25,106 @@ import (
 	"github.com/radarnex/terraform-provider-wormly/internal/client"
 )
 
+// apiKeyEnvVar is the environment variable the API key is read from when api_key is not set.
+const apiKeyEnvVar = "WORMLY_API_KEY"
+
 // Config represents the provider configuration.
 type Config struct {
 	APIKey            string
 	BaseURL           string
 	RequestsPerSecond float64
+	RequestsBurst     int
 	MaxRetries        int
 	InitialBackoff    time.Duration
 	BackoffMultiplier float64
 	MaxBackoff        time.Duration
-	UserAgent         string
-	Debug             bool
+	RetryStrategy     client.RetryStrategy
+	RetryOnStatus     []int
+	MaxResponseBytes  int64
+	ResponseFormat    client.ResponseFormat
+	// EventualConsistencyRetries is how many times a newly created object is re-read while the API reports it as not found.
+	EventualConsistencyRetries int
+	// CircuitBreakerThreshold is how many consecutive transient failures pause requests to the API; 0 disables the breaker.
+	CircuitBreakerThreshold int
+	RequestTimeout          time.Duration
+	// CommandTimeout bounds each API command including its retries; 0 leaves commands unbounded.
+	CommandTimeout     time.Duration
+	UserAgent          string
+	Debug              bool
+	ProxyURL           string
+	InsecureSkipVerify bool
+	// MaxIdleConns and MaxIdleConnsPerHost size the pool of idle connections kept for reuse.
+	MaxIdleConns        int
+	MaxIdleConnsPerHost int
+	// DefaultTestInterval is the test interval in seconds of hosts that do not set one; 0 keeps the host default.
+	DefaultTestInterval int
+	// VerifyCredentials checks the API key against the API while configuring the provider.
+	VerifyCredentials bool
+}
+
+// providerData is passed to resources as their provider data. It embeds the
+// API client, so resources still assert the client API interfaces they use,
+// and carries the settings that only the provider's resources act on.
+type providerData struct {
+	*client.Client
+
+	defaultTestInterval        int
+	eventualConsistencyRetries int
+}
+
+// DefaultTestInterval returns the test interval in seconds planned for new
+// hosts that do not set one, or 0 to keep the host default.
+func (d *providerData) DefaultTestInterval() int {
+	return d.defaultTestInterval
+}
+
+// EventualConsistencyRetries returns how many times a read of a newly created
+// object is retried while the API still reports it as not found.
+func (d *providerData) EventualConsistencyRetries() int {
+	return d.eventualConsistencyRetries
 }
 
 // wormlyProviderModel represents the provider configuration model.
 type wormlyProviderModel struct {
-	APIKey            types.String  `tfsdk:"api_key"`
-	BaseURL           types.String  `tfsdk:"base_url"`
-	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
-	MaxRetries        types.Int64   `tfsdk:"max_retries"`
-	InitialBackoff    types.String  `tfsdk:"initial_backoff"`
-	BackoffMultiplier types.Float64 `tfsdk:"backoff_multiplier"`
-	MaxBackoff        types.String  `tfsdk:"max_backoff"`
-	UserAgent         types.String  `tfsdk:"user_agent"`
-	Debug             types.Bool    `tfsdk:"debug"`
+	APIKey                     types.String  `tfsdk:"api_key"`
+	BaseURL                    types.String  `tfsdk:"base_url"`
+	RequestsPerSecond          types.Float64 `tfsdk:"requests_per_second"`
+	RequestsBurst              types.Int64   `tfsdk:"requests_burst"`
+	MaxRetries                 types.Int64   `tfsdk:"max_retries"`
+	InitialBackoff             types.String  `tfsdk:"initial_backoff"`
+	BackoffMultiplier          types.Float64 `tfsdk:"backoff_multiplier"`
+	MaxBackoff                 types.String  `tfsdk:"max_backoff"`
+	RetryStrategy              types.String  `tfsdk:"retry_strategy"`
+	RetryOnStatus              types.List    `tfsdk:"retry_on_status"`
+	MaxResponseBytes           types.Int64   `tfsdk:"max_response_bytes"`
+	ResponseFormat             types.String  `tfsdk:"response_format"`
+	EventualConsistencyRetries types.Int64   `tfsdk:"eventual_consistency_retries"`
+	CircuitBreakerThreshold    types.Int64   `tfsdk:"circuit_breaker_threshold"`
+	RequestTimeout             types.String  `tfsdk:"request_timeout"`
+	CommandTimeout             types.String  `tfsdk:"command_timeout"`
+	UserAgent                  types.String  `tfsdk:"user_agent"`
+	Debug                      types.Bool    `tfsdk:"debug"`
+	ProxyURL                   types.String  `tfsdk:"proxy_url"`
+	InsecureSkipVerify         types.Bool    `tfsdk:"insecure_skip_verify"`
+	MaxIdleConns               types.Int64   `tfsdk:"max_idle_conns"`
+	MaxIdleConnsPerHost        types.Int64   `tfsdk:"max_idle_conns_per_host"`
+	DefaultTestInterval        types.Int64   `tfsdk:"default_test_interval"`
+	VerifyCredentials          types.Bool    `tfsdk:"verify_credentials"`
 }
 
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ provider.Provider                   = &wormlyProvider{}
+	_ provider.ProviderWithFunctions      = &wormlyProvider{}
+	_ provider.ProviderWithValidateConfig = &wormlyProvider{}
+)
+
 type wormlyProvider struct {
 	version string
+
+	// client is the API client from the latest Configure, closed when Configure runs again.
+	mu     sync.Mutex
+	client *client.Client
 }
 
 // New creates a new provider instance.
@@ -61,20 +143,24 @@ func (p *wormlyProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"api_key": schema.StringAttribute{
-				MarkdownDescription: "Wormly API key.",
-				Required:            true,
+				MarkdownDescription: "Wormly API key. May also be provided via the `WORMLY_API_KEY` environment variable, which is used when this is unset or empty.",
+				Optional:            true,
 				Sensitive:           true,
 			},
 			"base_url": schema.StringAttribute{
-				MarkdownDescription: "Base URL for the Wormly API. Defaults to 'https://api.wormly.com'.",
+				MarkdownDescription: "Base URL for the Wormly API. Must be an absolute URL and may include a path prefix, such as 'https://gw.example.com/wormly/api'; trailing slashes are ignored. A warning is shown when it does not look like an API endpoint, such as the Wormly web UI. Defaults to 'https://api.wormly.com'.",
 				Optional:            true,
 			},
 			"requests_per_second": schema.Float64Attribute{
-				MarkdownDescription: "Maximum number of requests per second to the Wormly API. Defaults to 10.",
+				MarkdownDescription: "Maximum number of requests per second to the Wormly API. Must be greater than 0. A warning is shown when this exceeds the API rate limit reported for the account. Defaults to 10.",
+				Optional:            true,
+			},
+			"requests_burst": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of requests that may be sent back to back before `requests_per_second` applies. Must be at least 1. Defaults to 1.",
 				Optional:            true,
 			},
 			"max_retries": schema.Int64Attribute{
-				MarkdownDescription: "Maximum number of retries for failed requests. Defaults to 3.",
+				MarkdownDescription: "Maximum number of retries for failed requests. Must not be negative. Defaults to 3.",
 				Optional:            true,
 			},
 			"initial_backoff": schema.StringAttribute{
@@ -82,25 +168,144 @@ func (p *wormlyProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 				Optional:            true,
 			},
 			"backoff_multiplier": schema.Float64Attribute{
-				MarkdownDescription: "Multiplier for exponential backoff. Defaults to 2.0.",
+				MarkdownDescription: "Multiplier for exponential backoff. Must be at least 1.0. Defaults to 2.0.",
 				Optional:            true,
 			},
 			"max_backoff": schema.StringAttribute{
-				MarkdownDescription: "Maximum backoff duration. Defaults to '30s'.",
+				MarkdownDescription: "Maximum backoff duration. Must not be smaller than `initial_backoff`. Defaults to '30s'.",
+				Optional:            true,
+			},
+			"retry_strategy": schema.StringAttribute{
+				MarkdownDescription: "How the delay between retries evolves: `exponential` (deterministic), `exponential_jitter` (randomised to spread out concurrent retries) or `constant` (always `initial_backoff`). Defaults to 'exponential'.",
+				Optional:            true,
+			},
+			"retry_on_status": schema.ListAttribute{
+				MarkdownDescription: "HTTP status codes that are considered transient and retried. Commands that create objects, such as a new host, are only retried on 429, since Wormly may have created the object before failing. Defaults to `[429, 500, 502, 503, 504]`.",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+			},
+			"max_response_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Maximum size in bytes of a Wormly API response body. Larger responses fail instead of being read into memory. Must be at least 1. Defaults to 10485760 (10 MiB).",
+				Optional:            true,
+			},
+			"response_format": schema.StringAttribute{
+				MarkdownDescription: "Format Wormly API responses are requested and decoded in: `json`, or `xml` for legacy endpoints that do not serve JSON. Defaults to 'json'.",
+				Optional:            true,
+			},
+			"eventual_consistency_retries": schema.Int64Attribute{
+				MarkdownDescription: "How many times to re-read an HTTP sensor or scheduled downtime period that Wormly reports as not found, waiting 1s between attempts, before treating it as missing. Smooths over Wormly's propagation delay right after creation; set to 0 to detect deleted objects without delay. Defaults to 3.",
+				Optional:            true,
+			},
+			"circuit_breaker_threshold": schema.Int64Attribute{
+				MarkdownDescription: "Number of consecutive transient failures after which requests to the Wormly API fail immediately instead of retrying. After a 30s cooldown a single request probes whether the API has recovered. Shared by every resource in the run, so an outage fails the apply quickly instead of exhausting each resource's retries. Defaults to 0 (disabled).",
+				Optional:            true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "Timeout for each HTTP request to the Wormly API. Defaults to '30s'.",
+				Optional:            true,
+			},
+			"command_timeout": schema.StringAttribute{
+				MarkdownDescription: "Timeout for each Wormly API command, covering its rate limiting wait, retries and backoff, so a single slow command cannot stall the whole run. Each HTTP request within it is still bounded by `request_timeout`. Defaults to no limit.",
 				Optional:            true,
 			},
 			"user_agent": schema.StringAttribute{
-				MarkdownDescription: "User agent string for API requests. Defaults to 'terraform-provider-wormly/dev'.",
+				MarkdownDescription: "User agent string for API requests. Defaults to the provider and Terraform versions, such as 'terraform-provider-wormly/1.2.3 terraform/1.7.0'.",
 				Optional:            true,
 			},
 			"debug": schema.BoolAttribute{
 				MarkdownDescription: "Enable debug logging for API requests and responses. Defaults to false.",
 				Optional:            true,
 			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of an HTTP(S) proxy to route API requests through. Defaults to the proxy from the standard `HTTPS_PROXY`/`NO_PROXY` environment variables.",
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip TLS certificate verification for API requests. Only use this for testing. Defaults to false.",
+				Optional:            true,
+			},
+			"max_idle_conns": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of idle connections kept open for reuse across all hosts. Must be at least 1. Defaults to 100.",
+				Optional:            true,
+			},
+			"max_idle_conns_per_host": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of idle connections kept open for reuse to the Wormly API host. Raise it together with `requests_burst` when managing many resources, so parallel requests reuse connections instead of opening new ones. Must be at least 1. Defaults to 10.",
+				Optional:            true,
+			},
+			"default_test_interval": schema.Int64Attribute{
+				MarkdownDescription: "Test interval in seconds of new `wormly_host` resources that do not set `test_interval`. Existing hosts keep their interval when it changes. Must be at least 1. Defaults to 60.",
+				Optional:            true,
+			},
+			"verify_credentials": schema.BoolAttribute{
+				MarkdownDescription: "Check the API key and connectivity with a cheap read when the provider is configured, so an invalid key fails before any resource is touched. Costs one API request per run. Defaults to false.",
+				Optional:            true,
+			},
 		},
 	}
 }
 
+// ValidateConfig catches retry settings that only fail or misbehave at runtime.
+// Durations that do not parse are left for Configure to report.
+func (p *wormlyProvider) ValidateConfig(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	var data wormlyProviderModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.MaxRetries.IsNull() && !data.MaxRetries.IsUnknown() && data.MaxRetries.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"Invalid Max Retries",
+			fmt.Sprintf("max_retries must not be negative, got: %d", data.MaxRetries.ValueInt64()),
+		)
+	}
+
+	if !data.RequestsPerSecond.IsNull() && !data.RequestsPerSecond.IsUnknown() && data.RequestsPerSecond.ValueFloat64() <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Invalid Requests Per Second",
+			fmt.Sprintf("requests_per_second must be greater than 0, got: %g", data.RequestsPerSecond.ValueFloat64()),
+		)
+	}
+
+	if !data.BackoffMultiplier.IsNull() && !data.BackoffMultiplier.IsUnknown() && data.BackoffMultiplier.ValueFloat64() < 1.0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("backoff_multiplier"),
+			"Invalid Backoff Multiplier",
+			fmt.Sprintf("backoff_multiplier must be at least 1.0 so that backoff does not shrink between retries, got: %g", data.BackoffMultiplier.ValueFloat64()),
+		)
+	}
+
+	// Unset durations fall back to the defaults applied in Configure
+	initialBackoff, maxBackoff := time.Second, 30*time.Second
+	if data.InitialBackoff.IsUnknown() || data.MaxBackoff.IsUnknown() {
+		return
+	}
+	if !data.InitialBackoff.IsNull() {
+		duration, err := time.ParseDuration(data.InitialBackoff.ValueString())
+		if err != nil {
+			return
+		}
+		initialBackoff = duration
+	}
+	if !data.MaxBackoff.IsNull() {
+		duration, err := time.ParseDuration(data.MaxBackoff.ValueString())
+		if err != nil {
+			return
+		}
+		maxBackoff = duration
+	}
+	if maxBackoff < initialBackoff {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_backoff"),
+			"Invalid Max Backoff",
+			fmt.Sprintf("max_backoff (%s) must not be smaller than initial_backoff (%s)", maxBackoff, initialBackoff),
+		)
+	}
+}
+
 func (p *wormlyProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var data wormlyProviderModel
 
@@ -111,26 +316,48 @@ func (p *wormlyProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 	// Build configuration with defaults
 	config := Config{
-		APIKey:            data.APIKey.ValueString(),
-		BaseURL:           "https://api.wormly.com",
-		RequestsPerSecond: 3.0,
-		MaxRetries:        3,
-		InitialBackoff:    time.Second,
-		BackoffMultiplier: 2.0,
-		MaxBackoff:        30 * time.Second,
-		UserAgent:         "terraform-provider-wormly/dev",
-		Debug:             false,
+		APIKey:                     data.APIKey.ValueString(),
+		BaseURL:                    "https://api.wormly.com",
+		RequestsPerSecond:          3.0,
+		RequestsBurst:              1,
+		MaxRetries:                 3,
+		InitialBackoff:             time.Second,
+		BackoffMultiplier:          2.0,
+		MaxBackoff:                 30 * time.Second,
+		RetryStrategy:              client.RetryStrategyExponential,
+		RetryOnStatus:              client.DefaultRetryOnStatus,
+		MaxResponseBytes:           client.DefaultMaxResponseBytes,
+		ResponseFormat:             client.ResponseFormatJSON,
+		EventualConsistencyRetries: 3,
+		RequestTimeout:             30 * time.Second,
+		UserAgent:                  defaultUserAgent(p.version, req.TerraformVersion),
+		Debug:                      false,
+		MaxIdleConns:               100,
+		MaxIdleConnsPerHost:        10,
 	}
 
 	// Override with configured values if provided
 	if !data.BaseURL.IsNull() && !data.BaseURL.IsUnknown() {
 		config.BaseURL = data.BaseURL.ValueString()
+		resp.Diagnostics.Append(checkBaseURL(config.BaseURL)...)
 	}
 
 	if !data.RequestsPerSecond.IsNull() && !data.RequestsPerSecond.IsUnknown() {
 		config.RequestsPerSecond = data.RequestsPerSecond.ValueFloat64()
 	}
 
+	if !data.RequestsBurst.IsNull() && !data.RequestsBurst.IsUnknown() {
+		if burst := data.RequestsBurst.ValueInt64(); burst < 1 {
+			resp.Diagnostics.AddError(
+				"Invalid Requests Burst",
+				fmt.Sprintf("requests_burst must be at least 1, got: %d", burst),
+			)
+			return
+		} else {
+			config.RequestsBurst = int(burst)
+		}
+	}
+
 	if !data.MaxRetries.IsNull() && !data.MaxRetries.IsUnknown() {
 		config.MaxRetries = int(data.MaxRetries.ValueInt64())
 	}
@@ -163,6 +390,117 @@ func (p *wormlyProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		}
 	}
 
+	if !data.RetryStrategy.IsNull() && !data.RetryStrategy.IsUnknown() {
+		strategy := client.RetryStrategy(data.RetryStrategy.ValueString())
+		if !strategy.IsValid() {
+			resp.Diagnostics.AddError(
+				"Invalid Retry Strategy",
+				fmt.Sprintf("retry_strategy must be one of %q, got: %q", client.RetryStrategies, strategy),
+			)
+			return
+		}
+		config.RetryStrategy = strategy
+	}
+
+	if !data.ResponseFormat.IsNull() && !data.ResponseFormat.IsUnknown() {
+		format := client.ResponseFormat(data.ResponseFormat.ValueString())
+		if !format.IsValid() {
+			resp.Diagnostics.AddError(
+				"Invalid Response Format",
+				fmt.Sprintf("response_format must be one of %q, got: %q", client.ResponseFormats, format),
+			)
+			return
+		}
+		config.ResponseFormat = format
+	}
+
+	if !data.RetryOnStatus.IsNull() && !data.RetryOnStatus.IsUnknown() {
+		var statusCodes []int64
+		resp.Diagnostics.Append(data.RetryOnStatus.ElementsAs(ctx, &statusCodes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		config.RetryOnStatus = make([]int, 0, len(statusCodes))
+		for _, statusCode := range statusCodes {
+			if statusCode < 100 || statusCode > 599 {
+				resp.Diagnostics.AddError(
+					"Invalid Retry Status Code",
+					fmt.Sprintf("retry_on_status must only contain HTTP status codes between 100 and 599, got: %d", statusCode),
+				)
+				return
+			}
+			config.RetryOnStatus = append(config.RetryOnStatus, int(statusCode))
+		}
+	}
+
+	if !data.MaxResponseBytes.IsNull() && !data.MaxResponseBytes.IsUnknown() {
+		if maxResponseBytes := data.MaxResponseBytes.ValueInt64(); maxResponseBytes < 1 {
+			resp.Diagnostics.AddError(
+				"Invalid Max Response Bytes",
+				fmt.Sprintf("max_response_bytes must be at least 1, got: %d", maxResponseBytes),
+			)
+			return
+		} else {
+			config.MaxResponseBytes = maxResponseBytes
+		}
+	}
+
+	if !data.EventualConsistencyRetries.IsNull() && !data.EventualConsistencyRetries.IsUnknown() {
+		if retries := data.EventualConsistencyRetries.ValueInt64(); retries < 0 {
+			resp.Diagnostics.AddError(
+				"Invalid Eventual Consistency Retries",
+				fmt.Sprintf("eventual_consistency_retries must not be negative, got: %d", retries),
+			)
+			return
+		} else {
+			config.EventualConsistencyRetries = int(retries)
+		}
+	}
+
+	if !data.CircuitBreakerThreshold.IsNull() && !data.CircuitBreakerThreshold.IsUnknown() {
+		if threshold := data.CircuitBreakerThreshold.ValueInt64(); threshold < 0 {
+			resp.Diagnostics.AddError(
+				"Invalid Circuit Breaker Threshold",
+				fmt.Sprintf("circuit_breaker_threshold must not be negative, got: %d", threshold),
+			)
+			return
+		} else {
+			config.CircuitBreakerThreshold = int(threshold)
+		}
+	}
+
+	if !data.RequestTimeout.IsNull() && !data.RequestTimeout.IsUnknown() {
+		if duration, err := time.ParseDuration(data.RequestTimeout.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Request Timeout Duration",
+				"Could not parse request_timeout as a duration: "+err.Error(),
+			)
+			return
+		} else {
+			config.RequestTimeout = duration
+		}
+	}
+
+	if !data.CommandTimeout.IsNull() && !data.CommandTimeout.IsUnknown() {
+		duration, err := time.ParseDuration(data.CommandTimeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Command Timeout Duration",
+				"Could not parse command_timeout as a duration: "+err.Error(),
+			)
+			return
+		}
+		if duration <= 0 {
+			resp.Diagnostics.AddError(
+				"Invalid Command Timeout Duration",
+				fmt.Sprintf("command_timeout must be positive, got: %s", duration),
+			)
+			return
+		}
+		config.CommandTimeout = duration
+	}
+
 	if !data.UserAgent.IsNull() && !data.UserAgent.IsUnknown() {
 		config.UserAgent = data.UserAgent.ValueString()
 	}
@@ -171,18 +509,76 @@ func (p *wormlyProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		config.Debug = data.Debug.ValueBool()
 	}
 
+	if !data.ProxyURL.IsNull() && !data.ProxyURL.IsUnknown() {
+		config.ProxyURL = data.ProxyURL.ValueString()
+	}
+
+	if !data.InsecureSkipVerify.IsNull() && !data.InsecureSkipVerify.IsUnknown() {
+		config.InsecureSkipVerify = data.InsecureSkipVerify.ValueBool()
+	}
+
+	if !data.MaxIdleConns.IsNull() && !data.MaxIdleConns.IsUnknown() {
+		if maxIdleConns := data.MaxIdleConns.ValueInt64(); maxIdleConns < 1 {
+			resp.Diagnostics.AddError(
+				"Invalid Max Idle Connections",
+				fmt.Sprintf("max_idle_conns must be at least 1, got: %d", maxIdleConns),
+			)
+			return
+		} else {
+			config.MaxIdleConns = int(maxIdleConns)
+		}
+	}
+
+	if !data.MaxIdleConnsPerHost.IsNull() && !data.MaxIdleConnsPerHost.IsUnknown() {
+		if maxIdleConnsPerHost := data.MaxIdleConnsPerHost.ValueInt64(); maxIdleConnsPerHost < 1 {
+			resp.Diagnostics.AddError(
+				"Invalid Max Idle Connections Per Host",
+				fmt.Sprintf("max_idle_conns_per_host must be at least 1, got: %d", maxIdleConnsPerHost),
+			)
+			return
+		} else {
+			config.MaxIdleConnsPerHost = int(maxIdleConnsPerHost)
+		}
+	}
+
+	if !data.DefaultTestInterval.IsNull() && !data.DefaultTestInterval.IsUnknown() {
+		if defaultTestInterval := data.DefaultTestInterval.ValueInt64(); defaultTestInterval < 1 {
+			resp.Diagnostics.AddError(
+				"Invalid Default Test Interval",
+				fmt.Sprintf("default_test_interval must be at least 1, got: %d", defaultTestInterval),
+			)
+			return
+		} else {
+			config.DefaultTestInterval = int(defaultTestInterval)
+		}
+	}
+
+	if !data.VerifyCredentials.IsNull() && !data.VerifyCredentials.IsUnknown() {
+		config.VerifyCredentials = data.VerifyCredentials.ValueBool()
+	}
+
+	// Fall back to the environment, unless the key is only known at apply time
+	if config.APIKey == "" && !data.APIKey.IsUnknown() {
+		config.APIKey = os.Getenv(apiKeyEnvVar)
+	}
+
 	// Validate API key
 	if config.APIKey == "" {
 		resp.Diagnostics.AddError(
 			"Missing API Key Configuration",
-			"The api_key must be provided to authenticate with the Wormly API.",
+			"The api_key must be provided, or the "+apiKeyEnvVar+" environment variable set, to authenticate with the Wormly API.",
 		)
 		return
 	}
 
 	// Create HTTP client
-	httpClient := &http.Client{
-		Timeout: 30 * time.Second,
+	httpClient, err := newHTTPClient(config)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Proxy URL",
+			"Could not parse proxy_url: "+err.Error(),
+		)
+		return
 	}
 
 	// Create logger for debug output
@@ -192,9 +588,26 @@ func (p *wormlyProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	}
 
 	// Create Wormly client
-	wormlyClient, err := client.New(httpClient, config.APIKey, config.BaseURL, config.UserAgent,
-		config.RequestsPerSecond, config.MaxRetries, config.InitialBackoff,
-		config.BackoffMultiplier, config.MaxBackoff, logger, config.Debug)
+	wormlyClient, err := client.New(client.Options{
+		HTTPClient:              httpClient,
+		APIKey:                  config.APIKey,
+		BaseURL:                 config.BaseURL,
+		UserAgent:               config.UserAgent,
+		RequestsPerSecond:       config.RequestsPerSecond,
+		RequestsBurst:           config.RequestsBurst,
+		MaxRetries:              config.MaxRetries,
+		InitialBackoff:          config.InitialBackoff,
+		BackoffMultiplier:       config.BackoffMultiplier,
+		MaxBackoff:              config.MaxBackoff,
+		RetryStrategy:           config.RetryStrategy,
+		RetryOnStatus:           config.RetryOnStatus,
+		MaxResponseBytes:        config.MaxResponseBytes,
+		CircuitBreakerThreshold: config.CircuitBreakerThreshold,
+		CommandTimeout:          config.CommandTimeout,
+		ResponseFormat:          config.ResponseFormat,
+		Logger:                  logger,
+		DebugEnabled:            config.Debug,
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Wormly API Client",
@@ -203,9 +616,86 @@ func (p *wormlyProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
+	if config.VerifyCredentials {
+		resp.Diagnostics.Append(verifyCredentials(ctx, wormlyClient)...)
+		if resp.Diagnostics.HasError() {
+			wormlyClient.Close()
+			return
+		}
+	}
+
+	// Warn about a rate the account cannot sustain. The lookup costs an API call,
+	// so it only runs when requests_per_second is set explicitly.
+	if !data.RequestsPerSecond.IsNull() && !data.RequestsPerSecond.IsUnknown() {
+		resp.Diagnostics.Append(checkRequestsPerSecond(ctx, wormlyClient, config.RequestsPerSecond)...)
+	}
+
 	// Make the client available to resources and data sources
 	resp.DataSourceData = wormlyClient
-	resp.ResourceData = wormlyClient
+	resp.ResourceData = &providerData{
+		Client:                     wormlyClient,
+		defaultTestInterval:        config.DefaultTestInterval,
+		eventualConsistencyRetries: config.EventualConsistencyRetries,
+	}
+
+	// Release the connections of a client replaced by reconfiguring the provider
+	p.mu.Lock()
+	previous := p.client
+	p.client = wormlyClient
+	p.mu.Unlock()
+	if previous != nil {
+		previous.Close()
+	}
+}
+
+// defaultUserAgent identifies the provider and Terraform versions to the API for
+// support triage. The Terraform version is omitted when Terraform does not report it.
+func defaultUserAgent(providerVersion, terraformVersion string) string {
+	if providerVersion == "" {
+		providerVersion = "dev"
+	}
+
+	userAgent := "terraform-provider-wormly/" + providerVersion
+	if terraformVersion != "" {
+		userAgent += " terraform/" + terraformVersion
+	}
+	return userAgent
+}
+
+// newHTTPClient builds the HTTP client used for API requests from the provider configuration.
+func newHTTPClient(config Config) (*http.Client, error) {
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+	if defaultTransport, ok := http.DefaultTransport.(*http.Transport); ok {
+		transport = defaultTransport.Clone()
+	}
+
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return nil, err
+		}
+		if proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("expected an absolute URL such as 'http://proxy.example.com:3128', got: %s", config.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	// Zero keeps the transport's own limits, for configurations built without defaults
+	if config.MaxIdleConns > 0 {
+		transport.MaxIdleConns = config.MaxIdleConns
+	}
+	if config.MaxIdleConnsPerHost > 0 {
+		transport.MaxIdleConnsPerHost = config.MaxIdleConnsPerHost
+	}
+
+	if config.InsecureSkipVerify {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} // Explicitly requested by the practitioner
+	}
+
+	return &http.Client{
+		Timeout:   config.RequestTimeout,
+		Transport: transport,
+	}, nil
 }
 
 func (p *wormlyProvider) Resources(_ context.Context) []func() resource.Resource {
@@ -214,12 +704,129 @@ func (p *wormlyProvider) Resources(_ context.Context) []func() resource.Resource
 		NewSensorHTTPResource,
 		NewGlobalAlertsMuteResource,
 		NewScheduledDowntimePeriodResource,
+		NewMaintenanceWindowResource,
+		NewContactResource,
+		NewHostGroupResource,
+		NewStatusPageResource,
+		NewSensorAcknowledgementResource,
 	}
 }
 
 func (p *wormlyProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewAccountDataSource,
 		NewHostDataSource,
 		NewSensorHTTPDataSource,
+		NewSensorHTTPLookupDataSource,
+		NewSensorsHTTPMultiDataSource,
+		NewScheduledDowntimePeriodsDataSource,
+		NewSensorTypesDataSource,
 	}
 }
+
+func (p *wormlyProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewSensorIDFunction,
+		NewParseSensorIDFunction,
+	}
+}
+
+// checkBaseURL warns when baseURL does not look like a Wormly API endpoint, such
+// as when it points at the web UI. Requests to the web UI return HTML pages that
+// fail to decode, so the warning points at the likely cause up front.
+func checkBaseURL(baseURL string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	parsed, err := url.Parse(baseURL)
+	if err != nil || parsed.Host == "" {
+		diags.AddAttributeWarning(
+			path.Root("base_url"),
+			"Base URL Does Not Look Like an API Endpoint",
+			fmt.Sprintf("base_url should be an absolute URL such as 'https://api.wormly.com', got: %s", baseURL),
+		)
+		return diags
+	}
+
+	hostname := parsed.Hostname()
+	if hostname == "localhost" || net.ParseIP(hostname) != nil {
+		// Local endpoints are mock servers or tunnels; there is no naming to check
+		return diags
+	}
+
+	for _, label := range strings.Split(hostname, ".") {
+		if strings.HasPrefix(label, "api") {
+			return diags
+		}
+	}
+	for _, segment := range strings.Split(parsed.Path, "/") {
+		if segment == "api" {
+			return diags
+		}
+	}
+
+	diags.AddAttributeWarning(
+		path.Root("base_url"),
+		"Base URL Does Not Look Like an API Endpoint",
+		fmt.Sprintf("base_url is set to %s, which has no 'api' subdomain or path. "+
+			"If it points at the Wormly web UI, requests will return HTML instead of API responses; "+
+			"the Wormly API is served from 'https://api.wormly.com'.", baseURL),
+	)
+
+	return diags
+}
+
+// accountLookupTimeout bounds each account lookup made while configuring the provider.
+const accountLookupTimeout = 10 * time.Second
+
+// verifyCredentials pings the API, reporting an error when the API key is
+// rejected or the API cannot be reached.
+func verifyCredentials(ctx context.Context, api client.AccountAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ctx, cancel := context.WithTimeout(ctx, accountLookupTimeout)
+	defer cancel()
+
+	err := api.Ping(ctx)
+	switch {
+	case err == nil:
+	case errors.Is(err, client.ErrAuthFailed):
+		diags.AddAttributeError(
+			path.Root("api_key"),
+			"Invalid API Key",
+			"The Wormly API rejected the configured API key: "+err.Error()+apiErrorHint(err),
+		)
+	default:
+		diags.AddError(
+			"Unable to Verify Credentials",
+			"verify_credentials is set, but the Wormly API could not be reached to check the API key: "+err.Error(),
+		)
+	}
+
+	return diags
+}
+
+// checkRequestsPerSecond warns when requestsPerSecond exceeds the API rate limit of the account.
+// The check is best effort: it is skipped when the account or its limit cannot be looked up.
+func checkRequestsPerSecond(ctx context.Context, api client.AccountAPI, requestsPerSecond float64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ctx, cancel := context.WithTimeout(ctx, accountLookupTimeout)
+	defer cancel()
+
+	account, err := api.GetAccountInfo(ctx)
+	if err != nil || account.APIRateLimit <= 0 {
+		return diags
+	}
+
+	if requestsPerSecond > account.APIRateLimit {
+		diags.AddAttributeWarning(
+			path.Root("requests_per_second"),
+			"Requests Per Second Exceeds Account Limit",
+			fmt.Sprintf("requests_per_second is set to %g, but the Wormly account allows %g requests per second. "+
+				"Requests above the limit are rejected with HTTP 429; consider setting requests_per_second to %g or lower.",
+				requestsPerSecond, account.APIRateLimit, account.APIRateLimit),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/radarnex/terraform-provider-wormly/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &contactResource{}
+	_ resource.ResourceWithConfigure   = &contactResource{}
+	_ resource.ResourceWithImportState = &contactResource{}
+)
+
+// contactResourceModel represents the resource data model.
+type contactResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Email   types.String `tfsdk:"email"`
+	SMS     types.String `tfsdk:"sms"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+}
+
+// contactResource defines the resource implementation.
+type contactResource struct {
+	client client.ContactAPI
+}
+
+// NewContactResource creates a new contact resource.
+func NewContactResource() resource.Resource {
+	return &contactResource{}
+}
+
+func (r *contactResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_contact"
+}
+
+func (r *contactResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Wormly contact (alert recipient) resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Contact identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Contact name",
+				Required:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address alerts are sent to",
+				Optional:            true,
+			},
+			"sms": schema.StringAttribute{
+				MarkdownDescription: "Mobile number SMS alerts are sent to, in international format (e.g., '+15555550100')",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the contact receives alerts",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *contactResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.ContactAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.ContactAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *contactResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data contactResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the contact
+	contact, err := r.client.CreateContact(ctx, data.Name.ValueString(), data.Email.ValueString(), data.SMS.ValueString(), data.Enabled.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create contact, got error: %s", err))
+		return
+	}
+
+	// Set the resource state
+	data.ID = types.StringValue(strconv.Itoa(contact.ID))
+	setContactResourceModelFromAPI(&data, contact)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *contactResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data contactResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Parse the ID
+	id, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse contact ID: %s", err))
+		return
+	}
+
+	// Get the contact
+	contact, err := r.client.GetContact(ctx, id)
+	if err != nil {
+		// If the contact is not found, remove it from state
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read contact, got error: %s", err))
+		return
+	}
+
+	// Update the model with the latest data
+	setContactResourceModelFromAPI(&data, contact)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *contactResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state contactResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read current state data
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Parse the contact ID from the current state (not from plan, since ID is computed)
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse contact ID: %s", err))
+		return
+	}
+
+	// Update the contact
+	contact, err := r.client.UpdateContact(ctx, id, data.Name.ValueString(), data.Email.ValueString(), data.SMS.ValueString(), data.Enabled.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update contact, got error: %s", err))
+		return
+	}
+
+	// Update the model with the response data
+	data.ID = state.ID
+	setContactResourceModelFromAPI(&data, contact)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *contactResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data contactResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Parse the ID
+	id, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse contact ID: %s", err))
+		return
+	}
+
+	// Delete the contact
+	err = r.client.DeleteContact(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete contact, got error: %s", err))
+		return
+	}
+}
+
+func (r *contactResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Validate contact ID is numeric
+	if _, err := strconv.Atoi(req.ID); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Contact ID",
+			fmt.Sprintf("Unable to parse contact ID '%s': %s", req.ID, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+func setContactResourceModelFromAPI(data *contactResourceModel, contact *client.Contact) {
+	data.Name = types.StringValue(contact.Name)
+	data.Enabled = types.BoolValue(contact.Enabled)
+
+	// email and sms are optional; keep them null when the API returns no value
+	data.Email = types.StringNull()
+	if contact.Email != "" {
+		data.Email = types.StringValue(contact.Email)
+	}
+	data.SMS = types.StringNull()
+	if contact.SMS != "" {
+		data.SMS = types.StringValue(contact.SMS)
+	}
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/radarnex/terraform-provider-wormly/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestContactResource_Configure(t *testing.T) {
+	r := &contactResource{}
+	mockClient := &client.MockContactAPI{}
+
+	req := frameworkresource.ConfigureRequest{
+		ProviderData: mockClient,
+	}
+	resp := &frameworkresource.ConfigureResponse{}
+
+	r.Configure(t.Context(), req, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.Equal(t, mockClient, r.client)
+}
+
+func TestContactResource_Configure_InvalidType(t *testing.T) {
+	r := &contactResource{}
+
+	req := frameworkresource.ConfigureRequest{
+		ProviderData: "invalid",
+	}
+	resp := &frameworkresource.ConfigureResponse{}
+
+	r.Configure(t.Context(), req, resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+}
+
+func TestContactResource_ModelMapping(t *testing.T) {
+	data := contactResourceModel{
+		ID:    types.StringValue("42"),
+		Email: types.StringValue("stale@example.com"),
+	}
+
+	setContactResourceModelFromAPI(&data, &client.Contact{
+		ID:      42,
+		Name:    "On-call",
+		SMS:     "+15555550100",
+		Enabled: false,
+	})
+
+	assert.Equal(t, types.StringValue("42"), data.ID)
+	assert.Equal(t, types.StringValue("On-call"), data.Name)
+	assert.True(t, data.Email.IsNull())
+	assert.Equal(t, types.StringValue("+15555550100"), data.SMS)
+	assert.Equal(t, types.BoolValue(false), data.Enabled)
+}
+
+func TestContactResource_ReadRemovesNotFound(t *testing.T) {
+	mockClient := &client.MockContactAPI{}
+	mockClient.On("GetContact", mock.Anything, 42).Return(nil, fmt.Errorf("contact with ID 42 %w", client.ErrNotFound))
+
+	r := &contactResource{client: mockClient}
+
+	state := newResourceTestValue(t, r, map[string]tftypes.Value{
+		"id":      tftypes.NewValue(tftypes.String, "42"),
+		"name":    tftypes.NewValue(tftypes.String, "On-call"),
+		"enabled": tftypes.NewValue(tftypes.Bool, true),
+	}).State()
+	resp := &frameworkresource.ReadResponse{State: state}
+
+	r.Read(t.Context(), frameworkresource.ReadRequest{State: state}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.True(t, resp.State.Raw.IsNull())
+	mockClient.AssertExpectations(t)
+}
+
+func TestAccContactResource_basic(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccContactResourceConfig(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wormly_contact.test", "name", rName),
+					resource.TestCheckResourceAttr("wormly_contact.test", "email", rName+"@example.com"),
+					resource.TestCheckResourceAttr("wormly_contact.test", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("wormly_contact.test", "id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccContactResourceConfig(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wormly_contact.test", "enabled", "false"),
+				),
+			},
+			// Import testing
+			{
+				ResourceName:      "wormly_contact.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccContactResourceConfig(name string, enabled bool) string {
+	return fmt.Sprintf(`
+provider "wormly" {
+  api_key = "%s"
+}
+
+resource "wormly_contact" "test" {
+  name    = "%s"
+  email   = "%s@example.com"
+  enabled = %t
+}
+`, os.Getenv("WORMLY_API_KEY"), name, name, enabled)
+}
@@ -27,7 +27,7 @@ type globalAlertsMuteResourceModel struct {
 
 // globalAlertsMuteResource defines the resource implementation.
 type globalAlertsMuteResource struct {
-	client *client.Client
+	client client.GlobalAlertMuteAPI
 }
 
 // NewGlobalAlertsMuteResource creates a new global alerts mute resource.
@@ -66,11 +66,11 @@ func (r *globalAlertsMuteResource) Configure(_ context.Context, req resource.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.GlobalAlertMuteAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.GlobalAlertMuteAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -2,15 +2,16 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -19,22 +20,52 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &hostResource{}
-	_ resource.ResourceWithConfigure   = &hostResource{}
-	_ resource.ResourceWithImportState = &hostResource{}
+	_ resource.Resource                   = &hostResource{}
+	_ resource.ResourceWithConfigure      = &hostResource{}
+	_ resource.ResourceWithImportState    = &hostResource{}
+	_ resource.ResourceWithModifyPlan     = &hostResource{}
+	_ resource.ResourceWithValidateConfig = &hostResource{}
 )
 
+// defaultHostTestInterval is the test interval in seconds of hosts that do not
+// set one, unless the provider sets default_test_interval.
+const defaultHostTestInterval = 60
+
+// Associations that can be deleted together with a host.
+const (
+	hostAssociationSensors         = "sensors"
+	hostAssociationDowntimePeriods = "downtime_periods"
+	hostAssociationAlertRecipients = "alert_recipients"
+)
+
+// hostAssociations lists the supported delete_associated values in cleanup order.
+// Sensors are deleted last, together with the host, by DeleteHostCascade.
+var hostAssociations = []string{
+	hostAssociationSensors,
+	hostAssociationDowntimePeriods,
+	hostAssociationAlertRecipients,
+}
+
 // hostResourceModel represents the resource data model.
 type hostResourceModel struct {
-	ID           types.String `tfsdk:"id"`
-	Name         types.String `tfsdk:"name"`
-	TestInterval types.Int64  `tfsdk:"test_interval"`
-	Enabled      types.Bool   `tfsdk:"enabled"`
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	TestInterval     types.Int64  `tfsdk:"test_interval"`
+	Enabled          types.Bool   `tfsdk:"enabled"`
+	UptimeMonitoring types.Bool   `tfsdk:"uptime_monitoring"`
+	HealthMonitoring types.Bool   `tfsdk:"health_monitoring"`
+	DeleteAssociated types.Set    `tfsdk:"delete_associated"`
 }
 
 // hostResource defines the resource implementation.
 type hostResource struct {
 	client client.HostAPI
+
+	// Used to clean up downtime periods on delete; nil when the provider data does not implement it.
+	downtimes client.ScheduledDowntimePeriodAPI
+
+	// The provider's default_test_interval; 0 when unset.
+	defaultTestInterval int
 }
 
 // NewHostResource creates a new host resource.
@@ -65,31 +96,94 @@ func (r *hostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"test_interval": schema.Int64Attribute{
-				MarkdownDescription: "Test interval in seconds",
+				MarkdownDescription: "Test interval in seconds. New hosts default to the provider's `default_test_interval`, or 60; an existing host that stops setting it keeps its interval. Changing it replaces the host.",
 				Optional:            true,
 				Computed:            true,
-				Default:             int64default.StaticInt64(60),
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
-				},
 			},
 			"enabled": schema.BoolAttribute{
-				MarkdownDescription: "Whether the host is enabled",
+				MarkdownDescription: "Whether uptime monitoring is enabled for the host. Deprecated alias of `uptime_monitoring`.",
+				DeprecationMessage:  "Use uptime_monitoring instead.",
 				Optional:            true,
 				Computed:            true,
-				Default:             booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolFromAliasOrDefault{alias: "uptime_monitoring", defaultValue: true},
+				},
+			},
+			"uptime_monitoring": schema.BoolAttribute{
+				MarkdownDescription: "Whether uptime monitoring is enabled for the host. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolFromAliasOrDefault{alias: "enabled", defaultValue: true},
+				},
+			},
+			"health_monitoring": schema.BoolAttribute{
+				MarkdownDescription: "Whether health monitoring is enabled for the host. Health monitoring requires the Wormly agent on the host, " +
+					"so it is left unchanged when not set.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"delete_associated": schema.SetAttribute{
+				MarkdownDescription: "Associations to delete together with the host: `sensors`, `downtime_periods` and/or `alert_recipients`. " +
+					"`sensors` covers sensors of every type, not only HTTP sensors. By default nothing is cleaned up and the delete fails if the host still has any of them.",
+				ElementType: types.StringType,
+				Optional:    true,
 			},
 		},
 	}
 }
 
+func (r *hostResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var enabled, uptimeMonitoring types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("enabled"), &enabled)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("uptime_monitoring"), &uptimeMonitoring)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !enabled.IsNull() && !uptimeMonitoring.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("enabled"),
+			"Conflicting Uptime Monitoring Attributes",
+			"enabled is a deprecated alias of uptime_monitoring, only one of them can be set.",
+		)
+	}
+
+	var deleteAssociated types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("delete_associated"), &deleteAssociated)...)
+	if resp.Diagnostics.HasError() || deleteAssociated.IsNull() || deleteAssociated.IsUnknown() {
+		return
+	}
+
+	var values []types.String
+	resp.Diagnostics.Append(deleteAssociated.ElementsAs(ctx, &values, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, value := range values {
+		if value.IsUnknown() || value.IsNull() {
+			continue
+		}
+		if !slices.Contains(hostAssociations, value.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("delete_associated"),
+				"Invalid Delete Associated Value",
+				fmt.Sprintf("delete_associated must only contain %s, got: %q", strings.Join(hostAssociations, ", "), value.ValueString()),
+			)
+		}
+	}
+}
+
 func (r *hostResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(client.HostAPI)
+	hostClient, ok := req.ProviderData.(client.HostAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
@@ -98,7 +192,74 @@ func (r *hostResource) Configure(_ context.Context, req resource.ConfigureReques
 		return
 	}
 
-	r.client = client
+	r.client = hostClient
+
+	// The provider client also implements the API needed to delete downtime periods
+	if downtimes, ok := req.ProviderData.(client.ScheduledDowntimePeriodAPI); ok {
+		r.downtimes = downtimes
+	}
+	if settings, ok := req.ProviderData.(hostDefaultSettings); ok {
+		r.defaultTestInterval = settings.DefaultTestInterval()
+	}
+}
+
+// hostDefaultSettings is implemented by provider data that carries the
+// default_test_interval setting.
+type hostDefaultSettings interface {
+	DefaultTestInterval() int
+}
+
+// ModifyPlan defaults test_interval to the provider's default_test_interval.
+// A static schema default cannot see provider configuration, so the plan is
+// filled in here, which also means replacing the host when the interval changes.
+// The default only applies to new hosts: an existing host that does not set
+// test_interval keeps its interval, so changing default_test_interval does not
+// replace every such host.
+func (r *hostResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var testInterval types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("test_interval"), &testInterval)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var previous types.Int64
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("test_interval"), &previous)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if testInterval.IsNull() {
+		testInterval = previous
+		if testInterval.IsNull() {
+			testInterval = types.Int64Value(int64(r.testIntervalDefault()))
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("test_interval"), testInterval)...)
+		return
+	}
+
+	// Nothing to replace on create
+	if previous.IsNull() {
+		return
+	}
+
+	if !testInterval.Equal(previous) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("test_interval"))
+	}
+}
+
+// testIntervalDefault returns the test interval planned for new hosts that do not set one.
+func (r *hostResource) testIntervalDefault() int {
+	if r.defaultTestInterval > 0 {
+		return r.defaultTestInterval
+	}
+	return defaultHostTestInterval
 }
 
 func (r *hostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -113,7 +274,7 @@ func (r *hostResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// Create the host
 	host, err := r.client.CreateHost(ctx, data.Name.ValueString(), int(data.TestInterval.ValueInt64()), data.Enabled.ValueBool())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create host, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create host, got error: %s%s", err, apiErrorHint(err)))
 		return
 	}
 
@@ -122,24 +283,24 @@ func (r *hostResource) Create(ctx context.Context, req resource.CreateRequest, r
 	data.Name = types.StringValue(host.Name)
 	data.TestInterval = types.Int64Value(int64(host.TestInterval))
 
-	// Apply the desired enabled state through the monitoring APIs
-	desiredEnabled := data.Enabled.ValueBool()
-	if desiredEnabled {
-		// Enable monitoring to ensure the host is in the desired state
-		err := r.client.EnableHostUptimeMonitoring(ctx, host.ID)
-		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to enable host uptime monitoring: %s", err))
-			return
-		}
-		data.Enabled = types.BoolValue(true)
-	} else {
-		// Disable monitoring to set to the desired state
-		err := r.client.DisableHostUptimeMonitoring(ctx, host.ID)
-		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disable host uptime monitoring: %s", err))
-			return
-		}
-		data.Enabled = types.BoolValue(false)
+	// Apply the desired monitoring state through the monitoring APIs
+	desiredUptime := data.UptimeMonitoring.ValueBool()
+	if data.UptimeMonitoring.IsUnknown() {
+		desiredUptime = data.Enabled.ValueBool()
+	}
+	if err := r.setUptimeMonitoring(ctx, host.ID, desiredUptime); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set host monitoring, got error: %s", err))
+		return
+	}
+	data.UptimeMonitoring = types.BoolValue(desiredUptime)
+	data.Enabled = types.BoolValue(desiredUptime)
+
+	if data.HealthMonitoring.IsUnknown() {
+		// Not configured: new hosts start without health monitoring
+		data.HealthMonitoring = types.BoolValue(false)
+	} else if err := r.setHealthMonitoring(ctx, host.ID, data.HealthMonitoring.ValueBool()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set host monitoring, got error: %s", err))
+		return
 	}
 
 	// Save data into Terraform state
@@ -177,7 +338,9 @@ func (r *hostResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	// Update the model with the latest data
 	data.Name = types.StringValue(host.Name)
 	data.TestInterval = types.Int64Value(int64(host.TestInterval))
-	data.Enabled = types.BoolValue(host.Enabled)
+	data.UptimeMonitoring = types.BoolValue(host.UptimeMonitored)
+	data.Enabled = types.BoolValue(host.UptimeMonitored)
+	data.HealthMonitoring = types.BoolValue(host.HealthMonitored)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -205,32 +368,49 @@ func (r *hostResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	// Handle enabled state changes
-	if !data.Enabled.Equal(state.Enabled) {
-		if !data.Enabled.ValueBool() {
-			// Host is being disabled - disable uptime monitoring
-			err := r.client.DisableHostUptimeMonitoring(ctx, id)
-			if err != nil {
-				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disable host uptime monitoring: %s", err))
-				return
-			}
-		} else {
-			// Host is being enabled - enable uptime monitoring
-			err := r.client.EnableHostUptimeMonitoring(ctx, id)
-			if err != nil {
-				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to enable host uptime monitoring: %s", err))
-				return
-			}
+	// Reconcile uptime and health monitoring independently
+	desiredUptime := data.UptimeMonitoring
+	if desiredUptime.IsUnknown() {
+		desiredUptime = data.Enabled
+	}
+	if !desiredUptime.IsNull() && !desiredUptime.IsUnknown() && !desiredUptime.Equal(state.UptimeMonitoring) {
+		if err := r.setUptimeMonitoring(ctx, id, desiredUptime.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set host monitoring, got error: %s", err))
+			return
+		}
+	}
+
+	desiredHealth := data.HealthMonitoring
+	if desiredHealth.IsUnknown() {
+		desiredHealth = state.HealthMonitoring
+	}
+	if !desiredHealth.IsNull() && !desiredHealth.IsUnknown() && !desiredHealth.Equal(state.HealthMonitoring) {
+		if err := r.setHealthMonitoring(ctx, id, desiredHealth.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set host monitoring, got error: %s", err))
+			return
 		}
 	}
 
-	// Preserve all values from the current state and only update the enabled field from the plan
+	// Read the monitoring flags back, so an attribute left unknown in the plan
+	// is saved as the API reports it rather than as false
+	host, err := r.client.GetHost(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read host, got error: %s", err))
+		return
+	}
+
+	// Preserve all values from the current state and only update the monitoring fields
 	// Note: name and test_interval have RequiresReplace, so they should not change in an update
 	updatedState := hostResourceModel{
-		ID:           state.ID,
-		Name:         state.Name,
-		TestInterval: state.TestInterval,
-		Enabled:      data.Enabled, // This is the only field that can change in an update
+		ID:               state.ID,
+		Name:             state.Name,
+		TestInterval:     state.TestInterval,
+		Enabled:          types.BoolValue(host.UptimeMonitored),
+		UptimeMonitoring: types.BoolValue(host.UptimeMonitored),
+		HealthMonitoring: types.BoolValue(host.HealthMonitored),
+
+		// Only affects delete, so it is taken from the plan without an API call
+		DeleteAssociated: data.DeleteAssociated,
 	}
 
 	// Save updated data into Terraform state
@@ -253,22 +433,179 @@ func (r *hostResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	// Delete the host
+	var deleteAssociated []string
+	if !data.DeleteAssociated.IsNull() {
+		resp.Diagnostics.Append(data.DeleteAssociated.ElementsAs(ctx, &deleteAssociated, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Clean up the requested associations before deleting the host
+	for _, association := range hostAssociations {
+		if association == hostAssociationSensors || !slices.Contains(deleteAssociated, association) {
+			continue
+		}
+		if err := r.deleteHostAssociation(ctx, id, association); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete %s of host %d, got error: %s", strings.ReplaceAll(association, "_", " "), id, err))
+			return
+		}
+	}
+
+	// Delete the host, first deleting its sensors of every type when requested
+	if slices.Contains(deleteAssociated, hostAssociationSensors) {
+		if err := r.client.DeleteHostCascade(ctx, id); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete host and its sensors, got error: %s", err))
+		}
+		return
+	}
+
 	err = r.client.DeleteHost(ctx, id)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete host, got error: %s", err))
+		detail := fmt.Sprintf("Unable to delete host, got error: %s", err)
+		if blockers := r.hostDeleteBlockers(ctx, id); len(blockers) > 0 {
+			detail += fmt.Sprintf("\n\nThe host still has %s. Add the matching values to delete_associated to delete them together with the host.", strings.Join(blockers, ", "))
+		}
+		resp.Diagnostics.AddError("Client Error", detail)
 		return
 	}
 }
 
+// setUptimeMonitoring enables or disables uptime monitoring for a host.
+func (r *hostResource) setUptimeMonitoring(ctx context.Context, hostID int, enabled bool) error {
+	if enabled {
+		if err := r.client.EnableHostUptimeMonitoring(ctx, hostID); err != nil {
+			return fmt.Errorf("unable to enable host uptime monitoring: %w", err)
+		}
+		return nil
+	}
+	if err := r.client.DisableHostUptimeMonitoring(ctx, hostID); err != nil {
+		return fmt.Errorf("unable to disable host uptime monitoring: %w", err)
+	}
+	return nil
+}
+
+// setHealthMonitoring enables or disables health monitoring for a host.
+func (r *hostResource) setHealthMonitoring(ctx context.Context, hostID int, enabled bool) error {
+	if enabled {
+		if err := r.client.EnableHostHealthMonitoring(ctx, hostID); err != nil {
+			return fmt.Errorf("unable to enable host health monitoring: %w", err)
+		}
+		return nil
+	}
+	if err := r.client.DisableHostHealthMonitoring(ctx, hostID); err != nil {
+		return fmt.Errorf("unable to disable host health monitoring: %w", err)
+	}
+	return nil
+}
+
+// deleteHostAssociation deletes every item of one association type from a host.
+func (r *hostResource) deleteHostAssociation(ctx context.Context, hostID int, association string) error {
+	switch association {
+	case hostAssociationDowntimePeriods:
+		if r.downtimes == nil {
+			return fmt.Errorf("scheduled downtime period API is not configured")
+		}
+		periods, err := r.downtimes.GetScheduledDowntimePeriods(ctx, hostID)
+		if err != nil {
+			return err
+		}
+		for _, period := range periods {
+			if err := r.downtimes.DeleteScheduledDowntimePeriod(ctx, hostID, period.ID); err != nil {
+				return err
+			}
+		}
+	case hostAssociationAlertRecipients:
+		contactIDs, err := r.client.GetHostAlertRecipients(ctx, hostID)
+		if err != nil {
+			return err
+		}
+		if len(contactIDs) > 0 {
+			return r.client.ClearHostAlertRecipients(ctx, hostID)
+		}
+	}
+	return nil
+}
+
+// hostDeleteBlockers describes the associations left on a host after a failed delete.
+// Lookups are best effort: associations that cannot be listed are skipped.
+func (r *hostResource) hostDeleteBlockers(ctx context.Context, hostID int) []string {
+	var blockers []string
+
+	if count, err := r.client.CountHostSensors(ctx, hostID); err == nil && count > 0 {
+		blockers = append(blockers, fmt.Sprintf("%d sensor(s) (%s)", count, hostAssociationSensors))
+	}
+	if r.downtimes != nil {
+		if periods, err := r.downtimes.GetScheduledDowntimePeriods(ctx, hostID); err == nil && len(periods) > 0 {
+			blockers = append(blockers, fmt.Sprintf("%d downtime period(s) (%s)", len(periods), hostAssociationDowntimePeriods))
+		}
+	}
+	if contactIDs, err := r.client.GetHostAlertRecipients(ctx, hostID); err == nil && len(contactIDs) > 0 {
+		blockers = append(blockers, fmt.Sprintf("%d alert recipient(s) (%s)", len(contactIDs), hostAssociationAlertRecipients))
+	}
+
+	return blockers
+}
+
 func (r *hostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Set the ID from the import identifier
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
 }
 
-// isNotFoundError checks if an error represents a 404 Not Found response.
+// boolFromAliasOrDefault plans an unset bool from its alias attribute when the
+// alias is configured, and from the default otherwise, so both stay in sync.
+type boolFromAliasOrDefault struct {
+	alias        string
+	defaultValue bool
+}
+
+func (m boolFromAliasOrDefault) Description(_ context.Context) string {
+	return fmt.Sprintf("Uses the value of %s when set, otherwise defaults to %t.", m.alias, m.defaultValue)
+}
+
+func (m boolFromAliasOrDefault) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m boolFromAliasOrDefault) PlanModifyBool(ctx context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var alias types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(m.alias), &alias)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if alias.IsNull() {
+		resp.PlanValue = types.BoolValue(m.defaultValue)
+		return
+	}
+	resp.PlanValue = alias
+}
+
+// isNotFoundError checks if an error represents a 404 Not Found response
+// or an object the API no longer returns.
 func isNotFoundError(err error) bool {
+	if errors.Is(err, client.ErrNotFound) {
+		return true
+	}
 	// This is a simple implementation - in a real scenario, you would check
 	// the actual HTTP response status code
 	return err != nil && err.Error() == "404 Not Found"
 }
+
+// apiErrorHint suggests how to resolve Wormly errors with a known cause, to be
+// appended to the detail of a diagnostic. It returns "" for other errors.
+func apiErrorHint(err error) string {
+	switch {
+	case errors.Is(err, client.ErrAuthFailed):
+		return "\n\nCheck that the configured api_key is valid and has not been revoked."
+	case errors.Is(err, client.ErrQuotaExceeded):
+		return "\n\nThe Wormly account has reached a limit of its plan. Remove unused hosts or sensors, or upgrade the plan."
+	case errors.Is(err, client.ErrInvalidHost):
+		return "\n\nThe host does not exist or is not accessible with this API key. Check host_id."
+	}
+	return ""
+}
@@ -0,0 +1,290 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/radarnex/terraform-provider-wormly/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &hostGroupResource{}
+	_ resource.ResourceWithConfigure   = &hostGroupResource{}
+	_ resource.ResourceWithImportState = &hostGroupResource{}
+)
+
+// hostGroupResourceModel represents the resource data model.
+type hostGroupResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	HostIDs types.Set    `tfsdk:"host_ids"`
+}
+
+// hostGroupResource defines the resource implementation.
+type hostGroupResource struct {
+	client client.HostGroupAPI
+}
+
+// NewHostGroupResource creates a new host group resource.
+func NewHostGroupResource() resource.Resource {
+	return &hostGroupResource{}
+}
+
+func (r *hostGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_host_group"
+}
+
+func (r *hostGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Wormly host group resource. Groups organize hosts; deleting a group or removing a host from it does not delete the host.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Host group identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Host group name",
+				Required:            true,
+			},
+			"host_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the hosts in the group. Changes add and remove only the hosts that differ. Defaults to an empty group",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.Int64Type, []attr.Value{})),
+			},
+		},
+	}
+}
+
+func (r *hostGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.HostGroupAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.HostGroupAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *hostGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data hostGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, diags := int64SetElements(ctx, data.HostIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the group, then add its members
+	group, err := r.client.CreateHostGroup(ctx, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create host group, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(strconv.Itoa(group.ID))
+	data.Name = types.StringValue(group.Name)
+
+	// Save the memberships that were applied even if some failed, so the group is tracked
+	members, err := r.applyHostGroupMembership(ctx, group.ID, nil, desired)
+	data.HostIDs = int64SetValue(members)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add hosts to host group %d, got error: %s", group.ID, err))
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *hostGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data hostGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Parse the ID
+	id, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse host group ID: %s", err))
+		return
+	}
+
+	// Get the host group
+	group, err := r.client.GetHostGroup(ctx, id)
+	if err != nil {
+		// If the host group is not found, remove it from state
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read host group, got error: %s", err))
+		return
+	}
+
+	data.Name = types.StringValue(group.Name)
+	data.HostIDs = int64SetValue(group.HostIDs)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *hostGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state hostGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read current state data
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Parse the host group ID from the current state (not from plan, since ID is computed)
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse host group ID: %s", err))
+		return
+	}
+
+	current, diags := int64SetElements(ctx, state.HostIDs)
+	resp.Diagnostics.Append(diags...)
+	desired, diags := int64SetElements(ctx, data.HostIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = state.ID
+
+	if !data.Name.Equal(state.Name) {
+		if _, err := r.client.UpdateHostGroup(ctx, id, data.Name.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update host group, got error: %s", err))
+			return
+		}
+	}
+
+	// Save the memberships that were applied even if some failed, so the next plan retries the rest
+	members, err := r.applyHostGroupMembership(ctx, id, current, desired)
+	data.HostIDs = int64SetValue(members)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update the hosts in host group %d, got error: %s", id, err))
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *hostGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data hostGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Parse the ID
+	id, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse host group ID: %s", err))
+		return
+	}
+
+	// Delete the host group; its hosts are left in place
+	if err := r.client.DeleteHostGroup(ctx, id); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete host group, got error: %s", err))
+		return
+	}
+}
+
+func (r *hostGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Validate host group ID is numeric
+	if _, err := strconv.Atoi(req.ID); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Host Group ID",
+			fmt.Sprintf("Unable to parse host group ID '%s': %s", req.ID, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// applyHostGroupMembership adds and removes hosts so the group goes from the
+// current to the desired members. It returns the members the group has once
+// done, which only includes the changes applied before any error.
+func (r *hostGroupResource) applyHostGroupMembership(ctx context.Context, groupID int, current, desired []int) ([]int, error) {
+	add, remove := diffHostGroupMembers(current, desired)
+	members := slices.Clone(current)
+
+	for _, hostID := range remove {
+		if err := r.client.RemoveHostFromGroup(ctx, groupID, hostID); err != nil {
+			return members, fmt.Errorf("removing host %d: %w", hostID, err)
+		}
+		members = slices.DeleteFunc(members, func(id int) bool { return id == hostID })
+	}
+
+	for _, hostID := range add {
+		if err := r.client.AddHostToGroup(ctx, groupID, hostID); err != nil {
+			return members, fmt.Errorf("adding host %d: %w", hostID, err)
+		}
+		members = append(members, hostID)
+	}
+
+	return members, nil
+}
+
+// diffHostGroupMembers returns the hosts to add to and remove from a group to
+// go from the current to the desired members, each in ascending order.
+func diffHostGroupMembers(current, desired []int) (add, remove []int) {
+	for _, hostID := range desired {
+		if !slices.Contains(current, hostID) {
+			add = append(add, hostID)
+		}
+	}
+	for _, hostID := range current {
+		if !slices.Contains(desired, hostID) {
+			remove = append(remove, hostID)
+		}
+	}
+
+	slices.Sort(add)
+	slices.Sort(remove)
+	return add, remove
+}
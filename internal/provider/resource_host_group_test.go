@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/radarnex/terraform-provider-wormly/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// newHostGroupTestValue builds a host group state and plan with the given attributes.
+func newHostGroupTestValue(t *testing.T, r *hostGroupResource, id, name string, hostIDs []int) (tfsdk.State, tfsdk.Plan) {
+	t.Helper()
+
+	idValue := tftypes.NewValue(tftypes.String, nil)
+	if id != "" {
+		idValue = tftypes.NewValue(tftypes.String, id)
+	}
+
+	value := newResourceTestValue(t, r, map[string]tftypes.Value{
+		"id":       idValue,
+		"name":     tftypes.NewValue(tftypes.String, name),
+		"host_ids": numberSetTestValue(hostIDs),
+	})
+	return value.State(), value.Plan()
+}
+
+// hostGroupStateMembers returns the host IDs saved in a host group state.
+func hostGroupStateMembers(t *testing.T, state tfsdk.State) []int {
+	t.Helper()
+
+	var data hostGroupResourceModel
+	assert.False(t, state.Get(t.Context(), &data).HasError())
+	members, diags := int64SetElements(t.Context(), data.HostIDs)
+	assert.False(t, diags.HasError())
+	return members
+}
+
+func TestHostGroupResource_Configure_InvalidType(t *testing.T) {
+	r := &hostGroupResource{}
+	resp := &frameworkresource.ConfigureResponse{}
+
+	r.Configure(t.Context(), frameworkresource.ConfigureRequest{ProviderData: "invalid"}, resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+}
+
+func TestDiffHostGroupMembers(t *testing.T) {
+	tests := []struct {
+		name           string
+		current        []int
+		desired        []int
+		expectedAdd    []int
+		expectedRemove []int
+	}{
+		{name: "unchanged", current: []int{1, 2}, desired: []int{2, 1}},
+		{name: "new group", desired: []int{3, 1}, expectedAdd: []int{1, 3}},
+		{name: "emptied group", current: []int{2, 1}, expectedRemove: []int{1, 2}},
+		{name: "add and remove", current: []int{1, 2, 3}, desired: []int{2, 4, 3, 5}, expectedAdd: []int{4, 5}, expectedRemove: []int{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			add, remove := diffHostGroupMembers(tt.current, tt.desired)
+
+			assert.Equal(t, tt.expectedAdd, add)
+			assert.Equal(t, tt.expectedRemove, remove)
+		})
+	}
+}
+
+func TestHostGroupResource_Create(t *testing.T) {
+	mockClient := &client.MockHostGroupAPI{}
+	mockClient.On("CreateHostGroup", mock.Anything, "web").Return(&client.HostGroup{ID: 7, Name: "web", HostIDs: []int{}}, nil)
+	mockClient.On("AddHostToGroup", mock.Anything, 7, 1).Return(nil)
+	mockClient.On("AddHostToGroup", mock.Anything, 7, 2).Return(nil)
+
+	r := &hostGroupResource{client: mockClient}
+	state, plan := newHostGroupTestValue(t, r, "", "web", []int{2, 1})
+	resp := &frameworkresource.CreateResponse{State: tfsdk.State{Schema: state.Schema}}
+
+	r.Create(t.Context(), frameworkresource.CreateRequest{Plan: plan}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+	assert.ElementsMatch(t, []int{1, 2}, hostGroupStateMembers(t, resp.State))
+	mockClient.AssertExpectations(t)
+}
+
+func TestHostGroupResource_Update_Membership(t *testing.T) {
+	tests := []struct {
+		name            string
+		addErr          error
+		expectError     bool
+		expectedMembers []int
+	}{
+		{
+			name:            "adds and removes the difference",
+			expectedMembers: []int{2, 3},
+		},
+		{
+			name:            "keeps applied changes when adding fails",
+			addErr:          errors.New("API returned error code 1: Invalid hostid"),
+			expectError:     true,
+			expectedMembers: []int{2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &client.MockHostGroupAPI{}
+			// Only the differences are sent; host 2 stays in the group untouched
+			mockClient.On("RemoveHostFromGroup", mock.Anything, 7, 1).Return(nil).Once()
+			mockClient.On("AddHostToGroup", mock.Anything, 7, 3).Return(tt.addErr).Once()
+
+			r := &hostGroupResource{client: mockClient}
+			state, _ := newHostGroupTestValue(t, r, "7", "web", []int{1, 2})
+			_, plan := newHostGroupTestValue(t, r, "7", "web", []int{2, 3})
+			resp := &frameworkresource.UpdateResponse{State: state}
+
+			r.Update(t.Context(), frameworkresource.UpdateRequest{Plan: plan, State: state}, resp)
+
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+			assert.ElementsMatch(t, tt.expectedMembers, hostGroupStateMembers(t, resp.State))
+			mockClient.AssertExpectations(t)
+			mockClient.AssertNotCalled(t, "UpdateHostGroup", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestHostGroupResource_Update_Rename(t *testing.T) {
+	mockClient := &client.MockHostGroupAPI{}
+	mockClient.On("UpdateHostGroup", mock.Anything, 7, "api").Return(&client.HostGroup{ID: 7, Name: "api", HostIDs: []int{1}}, nil)
+
+	r := &hostGroupResource{client: mockClient}
+	state, _ := newHostGroupTestValue(t, r, "7", "web", []int{1})
+	_, plan := newHostGroupTestValue(t, r, "7", "api", []int{1})
+	resp := &frameworkresource.UpdateResponse{State: state}
+
+	r.Update(t.Context(), frameworkresource.UpdateRequest{Plan: plan, State: state}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+	mockClient.AssertExpectations(t)
+	mockClient.AssertNotCalled(t, "AddHostToGroup", mock.Anything, mock.Anything, mock.Anything)
+	mockClient.AssertNotCalled(t, "RemoveHostFromGroup", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHostGroupResource_ReadRemovesNotFound(t *testing.T) {
+	mockClient := &client.MockHostGroupAPI{}
+	mockClient.On("GetHostGroup", mock.Anything, 7).Return(nil, fmt.Errorf("host group with ID 7 %w", client.ErrNotFound))
+
+	r := &hostGroupResource{client: mockClient}
+	state, _ := newHostGroupTestValue(t, r, "7", "web", []int{1})
+	resp := &frameworkresource.ReadResponse{State: state}
+
+	r.Read(t.Context(), frameworkresource.ReadRequest{State: state}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.True(t, resp.State.Raw.IsNull())
+	mockClient.AssertExpectations(t)
+}
+
+func TestAccHostGroupResource_basic(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccHostGroupResourceConfig(rName, "wormly_host.a.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wormly_host_group.test", "name", rName),
+					resource.TestCheckResourceAttr("wormly_host_group.test", "host_ids.#", "1"),
+					resource.TestCheckResourceAttrSet("wormly_host_group.test", "id"),
+				),
+			},
+			// Update membership and Read testing
+			{
+				Config: testAccHostGroupResourceConfig(rName, "wormly_host.b.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair("wormly_host_group.test", "host_ids.*", "wormly_host.b", "id"),
+				),
+			},
+			// Import testing
+			{
+				ResourceName:      "wormly_host_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccHostGroupResourceConfig(name, member string) string {
+	return fmt.Sprintf(`
+provider "wormly" {
+  api_key = "%s"
+}
+
+resource "wormly_host" "a" {
+  name = "%s-a"
+}
+
+resource "wormly_host" "b" {
+  name = "%s-b"
+}
+
+resource "wormly_host_group" "test" {
+  name     = "%s"
+  host_ids = [%s]
+}
+`, os.Getenv("WORMLY_API_KEY"), name, name, name, member)
+}
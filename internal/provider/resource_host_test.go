@@ -3,11 +3,19 @@ package provider
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"slices"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/radarnex/terraform-provider-wormly/internal/client"
@@ -113,6 +121,11 @@ func TestIsNotFoundError(t *testing.T) {
 			err:      errors.New("404 Not Found"),
 			expected: true,
 		},
+		{
+			name:     "wrapped not found sentinel",
+			err:      fmt.Errorf("scheduled downtime period with ID 7 %w", client.ErrNotFound),
+			expected: true,
+		},
 		{
 			name:     "other error",
 			err:      errors.New("500 Internal Server Error"),
@@ -209,6 +222,599 @@ func TestHostResource_ErrorHandling(t *testing.T) {
 	mockClient.AssertExpectations(t)
 }
 
+// newHostTestState builds a host resource state with the given delete_associated values.
+func newHostTestState(t *testing.T, r *hostResource, deleteAssociated []string) tfsdk.State {
+	t.Helper()
+
+	deleteAssociatedValue := tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil)
+	if deleteAssociated != nil {
+		values := make([]tftypes.Value, 0, len(deleteAssociated))
+		for _, association := range deleteAssociated {
+			values = append(values, tftypes.NewValue(tftypes.String, association))
+		}
+		deleteAssociatedValue = tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, values)
+	}
+
+	return newResourceTestValue(t, r, map[string]tftypes.Value{
+		"id":                tftypes.NewValue(tftypes.String, "123"),
+		"name":              tftypes.NewValue(tftypes.String, "test-host"),
+		"test_interval":     tftypes.NewValue(tftypes.Number, 60),
+		"enabled":           tftypes.NewValue(tftypes.Bool, true),
+		"uptime_monitoring": tftypes.NewValue(tftypes.Bool, true),
+		"health_monitoring": tftypes.NewValue(tftypes.Bool, false),
+		"delete_associated": deleteAssociatedValue,
+	}).State()
+}
+
+// newHostTestPlan builds a host plan from the test state with the given attributes replaced.
+func newHostTestPlan(t *testing.T, state tfsdk.State, overrides map[string]tftypes.Value) tfsdk.Plan {
+	t.Helper()
+
+	return tfsdk.Plan{Schema: state.Schema, Raw: withTestAttributes(t, state.Raw, overrides)}
+}
+
+func TestHostResource_Delete_Cascade(t *testing.T) {
+	expectSensors := func(h *client.MockHostAPI) {
+		h.On("DeleteHostCascade", mock.Anything, 123).Return(nil).Once()
+	}
+	expectDowntimes := func(d *client.MockScheduledDowntimePeriodAPI) {
+		d.On("GetScheduledDowntimePeriods", mock.Anything, 123).Return([]client.ScheduledDowntimePeriod{{ID: 7, HostID: 123}}, nil).Once()
+		d.On("DeleteScheduledDowntimePeriod", mock.Anything, 123, 7).Return(nil).Once()
+	}
+	expectRecipients := func(h *client.MockHostAPI) {
+		h.On("GetHostAlertRecipients", mock.Anything, 123).Return([]int{41}, nil).Once()
+		h.On("ClearHostAlertRecipients", mock.Anything, 123).Return(nil).Once()
+	}
+
+	tests := []struct {
+		name             string
+		deleteAssociated []string
+		setupMocks       func(*client.MockHostAPI, *client.MockScheduledDowntimePeriodAPI)
+	}{
+		{
+			name:             "nothing configured",
+			deleteAssociated: nil,
+			setupMocks:       func(*client.MockHostAPI, *client.MockScheduledDowntimePeriodAPI) {},
+		},
+		{
+			name:             "empty set",
+			deleteAssociated: []string{},
+			setupMocks:       func(*client.MockHostAPI, *client.MockScheduledDowntimePeriodAPI) {},
+		},
+		{
+			name:             "sensors",
+			deleteAssociated: []string{"sensors"},
+			setupMocks: func(h *client.MockHostAPI, _ *client.MockScheduledDowntimePeriodAPI) {
+				expectSensors(h)
+			},
+		},
+		{
+			name:             "downtime periods",
+			deleteAssociated: []string{"downtime_periods"},
+			setupMocks: func(_ *client.MockHostAPI, d *client.MockScheduledDowntimePeriodAPI) {
+				expectDowntimes(d)
+			},
+		},
+		{
+			name:             "alert recipients",
+			deleteAssociated: []string{"alert_recipients"},
+			setupMocks: func(h *client.MockHostAPI, _ *client.MockScheduledDowntimePeriodAPI) {
+				expectRecipients(h)
+			},
+		},
+		{
+			name:             "alert recipients already empty",
+			deleteAssociated: []string{"alert_recipients"},
+			setupMocks: func(h *client.MockHostAPI, _ *client.MockScheduledDowntimePeriodAPI) {
+				h.On("GetHostAlertRecipients", mock.Anything, 123).Return([]int{}, nil).Once()
+			},
+		},
+		{
+			name:             "sensors and downtime periods",
+			deleteAssociated: []string{"sensors", "downtime_periods"},
+			setupMocks: func(h *client.MockHostAPI, d *client.MockScheduledDowntimePeriodAPI) {
+				expectSensors(h)
+				expectDowntimes(d)
+			},
+		},
+		{
+			name:             "sensors and alert recipients",
+			deleteAssociated: []string{"sensors", "alert_recipients"},
+			setupMocks: func(h *client.MockHostAPI, _ *client.MockScheduledDowntimePeriodAPI) {
+				expectSensors(h)
+				expectRecipients(h)
+			},
+		},
+		{
+			name:             "downtime periods and alert recipients",
+			deleteAssociated: []string{"downtime_periods", "alert_recipients"},
+			setupMocks: func(h *client.MockHostAPI, d *client.MockScheduledDowntimePeriodAPI) {
+				expectDowntimes(d)
+				expectRecipients(h)
+			},
+		},
+		{
+			name:             "everything",
+			deleteAssociated: []string{"alert_recipients", "downtime_periods", "sensors"},
+			setupMocks: func(h *client.MockHostAPI, d *client.MockScheduledDowntimePeriodAPI) {
+				expectSensors(h)
+				expectDowntimes(d)
+				expectRecipients(h)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hostClient := &client.MockHostAPI{}
+			downtimeClient := &client.MockScheduledDowntimePeriodAPI{}
+			tt.setupMocks(hostClient, downtimeClient)
+			if !slices.Contains(tt.deleteAssociated, "sensors") {
+				hostClient.On("DeleteHost", mock.Anything, 123).Return(nil).Once()
+			}
+
+			r := &hostResource{client: hostClient, downtimes: downtimeClient}
+			state := newHostTestState(t, r, tt.deleteAssociated)
+			resp := &frameworkresource.DeleteResponse{State: state}
+
+			r.Delete(t.Context(), frameworkresource.DeleteRequest{State: state}, resp)
+
+			assert.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+			hostClient.AssertExpectations(t)
+			downtimeClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHostResource_Delete_ListsBlockers(t *testing.T) {
+	hostClient := &client.MockHostAPI{}
+	downtimeClient := &client.MockScheduledDowntimePeriodAPI{}
+
+	hostClient.On("DeleteHost", mock.Anything, 123).Return(errors.New("API returned error code 1: Host has dependencies"))
+	hostClient.On("GetHostAlertRecipients", mock.Anything, 123).Return([]int{41, 42}, nil)
+	// Counts sensors of every type, not only HTTP sensors
+	hostClient.On("CountHostSensors", mock.Anything, 123).Return(3, nil)
+	downtimeClient.On("GetScheduledDowntimePeriods", mock.Anything, 123).Return([]client.ScheduledDowntimePeriod{}, nil)
+
+	r := &hostResource{client: hostClient, downtimes: downtimeClient}
+	state := newHostTestState(t, r, nil)
+	resp := &frameworkresource.DeleteResponse{State: state}
+
+	r.Delete(t.Context(), frameworkresource.DeleteRequest{State: state}, resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+	detail := resp.Diagnostics.Errors()[0].Detail()
+	assert.Contains(t, detail, "Host has dependencies")
+	assert.Contains(t, detail, "3 sensor(s) (sensors)")
+	assert.Contains(t, detail, "2 alert recipient(s) (alert_recipients)")
+	assert.NotContains(t, detail, "downtime_periods")
+	hostClient.AssertExpectations(t)
+	downtimeClient.AssertExpectations(t)
+}
+
+func TestHostResource_Delete_CascadeFailureKeepsHost(t *testing.T) {
+	hostClient := &client.MockHostAPI{}
+	hostClient.On("DeleteHostCascade", mock.Anything, 123).Return(errors.New("failed to delete sensor 1 of host 123: API error"))
+
+	r := &hostResource{client: hostClient}
+	state := newHostTestState(t, r, []string{"sensors"})
+	resp := &frameworkresource.DeleteResponse{State: state}
+
+	r.Delete(t.Context(), frameworkresource.DeleteRequest{State: state}, resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+	hostClient.AssertNotCalled(t, "DeleteHost", mock.Anything, mock.Anything)
+	hostClient.AssertExpectations(t)
+}
+
+func TestHostResource_ValidateConfig_DeleteAssociated(t *testing.T) {
+	tests := []struct {
+		name             string
+		deleteAssociated []string
+		expectError      bool
+	}{
+		{name: "unset", deleteAssociated: nil},
+		{name: "all supported values", deleteAssociated: []string{"sensors", "downtime_periods", "alert_recipients"}},
+		{name: "unsupported value", deleteAssociated: []string{"sensors", "contacts"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &hostResource{}
+			state := newHostTestState(t, r, tt.deleteAssociated)
+			config := newHostTestPlan(t, state, map[string]tftypes.Value{
+				"enabled": tftypes.NewValue(tftypes.Bool, nil),
+			})
+			req := frameworkresource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+			}
+			resp := &frameworkresource.ValidateConfigResponse{}
+
+			r.ValidateConfig(t.Context(), req, resp)
+
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestHostResource_ValidateConfig_UptimeMonitoringAlias(t *testing.T) {
+	tests := []struct {
+		name             string
+		enabled          any
+		uptimeMonitoring any
+		expectError      bool
+	}{
+		{name: "neither set"},
+		{name: "only enabled", enabled: false},
+		{name: "only uptime_monitoring", uptimeMonitoring: false},
+		{name: "both set", enabled: true, uptimeMonitoring: true, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &hostResource{}
+			config := newHostTestPlan(t, newHostTestState(t, r, nil), map[string]tftypes.Value{
+				"enabled":           tftypes.NewValue(tftypes.Bool, tt.enabled),
+				"uptime_monitoring": tftypes.NewValue(tftypes.Bool, tt.uptimeMonitoring),
+			})
+			req := frameworkresource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+			}
+			resp := &frameworkresource.ValidateConfigResponse{}
+
+			r.ValidateConfig(t.Context(), req, resp)
+
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestBoolFromAliasOrDefault(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   types.Bool
+		alias    any
+		expected types.Bool
+	}{
+		{name: "configured value kept", config: types.BoolValue(false), alias: true, expected: types.BoolValue(false)},
+		{name: "alias used when unset", config: types.BoolNull(), alias: false, expected: types.BoolValue(false)},
+		{name: "default used when both unset", config: types.BoolNull(), expected: types.BoolValue(true)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &hostResource{}
+			config := newHostTestPlan(t, newHostTestState(t, r, nil), map[string]tftypes.Value{
+				"enabled": tftypes.NewValue(tftypes.Bool, tt.alias),
+			})
+			req := planmodifier.BoolRequest{
+				Config:      tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+				ConfigValue: tt.config,
+				PlanValue:   tt.config,
+			}
+			if tt.config.IsNull() {
+				req.PlanValue = types.BoolUnknown()
+			}
+			resp := &planmodifier.BoolResponse{PlanValue: req.PlanValue}
+
+			boolFromAliasOrDefault{alias: "enabled", defaultValue: true}.PlanModifyBool(t.Context(), req, resp)
+
+			assert.False(t, resp.Diagnostics.HasError())
+			assert.Equal(t, tt.expected, resp.PlanValue)
+		})
+	}
+}
+
+func TestHostResource_ModifyPlan_TestInterval(t *testing.T) {
+	tests := []struct {
+		name                string
+		defaultTestInterval int
+		config              any
+		state               bool
+		expected            int64
+		expectReplace       bool
+	}{
+		{name: "host default when unset", expected: 60},
+		{name: "provider default when unset", defaultTestInterval: 300, expected: 300},
+		{name: "configured value kept", defaultTestInterval: 300, config: 120, expected: 120},
+		{name: "unchanged interval keeps host", state: true, expected: 60},
+		{name: "changed provider default keeps existing host", defaultTestInterval: 300, state: true, expected: 60},
+		{name: "configured value matching state keeps host", defaultTestInterval: 300, config: 60, state: true, expected: 60},
+		{name: "changed configured value replaces host", config: 120, state: true, expected: 120, expectReplace: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &hostResource{}
+			configureResp := &frameworkresource.ConfigureResponse{}
+			r.Configure(t.Context(), frameworkresource.ConfigureRequest{
+				ProviderData: &providerData{Client: &client.Client{}, defaultTestInterval: tt.defaultTestInterval},
+			}, configureResp)
+			assert.False(t, configureResp.Diagnostics.HasError())
+
+			state := newHostTestState(t, r, nil)
+			config := newHostTestPlan(t, state, map[string]tftypes.Value{
+				"test_interval": tftypes.NewValue(tftypes.Number, tt.config),
+			})
+			// The framework plans configured values and leaves unset computed ones unknown
+			planned := tt.config
+			if planned == nil {
+				planned = tftypes.UnknownValue
+			}
+			plan := newHostTestPlan(t, state, map[string]tftypes.Value{
+				"test_interval": tftypes.NewValue(tftypes.Number, planned),
+			})
+
+			req := frameworkresource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+				Plan:   plan,
+				State:  tfsdk.State{Schema: state.Schema, Raw: tftypes.NewValue(state.Raw.Type(), nil)},
+			}
+			if tt.state {
+				req.State = state
+			}
+			resp := &frameworkresource.ModifyPlanResponse{Plan: plan}
+
+			r.ModifyPlan(t.Context(), req, resp)
+
+			assert.False(t, resp.Diagnostics.HasError())
+			var testInterval types.Int64
+			assert.False(t, resp.Plan.GetAttribute(t.Context(), path.Root("test_interval"), &testInterval).HasError())
+			assert.Equal(t, types.Int64Value(tt.expected), testInterval)
+			assert.Equal(t, tt.expectReplace, len(resp.RequiresReplace) > 0)
+		})
+	}
+}
+
+func TestHostResource_Create_MonitoringCombinations(t *testing.T) {
+	tests := []struct {
+		name          string
+		uptime        bool
+		health        bool
+		expectedCalls []string
+	}{
+		{name: "uptime and health", uptime: true, health: true, expectedCalls: []string{"EnableHostUptimeMonitoring", "EnableHostHealthMonitoring"}},
+		{name: "uptime only", uptime: true, health: false, expectedCalls: []string{"EnableHostUptimeMonitoring", "DisableHostHealthMonitoring"}},
+		{name: "health only", uptime: false, health: true, expectedCalls: []string{"DisableHostUptimeMonitoring", "EnableHostHealthMonitoring"}},
+		{name: "neither", uptime: false, health: false, expectedCalls: []string{"DisableHostUptimeMonitoring", "DisableHostHealthMonitoring"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &client.MockHostAPI{}
+			mockClient.On("CreateHost", mock.Anything, "test-host", 60, tt.uptime).
+				Return(&client.Host{ID: 123, Name: "test-host", TestInterval: 60}, nil)
+			for _, call := range tt.expectedCalls {
+				mockClient.On(call, mock.Anything, 123).Return(nil)
+			}
+
+			r := &hostResource{client: mockClient}
+			state := newHostTestState(t, r, nil)
+			plan := newHostTestPlan(t, state, map[string]tftypes.Value{
+				"id":                tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"enabled":           tftypes.NewValue(tftypes.Bool, tt.uptime),
+				"uptime_monitoring": tftypes.NewValue(tftypes.Bool, tt.uptime),
+				"health_monitoring": tftypes.NewValue(tftypes.Bool, tt.health),
+			})
+			resp := &frameworkresource.CreateResponse{State: tfsdk.State{Schema: state.Schema, Raw: tftypes.NewValue(state.Raw.Type(), nil)}}
+
+			r.Create(t.Context(), frameworkresource.CreateRequest{Plan: plan}, resp)
+
+			assert.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+			mockClient.AssertExpectations(t)
+
+			var data hostResourceModel
+			assert.False(t, resp.State.Get(t.Context(), &data).HasError())
+			assert.Equal(t, tt.uptime, data.UptimeMonitoring.ValueBool())
+			assert.Equal(t, tt.uptime, data.Enabled.ValueBool())
+			assert.Equal(t, tt.health, data.HealthMonitoring.ValueBool())
+		})
+	}
+}
+
+func TestHostResource_Create_HealthMonitoringUnset(t *testing.T) {
+	mockClient := &client.MockHostAPI{}
+	mockClient.On("CreateHost", mock.Anything, "test-host", 60, true).
+		Return(&client.Host{ID: 123, Name: "test-host", TestInterval: 60}, nil)
+	mockClient.On("EnableHostUptimeMonitoring", mock.Anything, 123).Return(nil)
+
+	r := &hostResource{client: mockClient}
+	state := newHostTestState(t, r, nil)
+	plan := newHostTestPlan(t, state, map[string]tftypes.Value{
+		"id":                tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"health_monitoring": tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+	})
+	resp := &frameworkresource.CreateResponse{State: tfsdk.State{Schema: state.Schema, Raw: tftypes.NewValue(state.Raw.Type(), nil)}}
+
+	r.Create(t.Context(), frameworkresource.CreateRequest{Plan: plan}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+	mockClient.AssertExpectations(t)
+	mockClient.AssertNotCalled(t, "EnableHostHealthMonitoring", mock.Anything, mock.Anything)
+	mockClient.AssertNotCalled(t, "DisableHostHealthMonitoring", mock.Anything, mock.Anything)
+
+	var data hostResourceModel
+	assert.False(t, resp.State.Get(t.Context(), &data).HasError())
+	assert.False(t, data.HealthMonitoring.ValueBool())
+}
+
+func TestHostResource_Update_MonitoringIndependently(t *testing.T) {
+	tests := []struct {
+		name          string
+		uptime        any
+		health        any
+		apiUptime     bool
+		apiHealth     bool
+		expectedCalls []string
+	}{
+		{name: "uptime and health", uptime: true, health: true, apiUptime: true, apiHealth: true, expectedCalls: []string{"EnableHostHealthMonitoring"}},
+		{name: "uptime only", uptime: true, health: false, apiUptime: true},
+		{name: "health only", uptime: false, health: true, apiHealth: true, expectedCalls: []string{"DisableHostUptimeMonitoring", "EnableHostHealthMonitoring"}},
+		{name: "neither", uptime: false, health: false, expectedCalls: []string{"DisableHostUptimeMonitoring"}},
+		{name: "unknown uptime is read from the API", uptime: tftypes.UnknownValue, health: false, apiUptime: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &client.MockHostAPI{}
+			for _, call := range tt.expectedCalls {
+				mockClient.On(call, mock.Anything, 123).Return(nil)
+			}
+			mockClient.On("GetHost", mock.Anything, 123).
+				Return(&client.Host{ID: 123, Name: "test-host", TestInterval: 60, UptimeMonitored: tt.apiUptime, HealthMonitored: tt.apiHealth}, nil)
+
+			r := &hostResource{client: mockClient}
+			// The prior state has uptime monitoring enabled and health monitoring disabled
+			state := newHostTestState(t, r, nil)
+			plan := newHostTestPlan(t, state, map[string]tftypes.Value{
+				"enabled":           tftypes.NewValue(tftypes.Bool, tt.uptime),
+				"uptime_monitoring": tftypes.NewValue(tftypes.Bool, tt.uptime),
+				"health_monitoring": tftypes.NewValue(tftypes.Bool, tt.health),
+			})
+			resp := &frameworkresource.UpdateResponse{State: state}
+
+			r.Update(t.Context(), frameworkresource.UpdateRequest{Plan: plan, State: state}, resp)
+
+			assert.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+			mockClient.AssertExpectations(t)
+			assert.Len(t, mockClient.Calls, len(tt.expectedCalls)+1, "only the expected calls and GetHost should be made")
+
+			var data hostResourceModel
+			assert.False(t, resp.State.Get(t.Context(), &data).HasError())
+			assert.Equal(t, tt.apiUptime, data.UptimeMonitoring.ValueBool())
+			assert.Equal(t, tt.apiUptime, data.Enabled.ValueBool())
+			assert.Equal(t, tt.apiHealth, data.HealthMonitoring.ValueBool())
+		})
+	}
+}
+
+func TestHostResource_Read_UnmonitoredHostKeptInState(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("cmd") {
+		case "getHostStatus":
+			fmt.Fprint(w, `{"errorcode": 0, "status": []}`)
+		case "getHosts":
+			fmt.Fprint(w, `{"errorcode": 0, "hosts": [{"hostid": "123", "name": "test-host"}]}`)
+		case "getHostSettings":
+			fmt.Fprint(w, `{"errorcode": 0, "settings": {"testinterval": 60}}`)
+		default:
+			t.Errorf("Unexpected command %q", r.FormValue("cmd"))
+		}
+	}))
+	defer server.Close()
+
+	apiClient, err := client.New(client.Options{
+		HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 10.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+	})
+	assert.NoError(t, err)
+
+	r := &hostResource{client: apiClient}
+	state := newHostTestState(t, r, nil)
+	resp := &frameworkresource.ReadResponse{State: state}
+
+	r.Read(t.Context(), frameworkresource.ReadRequest{State: state}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+	assert.False(t, resp.State.Raw.IsNull(), "host should not be removed from state")
+
+	var data hostResourceModel
+	assert.False(t, resp.State.Get(t.Context(), &data).HasError())
+	assert.Equal(t, "123", data.ID.ValueString())
+	assert.Equal(t, "test-host", data.Name.ValueString())
+	assert.False(t, data.Enabled.ValueBool())
+	assert.False(t, data.UptimeMonitoring.ValueBool())
+	assert.False(t, data.HealthMonitoring.ValueBool())
+}
+
+func TestHostResource_Read_RemovesDeletedHost(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("cmd") {
+		case "getHostStatus":
+			fmt.Fprint(w, `{"errorcode": 0, "status": []}`)
+		case "getHosts":
+			fmt.Fprint(w, `{"errorcode": 0, "hosts": [{"hostid": "122", "name": "other-host"}]}`)
+		default:
+			t.Errorf("Unexpected command %q", r.FormValue("cmd"))
+		}
+	}))
+	defer server.Close()
+
+	apiClient, err := client.New(client.Options{
+		HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 10.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+	})
+	assert.NoError(t, err)
+
+	r := &hostResource{client: apiClient}
+	state := newHostTestState(t, r, nil)
+	resp := &frameworkresource.ReadResponse{State: state}
+
+	r.Read(t.Context(), frameworkresource.ReadRequest{State: state}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull(), "host deleted outside Terraform should be removed from state")
+}
+
+func TestHostResource_Read_HealthOnlyHostIsNotEnabled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("cmd") {
+		case "getHostStatus":
+			fmt.Fprint(w, `{"errorcode": 0, "status": [{"hostid": 123, "name": "test-host", "uptimemonitored": false, "healthmonitored": true}]}`)
+		case "getHostSettings":
+			fmt.Fprint(w, `{"errorcode": 0, "settings": {"testinterval": 60}}`)
+		default:
+			t.Errorf("Unexpected command %q", r.FormValue("cmd"))
+		}
+	}))
+	defer server.Close()
+
+	apiClient, err := client.New(client.Options{
+		HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 10.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+	})
+	assert.NoError(t, err)
+
+	r := &hostResource{client: apiClient}
+	state := newHostTestState(t, r, nil)
+	resp := &frameworkresource.ReadResponse{State: state}
+
+	r.Read(t.Context(), frameworkresource.ReadRequest{State: state}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+
+	// enabled only tracks uptime monitoring, so a configuration with enabled = false does not drift
+	var data hostResourceModel
+	assert.False(t, resp.State.Get(t.Context(), &data).HasError())
+	assert.False(t, data.Enabled.ValueBool())
+	assert.False(t, data.UptimeMonitoring.ValueBool())
+	assert.True(t, data.HealthMonitoring.ValueBool())
+}
+
 func TestAccHostResource_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 	rNameUpdated := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
@@ -222,7 +828,7 @@ func TestAccHostResource_basic(t *testing.T) {
 				Config: testAccHostResourceConfig(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("wormly_host.test", "name", rName),
-					resource.TestCheckResourceAttr("wormly_host.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("wormly_host.test", "uptime_monitoring", "true"),
 					resource.TestCheckResourceAttr("wormly_host.test", "test_interval", "60"),
 				),
 			},
@@ -231,7 +837,7 @@ func TestAccHostResource_basic(t *testing.T) {
 				Config: testAccHostResourceConfig(rNameUpdated),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("wormly_host.test", "name", rNameUpdated),
-					resource.TestCheckResourceAttr("wormly_host.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("wormly_host.test", "uptime_monitoring", "true"),
 					resource.TestCheckResourceAttr("wormly_host.test", "test_interval", "60"),
 				),
 			},
@@ -252,9 +858,30 @@ provider "wormly" {
 }
 
 resource "wormly_host" "test" {
-  name          = "%s"
-  enabled       = true
-  test_interval = 60
+  name              = "%s"
+  uptime_monitoring = true
+  test_interval     = 60
 }
 `, os.Getenv("WORMLY_API_KEY"), name)
 }
+
+func TestHostResource_Create_QuotaExceeded(t *testing.T) {
+	mockClient := &client.MockHostAPI{}
+	mockClient.On("CreateHost", mock.Anything, "test-host", 60, true).
+		Return(nil, fmt.Errorf("API returned error code 4: Host limit reached: %w", client.ErrQuotaExceeded))
+
+	r := &hostResource{client: mockClient}
+	state := newHostTestState(t, r, nil)
+	plan := newHostTestPlan(t, state, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+	resp := &frameworkresource.CreateResponse{State: tfsdk.State{Schema: state.Schema, Raw: tftypes.NewValue(state.Raw.Type(), nil)}}
+
+	r.Create(t.Context(), frameworkresource.CreateRequest{Plan: plan}, resp)
+
+	if assert.Equal(t, 1, resp.Diagnostics.ErrorsCount(), "diagnostics: %v", resp.Diagnostics) {
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "Host limit reached")
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "upgrade the plan")
+	}
+	mockClient.AssertExpectations(t)
+}
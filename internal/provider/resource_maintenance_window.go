@@ -0,0 +1,332 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/radarnex/terraform-provider-wormly/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &maintenanceWindowResource{}
+	_ resource.ResourceWithConfigure      = &maintenanceWindowResource{}
+	_ resource.ResourceWithImportState    = &maintenanceWindowResource{}
+	_ resource.ResourceWithValidateConfig = &maintenanceWindowResource{}
+)
+
+// maintenanceWindowResourceModel represents the resource data model.
+type maintenanceWindowResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	HostID   types.Int64  `tfsdk:"host_id"`
+	StartsAt types.String `tfsdk:"starts_at"`
+	EndsAt   types.String `tfsdk:"ends_at"`
+}
+
+// times parses the bounds of the window. Both must be known RFC3339 timestamps.
+func (m *maintenanceWindowResourceModel) times() (startsAt, endsAt time.Time, err error) {
+	startsAt, err = time.Parse(time.RFC3339, m.StartsAt.ValueString())
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("starts_at must be an RFC3339 timestamp, got: %q", m.StartsAt.ValueString())
+	}
+	endsAt, err = time.Parse(time.RFC3339, m.EndsAt.ValueString())
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("ends_at must be an RFC3339 timestamp, got: %q", m.EndsAt.ValueString())
+	}
+	return startsAt, endsAt, nil
+}
+
+// setTimes stores the bounds of the window, keeping the configured timestamps
+// when they denote the same instants so a different UTC offset is not drift.
+func (m *maintenanceWindowResourceModel) setTimes(startsAt, endsAt time.Time) {
+	if current, err := time.Parse(time.RFC3339, m.StartsAt.ValueString()); err != nil || !current.Equal(startsAt) {
+		m.StartsAt = types.StringValue(startsAt.UTC().Format(time.RFC3339))
+	}
+	if current, err := time.Parse(time.RFC3339, m.EndsAt.ValueString()); err != nil || !current.Equal(endsAt) {
+		m.EndsAt = types.StringValue(endsAt.UTC().Format(time.RFC3339))
+	}
+}
+
+// maintenanceWindowResource defines the resource implementation.
+type maintenanceWindowResource struct {
+	client client.MaintenanceWindowAPI
+
+	eventualConsistencyRetries int
+}
+
+// NewMaintenanceWindowResource creates a new maintenance window resource.
+func NewMaintenanceWindowResource() resource.Resource {
+	return &maintenanceWindowResource{}
+}
+
+func (r *maintenanceWindowResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_maintenance_window"
+}
+
+func (r *maintenanceWindowResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Wormly one-off maintenance window resource. The window is stored as a ONCEONLY scheduled downtime period in UTC; " +
+			"use `wormly_scheduled_downtime_period` for recurring downtime.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Maintenance window identifier, the ID of the backing scheduled downtime period",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"host_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the host to schedule the maintenance window for",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"starts_at": schema.StringAttribute{
+				MarkdownDescription: "When the window starts, as an RFC3339 timestamp in whole minutes (e.g., '2025-12-25T22:00:00Z')",
+				Required:            true,
+			},
+			"ends_at": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("When the window ends, as an RFC3339 timestamp in whole minutes. "+
+					"Must be after `starts_at` and less than %d hours later", int(client.MaxMaintenanceWindowDuration.Hours())),
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *maintenanceWindowResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data maintenanceWindowResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bounds := map[string]types.String{"starts_at": data.StartsAt, "ends_at": data.EndsAt}
+	known := true
+	for _, name := range []string{"starts_at", "ends_at"} {
+		value := bounds[name]
+		if value.IsNull() || value.IsUnknown() {
+			known = false
+			continue
+		}
+		if _, err := time.Parse(time.RFC3339, value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Maintenance Window Timestamp",
+				fmt.Sprintf("%s must be an RFC3339 timestamp such as '2025-12-25T22:00:00Z', got: %q", name, value.ValueString()),
+			)
+		}
+	}
+	if !known || resp.Diagnostics.HasError() {
+		return
+	}
+
+	startsAt, endsAt, err := data.times()
+	if err == nil {
+		_, _, _, err = client.MaintenanceWindowSchedule(startsAt, endsAt)
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ends_at"),
+			"Invalid Maintenance Window",
+			err.Error(),
+		)
+	}
+}
+
+func (r *maintenanceWindowResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	windowClient, ok := req.ProviderData.(client.MaintenanceWindowAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.MaintenanceWindowAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = windowClient
+	r.eventualConsistencyRetries = eventualConsistencyRetriesFrom(req.ProviderData)
+}
+
+func (r *maintenanceWindowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data maintenanceWindowResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	startsAt, endsAt, err := data.times()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Maintenance Window", err.Error())
+		return
+	}
+
+	window, err := r.client.CreateMaintenanceWindow(ctx, int(data.HostID.ValueInt64()), startsAt, endsAt)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create maintenance window, got error: %s", err))
+		return
+	}
+
+	// Set the resource state
+	data.ID = types.StringValue(strconv.Itoa(window.ID))
+	data.HostID = types.Int64Value(int64(window.HostID))
+	data.setTimes(window.StartsAt, window.EndsAt)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *maintenanceWindowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data maintenanceWindowResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Parse the ID
+	id, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse maintenance window ID: %s", err))
+		return
+	}
+
+	// Get the maintenance window, allowing for a window that was only just created
+	window, err := retryWhileNotFound(ctx, r.eventualConsistencyRetries, func() (*client.MaintenanceWindow, error) {
+		return r.client.GetMaintenanceWindow(ctx, int(data.HostID.ValueInt64()), id)
+	})
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read maintenance window, got error: %s", err))
+		return
+	}
+
+	// Update the model with the latest data
+	data.HostID = types.Int64Value(int64(window.HostID))
+	data.setTimes(window.StartsAt, window.EndsAt)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *maintenanceWindowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state maintenanceWindowResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read current state data
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Parse the window ID from the current state (not from plan, since ID is computed)
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse maintenance window ID: %s", err))
+		return
+	}
+
+	startsAt, endsAt, err := data.times()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Maintenance Window", err.Error())
+		return
+	}
+
+	window, err := r.client.UpdateMaintenanceWindow(ctx, int(data.HostID.ValueInt64()), id, startsAt, endsAt)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update maintenance window, got error: %s", err))
+		return
+	}
+
+	// Update the model with the response data
+	data.ID = types.StringValue(strconv.Itoa(window.ID))
+	data.HostID = types.Int64Value(int64(window.HostID))
+	data.setTimes(window.StartsAt, window.EndsAt)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *maintenanceWindowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data maintenanceWindowResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Parse the ID
+	id, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse maintenance window ID: %s", err))
+		return
+	}
+
+	// Delete the maintenance window
+	err = r.client.DeleteMaintenanceWindow(ctx, int(data.HostID.ValueInt64()), id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete maintenance window, got error: %s", err))
+		return
+	}
+}
+
+func (r *maintenanceWindowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Parse the import ID in the format "host_id/period_id"
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Import ID must be in the format 'host_id/period_id'",
+		)
+		return
+	}
+
+	hostID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Host ID",
+			fmt.Sprintf("Unable to parse host ID '%s': %s", parts[0], err),
+		)
+		return
+	}
+
+	if _, err := strconv.Atoi(parts[1]); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Period ID",
+			fmt.Sprintf("Unable to parse period ID '%s': %s", parts[1], err),
+		)
+		return
+	}
+
+	// Set the host_id and id in the state
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host_id"), hostID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/radarnex/terraform-provider-wormly/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// newMaintenanceWindowTestConfig builds a maintenance window configuration with the given attributes.
+func newMaintenanceWindowTestConfig(t *testing.T, r *maintenanceWindowResource, id, startsAt, endsAt interface{}) tfsdk.Config {
+	t.Helper()
+
+	return newResourceTestValue(t, r, map[string]tftypes.Value{
+		"id":        tftypes.NewValue(tftypes.String, id),
+		"host_id":   tftypes.NewValue(tftypes.Number, 12345),
+		"starts_at": tftypes.NewValue(tftypes.String, startsAt),
+		"ends_at":   tftypes.NewValue(tftypes.String, endsAt),
+	}).Config()
+}
+
+func TestMaintenanceWindowResource_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		startsAt    interface{}
+		endsAt      interface{}
+		expectError string
+	}{
+		{name: "valid", startsAt: "2025-12-25T22:00:00Z", endsAt: "2025-12-26T02:00:00Z"},
+		{name: "valid with offsets", startsAt: "2025-12-25T22:00:00+01:00", endsAt: "2025-12-25T22:30:00Z"},
+		{name: "unknown start", startsAt: tftypes.UnknownValue, endsAt: "2025-12-26T02:00:00Z"},
+		{name: "ends before it starts", startsAt: "2025-12-25T22:00:00Z", endsAt: "2025-12-25T21:00:00Z", expectError: "Invalid Maintenance Window"},
+		{name: "ends when it starts", startsAt: "2025-12-25T22:00:00Z", endsAt: "2025-12-25T22:00:00Z", expectError: "Invalid Maintenance Window"},
+		{name: "a day long", startsAt: "2025-12-25T22:00:00Z", endsAt: "2025-12-26T22:00:00Z", expectError: "Invalid Maintenance Window"},
+		{name: "not rfc3339", startsAt: "2025-12-25 22:00", endsAt: "2025-12-26T02:00:00Z", expectError: "Invalid Maintenance Window Timestamp"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &maintenanceWindowResource{}
+			config := newMaintenanceWindowTestConfig(t, r, nil, tt.startsAt, tt.endsAt)
+			resp := &frameworkresource.ValidateConfigResponse{}
+
+			r.ValidateConfig(t.Context(), frameworkresource.ValidateConfigRequest{Config: config}, resp)
+
+			if tt.expectError == "" {
+				assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+				return
+			}
+			if assert.Equal(t, 1, resp.Diagnostics.ErrorsCount(), "diagnostics: %v", resp.Diagnostics) {
+				assert.Equal(t, tt.expectError, resp.Diagnostics.Errors()[0].Summary())
+			}
+		})
+	}
+}
+
+func TestMaintenanceWindowResource_Create(t *testing.T) {
+	startsAt := time.Date(2025, 12, 25, 22, 0, 0, 0, time.FixedZone("", 3600))
+	endsAt := time.Date(2025, 12, 25, 23, 0, 0, 0, time.UTC)
+
+	mockClient := &client.MockMaintenanceWindowAPI{}
+	mockClient.On("CreateMaintenanceWindow", mock.Anything, 12345,
+		mock.MatchedBy(startsAt.Equal), mock.MatchedBy(endsAt.Equal)).
+		Return(&client.MaintenanceWindow{ID: 123, HostID: 12345, StartsAt: startsAt, EndsAt: endsAt}, nil)
+
+	r := &maintenanceWindowResource{client: mockClient}
+	config := newMaintenanceWindowTestConfig(t, r, tftypes.UnknownValue, "2025-12-25T22:00:00+01:00", "2025-12-25T23:00:00Z")
+	resp := &frameworkresource.CreateResponse{State: tfsdk.State{Schema: config.Schema}}
+
+	r.Create(t.Context(), frameworkresource.CreateRequest{Plan: tfsdk.Plan{Schema: config.Schema, Raw: config.Raw}}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+
+	var data maintenanceWindowResourceModel
+	assert.False(t, resp.State.Get(t.Context(), &data).HasError())
+	assert.Equal(t, "123", data.ID.ValueString())
+	// The configured offset is kept, as it denotes the same instant
+	assert.Equal(t, "2025-12-25T22:00:00+01:00", data.StartsAt.ValueString())
+	assert.Equal(t, "2025-12-25T23:00:00Z", data.EndsAt.ValueString())
+	mockClient.AssertExpectations(t)
+}
+
+func TestMaintenanceWindowResource_Read(t *testing.T) {
+	tests := []struct {
+		name             string
+		window           *client.MaintenanceWindow
+		expectedStartsAt string
+		expectedEndsAt   string
+		expectRemoved    bool
+	}{
+		{
+			name: "unchanged",
+			window: &client.MaintenanceWindow{ID: 123, HostID: 12345,
+				StartsAt: time.Date(2025, 12, 25, 21, 0, 0, 0, time.UTC), EndsAt: time.Date(2025, 12, 25, 23, 0, 0, 0, time.UTC)},
+			expectedStartsAt: "2025-12-25T22:00:00+01:00",
+			expectedEndsAt:   "2025-12-25T23:00:00Z",
+		},
+		{
+			name: "rescheduled outside Terraform",
+			window: &client.MaintenanceWindow{ID: 123, HostID: 12345,
+				StartsAt: time.Date(2025, 12, 26, 21, 0, 0, 0, time.UTC), EndsAt: time.Date(2025, 12, 26, 23, 0, 0, 0, time.UTC)},
+			expectedStartsAt: "2025-12-26T21:00:00Z",
+			expectedEndsAt:   "2025-12-26T23:00:00Z",
+		},
+		{
+			name:          "deleted outside Terraform",
+			expectRemoved: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &client.MockMaintenanceWindowAPI{}
+			if tt.window != nil {
+				mockClient.On("GetMaintenanceWindow", mock.Anything, 12345, 123).Return(tt.window, nil)
+			} else {
+				mockClient.On("GetMaintenanceWindow", mock.Anything, 12345, 123).Return(nil, client.ErrNotFound)
+			}
+
+			r := &maintenanceWindowResource{client: mockClient}
+			config := newMaintenanceWindowTestConfig(t, r, "123", "2025-12-25T22:00:00+01:00", "2025-12-25T23:00:00Z")
+			state := tfsdk.State{Schema: config.Schema, Raw: config.Raw}
+			resp := &frameworkresource.ReadResponse{State: state}
+
+			r.Read(t.Context(), frameworkresource.ReadRequest{State: state}, resp)
+
+			assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+			if tt.expectRemoved {
+				assert.True(t, resp.State.Raw.IsNull())
+				return
+			}
+
+			var data maintenanceWindowResourceModel
+			assert.False(t, resp.State.Get(t.Context(), &data).HasError())
+			assert.Equal(t, tt.expectedStartsAt, data.StartsAt.ValueString())
+			assert.Equal(t, tt.expectedEndsAt, data.EndsAt.ValueString())
+		})
+	}
+}
@@ -2,41 +2,142 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
+	"unicode"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/radarnex/terraform-provider-wormly/internal/client"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &scheduledDowntimePeriodResource{}
-	_ resource.ResourceWithConfigure   = &scheduledDowntimePeriodResource{}
-	_ resource.ResourceWithImportState = &scheduledDowntimePeriodResource{}
+	_ resource.Resource                   = &scheduledDowntimePeriodResource{}
+	_ resource.ResourceWithConfigure      = &scheduledDowntimePeriodResource{}
+	_ resource.ResourceWithImportState    = &scheduledDowntimePeriodResource{}
+	_ resource.ResourceWithValidateConfig = &scheduledDowntimePeriodResource{}
 )
 
+// Recurrence patterns supported by the Wormly API.
+const (
+	downtimeRecurrenceOnceOnly = "ONCEONLY"
+	downtimeRecurrenceDaily    = "DAILY"
+	downtimeRecurrenceWeekly   = "WEEKLY"
+	downtimeRecurrenceMonthly  = "MONTHLY"
+)
+
+// downtimeRecurrences lists the recurrence patterns in schema order.
+var downtimeRecurrences = []string{
+	downtimeRecurrenceOnceOnly,
+	downtimeRecurrenceDaily,
+	downtimeRecurrenceWeekly,
+	downtimeRecurrenceMonthly,
+}
+
+// Typed attributes that carry the API "on" parameter for a single recurrence.
+const (
+	downtimeOnDate       = "on_date"
+	downtimeOnWeekday    = "on_weekday"
+	downtimeOnDayOfMonth = "on_day_of_month"
+)
+
+// downtimeOnAttributes lists the typed "on" attributes in schema order.
+var downtimeOnAttributes = []string{downtimeOnDate, downtimeOnWeekday, downtimeOnDayOfMonth}
+
+// downtimeOnAttributeForRecurrence maps each recurrence that needs a day to its typed attribute.
+var downtimeOnAttributeForRecurrence = map[string]string{
+	downtimeRecurrenceOnceOnly: downtimeOnDate,
+	downtimeRecurrenceWeekly:   downtimeOnWeekday,
+	downtimeRecurrenceMonthly:  downtimeOnDayOfMonth,
+}
+
+// downtimeWeekdays lists the accepted on_weekday values.
+var downtimeWeekdays = []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+
 // scheduledDowntimePeriodResourceModel represents the resource data model.
 type scheduledDowntimePeriodResourceModel struct {
-	ID         types.String `tfsdk:"id"`
-	HostID     types.Int64  `tfsdk:"hostid"`
-	Start      types.String `tfsdk:"start"`
-	End        types.String `tfsdk:"end"`
-	Timezone   types.String `tfsdk:"timezone"`
-	Recurrence types.String `tfsdk:"recurrence"`
-	On         types.String `tfsdk:"on"`
+	ID           types.String `tfsdk:"id"`
+	HostID       types.Int64  `tfsdk:"hostid"`
+	Start        types.String `tfsdk:"start"`
+	End          types.String `tfsdk:"end"`
+	Timezone     types.String `tfsdk:"timezone"`
+	Recurrence   types.String `tfsdk:"recurrence"`
+	On           types.String `tfsdk:"on"`
+	OnDate       types.String `tfsdk:"on_date"`
+	OnWeekday    types.String `tfsdk:"on_weekday"`
+	OnDayOfMonth types.String `tfsdk:"on_day_of_month"`
+}
+
+// onAttributes returns the typed "on" attributes of the model keyed by attribute name.
+func (m *scheduledDowntimePeriodResourceModel) onAttributes() map[string]types.String {
+	return map[string]types.String{
+		downtimeOnDate:       m.OnDate,
+		downtimeOnWeekday:    m.OnWeekday,
+		downtimeOnDayOfMonth: m.OnDayOfMonth,
+	}
+}
+
+// onValue returns the API "on" parameter from whichever typed attribute is set,
+// falling back to the deprecated on attribute.
+func (m *scheduledDowntimePeriodResourceModel) onValue() string {
+	attributes := m.onAttributes()
+	for _, name := range downtimeOnAttributes {
+		if value := attributes[name]; !value.IsNull() && !value.IsUnknown() {
+			return value.ValueString()
+		}
+	}
+	return m.On.ValueString()
+}
+
+// setOn stores the API "on" value in the deprecated on attribute when the
+// configuration uses it, and in the typed attribute for the recurrence otherwise.
+func (m *scheduledDowntimePeriodResourceModel) setOn(recurrence, on string) {
+	useDeprecated := !m.On.IsNull()
+
+	m.On = types.StringNull()
+	m.OnDate = types.StringNull()
+	m.OnWeekday = types.StringNull()
+	m.OnDayOfMonth = types.StringNull()
+
+	if on == "" {
+		return
+	}
+
+	switch {
+	case useDeprecated:
+		m.On = types.StringValue(on)
+	case recurrence == downtimeRecurrenceOnceOnly:
+		m.OnDate = types.StringValue(on)
+	case recurrence == downtimeRecurrenceWeekly:
+		m.OnWeekday = types.StringValue(on)
+	case recurrence == downtimeRecurrenceMonthly:
+		m.OnDayOfMonth = types.StringValue(on)
+	default:
+		m.On = types.StringValue(on)
+	}
 }
 
 // scheduledDowntimePeriodResource defines the resource implementation.
 type scheduledDowntimePeriodResource struct {
 	client client.ScheduledDowntimePeriodAPI
+
+	// Used to warn about periods on disabled hosts; nil when the provider data does not implement it.
+	hosts client.HostAPI
+
+	eventualConsistencyRetries int
 }
 
 // NewScheduledDowntimePeriodResource creates a new scheduled downtime period resource.
@@ -50,7 +151,7 @@ func (r *scheduledDowntimePeriodResource) Metadata(_ context.Context, req resour
 
 func (r *scheduledDowntimePeriodResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Wormly scheduled downtime period resource",
+		MarkdownDescription: "Wormly scheduled downtime period resource\n\n~> Note: When the host has monitoring disabled, refreshing a period shows a warning, since downtime on an unmonitored host has no effect.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Scheduled downtime period identifier",
@@ -81,22 +182,214 @@ func (r *scheduledDowntimePeriodResource) Schema(_ context.Context, _ resource.S
 			"recurrence": schema.StringAttribute{
 				MarkdownDescription: "The recurrence pattern. Must be one of ONCEONLY, DAILY, WEEKLY, or MONTHLY",
 				Required:            true,
+				Validators: []validator.String{
+					stringOneOfValidator{values: downtimeRecurrences},
+				},
 			},
 			"on": schema.StringAttribute{
 				MarkdownDescription: "The specific day for the downtime. For ONCEONLY recurrence, this is a date in YYYY-MM-DD format. For WEEKLY recurrence, this is the day of the week (e.g., 'Sunday'). For MONTHLY recurrence, this is the day of the month (1-31 or 'LASTDAY'). This argument should be omitted for DAILY recurrence.",
 				Optional:            true,
+				DeprecationMessage:  "Use on_date, on_weekday or on_day_of_month instead.",
+			},
+			"on_date": schema.StringAttribute{
+				MarkdownDescription: "The date of a ONCEONLY downtime in YYYY-MM-DD format",
+				Optional:            true,
+			},
+			"on_weekday": schema.StringAttribute{
+				MarkdownDescription: "The day of the week of a WEEKLY downtime (e.g., 'Sunday')",
+				Optional:            true,
+			},
+			"on_day_of_month": schema.StringAttribute{
+				MarkdownDescription: "The day of the month of a MONTHLY downtime (1-31 or 'LASTDAY')",
+				Optional:            true,
 			},
 		},
 	}
 }
 
+func (r *scheduledDowntimePeriodResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data scheduledDowntimePeriodResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Recurrence.IsNull() || data.Recurrence.IsUnknown() {
+		return
+	}
+
+	// An unknown recurrence is reported by the recurrence validator
+	recurrence := data.Recurrence.ValueString()
+	if !slices.Contains(downtimeRecurrences, recurrence) {
+		return
+	}
+	attributes := data.onAttributes()
+
+	// The deprecated attribute stays usable on its own but cannot be mixed with the typed ones
+	if !data.On.IsNull() {
+		for _, name := range downtimeOnAttributes {
+			if !attributes[name].IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(name),
+					"Conflicting Downtime Day Attributes",
+					fmt.Sprintf("%s cannot be combined with the deprecated on attribute", name),
+				)
+			}
+		}
+		return
+	}
+
+	expected, needsDay := downtimeOnAttributeForRecurrence[recurrence]
+	for _, name := range downtimeOnAttributes {
+		value := attributes[name]
+		if value.IsNull() || name == expected {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root(name),
+			"Invalid Downtime Day Attribute",
+			fmt.Sprintf("%s cannot be set when recurrence is %s", name, recurrence),
+		)
+	}
+
+	if !needsDay {
+		return
+	}
+
+	value := attributes[expected]
+	if value.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(expected),
+			"Missing Downtime Day Attribute",
+			fmt.Sprintf("%s must be set when recurrence is %s", expected, recurrence),
+		)
+		return
+	}
+	if value.IsUnknown() {
+		return
+	}
+
+	if err := validateDowntimeOn(expected, value.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(expected),
+			"Invalid Downtime Day",
+			err.Error(),
+		)
+	}
+}
+
+// validateDowntimeOn checks the value of a typed "on" attribute.
+func validateDowntimeOn(attribute, value string) error {
+	switch attribute {
+	case downtimeOnDate:
+		if _, err := time.Parse(time.DateOnly, value); err != nil {
+			return fmt.Errorf("%s must be a date in YYYY-MM-DD format, got: %q", attribute, value)
+		}
+	case downtimeOnWeekday:
+		if !slices.Contains(downtimeWeekdays, value) {
+			return fmt.Errorf("%s must be one of %s, got: %q", attribute, strings.Join(downtimeWeekdays, ", "), value)
+		}
+	case downtimeOnDayOfMonth:
+		if value == "LASTDAY" {
+			return nil
+		}
+		day, err := strconv.Atoi(value)
+		if err != nil || day < 1 || day > 31 {
+			return fmt.Errorf("%s must be a day between 1 and 31 or LASTDAY, got: %q", attribute, value)
+		}
+	}
+	return nil
+}
+
+// downtimeErrorParameters lists the API parameters, in the order they are
+// checked, that an API error message may name, keyed to the attribute that
+// sets them. "on" is resolved by onAttributePath.
+var downtimeErrorParameters = []string{"timezone", "start", "end", "on"}
+
+// downtimeErrorPhrasings lists how Wormly error messages name an invalid
+// parameter, as words before and after the parameter name. A parameter name
+// used as an ordinary word, such as "denied on host 12", is not matched.
+var downtimeErrorPhrasings = []struct {
+	before []string
+	after  []string
+}{
+	{before: []string{"invalid"}},
+	{before: []string{"invalid", "value", "for"}},
+	{before: []string{"parameter"}},
+	{after: []string{"is", "not", "valid"}},
+	{after: []string{"is", "invalid"}},
+}
+
+// downtimeErrorAttribute returns the attribute an API error is about when the
+// Wormly error message names one of its parameters as invalid, for example
+// "Invalid timezone" or "on is not valid for WEEKLY recurrence".
+func downtimeErrorAttribute(data *scheduledDowntimePeriodResourceModel, err error) (path.Path, bool) {
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) {
+		return path.Empty(), false
+	}
+
+	words := strings.FieldsFunc(strings.ToLower(apiErr.Message), func(r rune) bool {
+		return !unicode.IsLetter(r) && r != '_'
+	})
+	for _, parameter := range downtimeErrorParameters {
+		if !namesInvalidParameter(words, parameter) {
+			continue
+		}
+		if parameter == "on" {
+			return data.onAttributePath(), true
+		}
+		return path.Root(parameter), true
+	}
+	return path.Empty(), false
+}
+
+// namesInvalidParameter reports whether words contain parameter in one of
+// the downtimeErrorPhrasings.
+func namesInvalidParameter(words []string, parameter string) bool {
+	for i, word := range words {
+		if word != parameter {
+			continue
+		}
+		for _, phrasing := range downtimeErrorPhrasings {
+			if i < len(phrasing.before) || i+1+len(phrasing.after) > len(words) {
+				continue
+			}
+			if slices.Equal(words[i-len(phrasing.before):i], phrasing.before) &&
+				slices.Equal(words[i+1:i+1+len(phrasing.after)], phrasing.after) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// onAttributePath returns the path of the attribute that carries the API "on"
+// parameter in the configuration.
+func (m *scheduledDowntimePeriodResourceModel) onAttributePath() path.Path {
+	attributes := m.onAttributes()
+	for _, name := range downtimeOnAttributes {
+		if !attributes[name].IsNull() {
+			return path.Root(name)
+		}
+	}
+	return path.Root("on")
+}
+
+// addDowntimeClientError reports a failed create or update, attached to the
+// attribute the API error names when there is one.
+func addDowntimeClientError(diags *diag.Diagnostics, data *scheduledDowntimePeriodResourceModel, action string, err error) {
+	detail := fmt.Sprintf("Unable to %s scheduled downtime period, got error: %s%s", action, err, apiErrorHint(err))
+	if attributePath, ok := downtimeErrorAttribute(data, err); ok {
+		diags.AddAttributeError(attributePath, "Invalid Scheduled Downtime Period", detail)
+		return
+	}
+	diags.AddError("Client Error", detail)
+}
+
 func (r *scheduledDowntimePeriodResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(client.ScheduledDowntimePeriodAPI)
+	downtimeClient, ok := req.ProviderData.(client.ScheduledDowntimePeriodAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
@@ -105,7 +398,13 @@ func (r *scheduledDowntimePeriodResource) Configure(_ context.Context, req resou
 		return
 	}
 
-	r.client = client
+	r.client = downtimeClient
+
+	// The provider client also implements the host API used to check the host's enabled state
+	if hosts, ok := req.ProviderData.(client.HostAPI); ok {
+		r.hosts = hosts
+	}
+	r.eventualConsistencyRetries = eventualConsistencyRetriesFrom(req.ProviderData)
 }
 
 func (r *scheduledDowntimePeriodResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -125,10 +424,10 @@ func (r *scheduledDowntimePeriodResource) Create(ctx context.Context, req resour
 		data.End.ValueString(),
 		data.Timezone.ValueString(),
 		data.Recurrence.ValueString(),
-		data.On.ValueString(),
+		data.onValue(),
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create scheduled downtime period, got error: %s", err))
+		addDowntimeClientError(&resp.Diagnostics, &data, "create", err)
 		return
 	}
 
@@ -140,7 +439,7 @@ func (r *scheduledDowntimePeriodResource) Create(ctx context.Context, req resour
 	data.Timezone = types.StringValue(period.Timezone)
 	data.Recurrence = types.StringValue(period.Recurrence)
 	if period.On != "" {
-		data.On = types.StringValue(period.On)
+		data.setOn(period.Recurrence, period.On)
 	}
 
 	// Save data into Terraform state
@@ -163,8 +462,10 @@ func (r *scheduledDowntimePeriodResource) Read(ctx context.Context, req resource
 		return
 	}
 
-	// Get the scheduled downtime period
-	period, err := r.client.GetScheduledDowntimePeriod(ctx, int(data.HostID.ValueInt64()), id)
+	// Get the scheduled downtime period, allowing for a period that was only just created
+	period, err := retryWhileNotFound(ctx, r.eventualConsistencyRetries, func() (*client.ScheduledDowntimePeriod, error) {
+		return r.client.GetScheduledDowntimePeriod(ctx, int(data.HostID.ValueInt64()), id)
+	})
 	if err != nil {
 		// Check if this is a not found error
 		if isNotFoundError(err) {
@@ -181,16 +482,57 @@ func (r *scheduledDowntimePeriodResource) Read(ctx context.Context, req resource
 	data.End = types.StringValue(period.End)
 	data.Timezone = types.StringValue(period.Timezone)
 	data.Recurrence = types.StringValue(period.Recurrence)
-	if period.On != "" {
-		data.On = types.StringValue(period.On)
-	} else {
-		data.On = types.StringNull()
-	}
+	data.setOn(period.Recurrence, readDowntimeOn(period, data.onValue()))
+
+	r.warnIfHostDisabled(ctx, period.HostID, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// readDowntimeOn returns the "on" value of a period read back from the API,
+// keeping a configured day 31 of a MONTHLY period that Wormly reports as
+// LASTDAY, since both mean the last day of the month.
+func readDowntimeOn(period *client.ScheduledDowntimePeriod, previous string) string {
+	if period.Recurrence == downtimeRecurrenceMonthly && period.On == "LASTDAY" && previous == "31" {
+		return previous
+	}
+	return period.On
+}
+
+// warnIfHostDisabled adds an advisory warning when the period's host has monitoring
+// disabled, since downtime on a host that is not monitored has no effect. Only the
+// host's monitoring status is read, which the client caches per host so the
+// periods of one host share a lookup. Failing to look it up is not an error; the
+// check is skipped.
+func (r *scheduledDowntimePeriodResource) warnIfHostDisabled(ctx context.Context, hostID int, diags *diag.Diagnostics) {
+	if r.hosts == nil {
+		return
+	}
+
+	monitoring, err := r.hosts.GetHostMonitoring(ctx, hostID)
+	if err != nil {
+		tflog.Debug(ctx, "Skipping host enabled check for scheduled downtime period", map[string]interface{}{
+			"host_id": hostID,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	if !monitoring.UptimeMonitored && !monitoring.HealthMonitored {
+		host := fmt.Sprintf("Host %d", hostID)
+		if monitoring.Name != "" {
+			host = fmt.Sprintf("Host %d (%s)", hostID, monitoring.Name)
+		}
+		diags.AddAttributeWarning(
+			path.Root("hostid"),
+			"Downtime Period On Disabled Host",
+			fmt.Sprintf("%s has monitoring disabled, so this scheduled downtime period has no effect. "+
+				"Remove the period or enable the host.", host),
+		)
+	}
+}
+
 func (r *scheduledDowntimePeriodResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data, state scheduledDowntimePeriodResourceModel
 
@@ -222,10 +564,10 @@ func (r *scheduledDowntimePeriodResource) Update(ctx context.Context, req resour
 		data.End.ValueString(),
 		data.Timezone.ValueString(),
 		data.Recurrence.ValueString(),
-		data.On.ValueString(),
+		data.onValue(),
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update scheduled downtime period, got error: %s", err))
+		addDowntimeClientError(&resp.Diagnostics, &data, "update", err)
 		return
 	}
 
@@ -236,11 +578,7 @@ func (r *scheduledDowntimePeriodResource) Update(ctx context.Context, req resour
 	data.End = types.StringValue(period.End)
 	data.Timezone = types.StringValue(period.Timezone)
 	data.Recurrence = types.StringValue(period.Recurrence)
-	if period.On != "" {
-		data.On = types.StringValue(period.On)
-	} else {
-		data.On = types.StringNull()
-	}
+	data.setOn(period.Recurrence, period.On)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -265,7 +603,7 @@ func (r *scheduledDowntimePeriodResource) Delete(ctx context.Context, req resour
 	// Delete the scheduled downtime period
 	err = r.client.DeleteScheduledDowntimePeriod(ctx, int(data.HostID.ValueInt64()), id)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete scheduled downtime period, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete scheduled downtime period, got error: %s%s", err, apiErrorHint(err)))
 		return
 	}
 }
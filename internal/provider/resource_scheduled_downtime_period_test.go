@@ -6,7 +6,13 @@ import (
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -258,3 +264,415 @@ func testAccScheduledDowntimePeriodImportStateIdFunc(resourceName string) resour
 		return fmt.Sprintf("%s/%s", hostID, periodID), nil
 	}
 }
+
+func newScheduledDowntimePeriodTestConfig(t *testing.T, r *scheduledDowntimePeriodResource, recurrence string, on map[string]string) tfsdk.Config {
+	t.Helper()
+
+	values := map[string]tftypes.Value{
+		"hostid":     tftypes.NewValue(tftypes.Number, 12345),
+		"start":      tftypes.NewValue(tftypes.String, "22:00"),
+		"end":        tftypes.NewValue(tftypes.String, "06:00"),
+		"timezone":   tftypes.NewValue(tftypes.String, "GMT"),
+		"recurrence": tftypes.NewValue(tftypes.String, recurrence),
+	}
+	for name, value := range on {
+		values[name] = tftypes.NewValue(tftypes.String, value)
+	}
+
+	return newResourceTestValue(t, r, values).Config()
+}
+
+func TestScheduledDowntimePeriodResource_ValidateConfig_On(t *testing.T) {
+	tests := []struct {
+		name        string
+		recurrence  string
+		on          map[string]string
+		expectError bool
+	}{
+		{name: "once only with on_date", recurrence: "ONCEONLY", on: map[string]string{"on_date": "2025-12-24"}},
+		{name: "weekly with on_weekday", recurrence: "WEEKLY", on: map[string]string{"on_weekday": "Sunday"}},
+		{name: "monthly with on_day_of_month", recurrence: "MONTHLY", on: map[string]string{"on_day_of_month": "15"}},
+		{name: "monthly with last day", recurrence: "MONTHLY", on: map[string]string{"on_day_of_month": "LASTDAY"}},
+		{name: "daily without day", recurrence: "DAILY"},
+		{name: "deprecated on", recurrence: "WEEKLY", on: map[string]string{"on": "Sunday"}},
+		{name: "once only missing on_date", recurrence: "ONCEONLY", expectError: true},
+		{name: "weekly with on_date", recurrence: "WEEKLY", on: map[string]string{"on_date": "2025-12-24"}, expectError: true},
+		{name: "daily with on_weekday", recurrence: "DAILY", on: map[string]string{"on_weekday": "Sunday"}, expectError: true},
+		{name: "invalid date", recurrence: "ONCEONLY", on: map[string]string{"on_date": "24/12/2025"}, expectError: true},
+		{name: "invalid weekday", recurrence: "WEEKLY", on: map[string]string{"on_weekday": "Someday"}, expectError: true},
+		{name: "invalid day of month", recurrence: "MONTHLY", on: map[string]string{"on_day_of_month": "32"}, expectError: true},
+		{name: "deprecated on combined with typed", recurrence: "WEEKLY", on: map[string]string{"on": "Sunday", "on_weekday": "Sunday"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &scheduledDowntimePeriodResource{}
+			req := frameworkresource.ValidateConfigRequest{
+				Config: newScheduledDowntimePeriodTestConfig(t, r, tt.recurrence, tt.on),
+			}
+			resp := &frameworkresource.ValidateConfigResponse{}
+
+			r.ValidateConfig(t.Context(), req, resp)
+
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+		})
+	}
+}
+
+func TestScheduledDowntimePeriodResource_RecurrenceValidation(t *testing.T) {
+	r := &scheduledDowntimePeriodResource{}
+	schemaResp := &frameworkresource.SchemaResponse{}
+	r.Schema(t.Context(), frameworkresource.SchemaRequest{}, schemaResp)
+	validators := schemaResp.Schema.Attributes["recurrence"].(interface{ StringValidators() []validator.String }).StringValidators()
+
+	for value, valid := range map[string]bool{"ONCEONLY": true, "DAILY": true, "WEEKLY": true, "MONTHLY": true, "weekly": false, "YEARLY": false} {
+		resp := &validator.StringResponse{}
+		for _, v := range validators {
+			v.ValidateString(t.Context(), validator.StringRequest{Path: path.Root("recurrence"), ConfigValue: types.StringValue(value)}, resp)
+		}
+		assert.Equal(t, !valid, resp.Diagnostics.HasError(), "recurrence %q", value)
+	}
+
+	// The day attributes are not checked against an unknown recurrence, which the validator above reports
+	resp := &frameworkresource.ValidateConfigResponse{}
+	r.ValidateConfig(t.Context(), frameworkresource.ValidateConfigRequest{
+		Config: newScheduledDowntimePeriodTestConfig(t, r, "weekly", map[string]string{"on_weekday": "Sunday"}),
+	}, resp)
+	assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+}
+
+func TestScheduledDowntimePeriodResource_Create_TypedOn(t *testing.T) {
+	tests := []struct {
+		recurrence string
+		attribute  string
+		on         string
+	}{
+		{recurrence: "ONCEONLY", attribute: "on_date", on: "2025-12-24"},
+		{recurrence: "WEEKLY", attribute: "on_weekday", on: "Sunday"},
+		{recurrence: "MONTHLY", attribute: "on_day_of_month", on: "LASTDAY"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.recurrence, func(t *testing.T) {
+			mockClient := &client.MockScheduledDowntimePeriodAPI{}
+			mockClient.On("CreateScheduledDowntimePeriod",
+				mock.Anything, 12345, "22:00", "06:00", "GMT", tt.recurrence, tt.on).
+				Return(&client.ScheduledDowntimePeriod{
+					ID:         123,
+					HostID:     12345,
+					Start:      "22:00",
+					End:        "06:00",
+					Timezone:   "GMT",
+					Recurrence: tt.recurrence,
+					On:         tt.on,
+				}, nil)
+
+			r := &scheduledDowntimePeriodResource{client: mockClient}
+			config := newScheduledDowntimePeriodTestConfig(t, r, tt.recurrence, map[string]string{tt.attribute: tt.on})
+			resp := &frameworkresource.CreateResponse{
+				State: tfsdk.State{Schema: config.Schema, Raw: config.Raw},
+			}
+
+			r.Create(t.Context(), frameworkresource.CreateRequest{
+				Plan: tfsdk.Plan{Schema: config.Schema, Raw: config.Raw},
+			}, resp)
+
+			assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+
+			var data scheduledDowntimePeriodResourceModel
+			assert.False(t, resp.State.Get(t.Context(), &data).HasError())
+			assert.Equal(t, tt.on, data.onAttributes()[tt.attribute].ValueString())
+			assert.True(t, data.On.IsNull())
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestScheduledDowntimePeriodResource_Read_RemovesDeletedPeriod(t *testing.T) {
+	mockClient := &client.MockScheduledDowntimePeriodAPI{}
+	mockClient.On("GetScheduledDowntimePeriod", mock.Anything, 12345, 123).
+		Return(nil, fmt.Errorf("scheduled downtime period with ID 123 %w", client.ErrNotFound))
+
+	r := &scheduledDowntimePeriodResource{client: mockClient}
+	config := newScheduledDowntimePeriodTestConfig(t, r, "DAILY", nil)
+	state := tfsdk.State{Schema: config.Schema, Raw: config.Raw}
+	assert.False(t, state.SetAttribute(t.Context(), path.Root("id"), "123").HasError())
+	resp := &frameworkresource.ReadResponse{State: state}
+
+	r.Read(t.Context(), frameworkresource.ReadRequest{State: state}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull(), "expected the period to be removed from state")
+	mockClient.AssertExpectations(t)
+}
+
+func TestScheduledDowntimePeriodResource_Read_WarnsOnDisabledHost(t *testing.T) {
+	tests := []struct {
+		name          string
+		monitoring    *client.HostMonitoring
+		hostErr       error
+		expectWarning string
+	}{
+		{
+			name:          "disabled host",
+			monitoring:    &client.HostMonitoring{HostID: 12345, Name: "web"},
+			expectWarning: "Host 12345 (web) has monitoring disabled",
+		},
+		{
+			name:          "host without a reported status",
+			monitoring:    &client.HostMonitoring{HostID: 12345},
+			expectWarning: "Host 12345 has monitoring disabled",
+		},
+		{
+			name:       "enabled host",
+			monitoring: &client.HostMonitoring{HostID: 12345, Name: "web", UptimeMonitored: true},
+		},
+		{
+			name:       "health monitoring only",
+			monitoring: &client.HostMonitoring{HostID: 12345, Name: "web", HealthMonitored: true},
+		},
+		{
+			name:    "host lookup fails",
+			hostErr: errors.New("connection refused"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &client.MockScheduledDowntimePeriodAPI{}
+			mockClient.On("GetScheduledDowntimePeriod", mock.Anything, 12345, 123).Return(&client.ScheduledDowntimePeriod{
+				ID:         123,
+				HostID:     12345,
+				Start:      "22:00",
+				End:        "06:00",
+				Timezone:   "GMT",
+				Recurrence: "DAILY",
+			}, nil)
+			hostClient := &client.MockHostAPI{}
+			if tt.hostErr != nil {
+				hostClient.On("GetHostMonitoring", mock.Anything, 12345).Return(nil, tt.hostErr)
+			} else {
+				hostClient.On("GetHostMonitoring", mock.Anything, 12345).Return(tt.monitoring, nil)
+			}
+
+			r := &scheduledDowntimePeriodResource{client: mockClient, hosts: hostClient}
+			config := newScheduledDowntimePeriodTestConfig(t, r, "DAILY", nil)
+			state := tfsdk.State{Schema: config.Schema, Raw: config.Raw}
+			assert.False(t, state.SetAttribute(t.Context(), path.Root("id"), "123").HasError())
+			resp := &frameworkresource.ReadResponse{State: state}
+
+			r.Read(t.Context(), frameworkresource.ReadRequest{State: state}, resp)
+
+			assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+			if tt.expectWarning != "" {
+				if assert.Equal(t, 1, resp.Diagnostics.WarningsCount(), "diagnostics: %v", resp.Diagnostics) {
+					assert.Equal(t, "Downtime Period On Disabled Host", resp.Diagnostics.Warnings()[0].Summary())
+					assert.Contains(t, resp.Diagnostics.Warnings()[0].Detail(), tt.expectWarning)
+				}
+			} else {
+				assert.Zero(t, resp.Diagnostics.WarningsCount(), "diagnostics: %v", resp.Diagnostics)
+			}
+			assert.False(t, resp.State.Raw.IsNull())
+			hostClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestScheduledDowntimePeriodResourceModel_SetOn(t *testing.T) {
+	t.Run("deprecated on is kept", func(t *testing.T) {
+		data := scheduledDowntimePeriodResourceModel{On: types.StringValue("Sunday")}
+		data.setOn("WEEKLY", "Monday")
+
+		assert.Equal(t, "Monday", data.On.ValueString())
+		assert.True(t, data.OnWeekday.IsNull())
+	})
+
+	t.Run("typed attribute for imported period", func(t *testing.T) {
+		data := scheduledDowntimePeriodResourceModel{On: types.StringNull()}
+		data.setOn("MONTHLY", "15")
+
+		assert.Equal(t, "15", data.OnDayOfMonth.ValueString())
+		assert.True(t, data.On.IsNull())
+	})
+
+	t.Run("empty on clears attributes", func(t *testing.T) {
+		data := scheduledDowntimePeriodResourceModel{OnDate: types.StringValue("2025-12-24")}
+		data.setOn("DAILY", "")
+
+		assert.True(t, data.OnDate.IsNull())
+		assert.True(t, data.On.IsNull())
+	})
+}
+
+func TestReadDowntimeOn(t *testing.T) {
+	tests := []struct {
+		name       string
+		recurrence string
+		on         string
+		previous   string
+		expected   string
+	}{
+		{name: "day 31 reported as last day", recurrence: "MONTHLY", on: "LASTDAY", previous: "31", expected: "31"},
+		{name: "last day", recurrence: "MONTHLY", on: "LASTDAY", previous: "LASTDAY", expected: "LASTDAY"},
+		{name: "last day set outside terraform", recurrence: "MONTHLY", on: "LASTDAY", previous: "15", expected: "LASTDAY"},
+		{name: "imported last day", recurrence: "MONTHLY", on: "LASTDAY", expected: "LASTDAY"},
+		{name: "day number", recurrence: "MONTHLY", on: "30", previous: "31", expected: "30"},
+		{name: "weekday", recurrence: "WEEKLY", on: "Sunday", previous: "Sunday", expected: "Sunday"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			period := &client.ScheduledDowntimePeriod{Recurrence: tt.recurrence, On: tt.on}
+			assert.Equal(t, tt.expected, readDowntimeOn(period, tt.previous))
+		})
+	}
+}
+
+func TestDowntimeErrorAttribute(t *testing.T) {
+	tests := []struct {
+		name     string
+		on       map[string]string
+		err      error
+		expected path.Path
+		ok       bool
+	}{
+		{
+			name:     "invalid timezone",
+			err:      &client.APIError{Code: 1, Message: "Invalid timezone"},
+			expected: path.Root("timezone"),
+			ok:       true,
+		},
+		{
+			name:     "start time",
+			err:      fmt.Errorf("failed to create scheduled downtime period: %w", &client.APIError{Code: 1, Message: "Invalid start time: 25:00"}),
+			expected: path.Root("start"),
+			ok:       true,
+		},
+		{
+			name:     "end time",
+			err:      &client.APIError{Code: 1, Message: "Parameter 'end' must be HH:mm"},
+			expected: path.Root("end"),
+			ok:       true,
+		},
+		{
+			name:     "on resolves to the typed attribute",
+			on:       map[string]string{"on_weekday": "Someday"},
+			err:      &client.APIError{Code: 1, Message: "on is not valid for WEEKLY recurrence"},
+			expected: path.Root("on_weekday"),
+			ok:       true,
+		},
+		{
+			name:     "on resolves to the deprecated attribute",
+			on:       map[string]string{"on": "Someday"},
+			err:      &client.APIError{Code: 1, Message: "Invalid value for on"},
+			expected: path.Root("on"),
+			ok:       true,
+		},
+		{
+			name: "parameter name inside another word",
+			err:  &client.APIError{Code: 1, Message: "Recurrence pattern is not one of the supported options"},
+		},
+		{
+			name: "on as an ordinary word",
+			on:   map[string]string{"on_weekday": "Sunday"},
+			err:  &client.APIError{Code: 1, Message: "Permission denied on host 12"},
+		},
+		{
+			name: "on as an ordinary word in a quota error",
+			on:   map[string]string{"on_weekday": "Sunday"},
+			err:  &client.APIError{Code: 1, Message: "Quota exceeded on this account"},
+		},
+		{
+			name: "end as an ordinary word",
+			err:  &client.APIError{Code: 1, Message: "Downtime must end after it starts"},
+		},
+		{
+			name:     "parameter is invalid",
+			err:      &client.APIError{Code: 1, Message: "timezone is invalid: Mars/Olympus"},
+			expected: path.Root("timezone"),
+			ok:       true,
+		},
+		{
+			name: "unrelated API error",
+			err:  &client.APIError{Code: client.ErrorCodeInvalidHost, Message: "Invalid host"},
+		},
+		{
+			name: "not an API error",
+			err:  errors.New("connection refused"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := scheduledDowntimePeriodResourceModel{
+				On:           types.StringNull(),
+				OnDate:       types.StringNull(),
+				OnWeekday:    types.StringNull(),
+				OnDayOfMonth: types.StringNull(),
+			}
+			for name, value := range tt.on {
+				switch name {
+				case "on":
+					data.On = types.StringValue(value)
+				case "on_weekday":
+					data.OnWeekday = types.StringValue(value)
+				}
+			}
+
+			attributePath, ok := downtimeErrorAttribute(&data, tt.err)
+
+			assert.Equal(t, tt.ok, ok)
+			if tt.ok {
+				assert.Equal(t, tt.expected, attributePath)
+			}
+		})
+	}
+}
+
+func TestScheduledDowntimePeriodResource_Create_AttributeError(t *testing.T) {
+	mockClient := &client.MockScheduledDowntimePeriodAPI{}
+	mockClient.On("CreateScheduledDowntimePeriod",
+		mock.Anything, 12345, "22:00", "06:00", "GMT", "WEEKLY", "Sunday").
+		Return(nil, &client.APIError{Code: 1, Message: "on is not valid for WEEKLY recurrence"})
+
+	r := &scheduledDowntimePeriodResource{client: mockClient}
+	config := newScheduledDowntimePeriodTestConfig(t, r, "WEEKLY", map[string]string{"on_weekday": "Sunday"})
+	resp := &frameworkresource.CreateResponse{
+		State: tfsdk.State{Schema: config.Schema, Raw: config.Raw},
+	}
+
+	r.Create(t.Context(), frameworkresource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: config.Schema, Raw: config.Raw},
+	}, resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+	errorDiag := resp.Diagnostics.Errors()[0]
+	withPath, ok := errorDiag.(diag.DiagnosticWithPath)
+	if assert.True(t, ok, "expected an attribute diagnostic, got: %v", errorDiag) {
+		assert.Equal(t, path.Root("on_weekday"), withPath.Path())
+	}
+	assert.Contains(t, errorDiag.Detail(), "on is not valid for WEEKLY recurrence")
+}
+
+func TestScheduledDowntimePeriodResource_Create_UnrelatedErrorNotAttributed(t *testing.T) {
+	mockClient := &client.MockScheduledDowntimePeriodAPI{}
+	mockClient.On("CreateScheduledDowntimePeriod",
+		mock.Anything, 12345, "22:00", "06:00", "GMT", "WEEKLY", "Sunday").
+		Return(nil, &client.APIError{Code: 1, Message: "Permission denied on host 12345"})
+
+	r := &scheduledDowntimePeriodResource{client: mockClient}
+	config := newScheduledDowntimePeriodTestConfig(t, r, "WEEKLY", map[string]string{"on_weekday": "Sunday"})
+	resp := &frameworkresource.CreateResponse{
+		State: tfsdk.State{Schema: config.Schema, Raw: config.Raw},
+	}
+
+	r.Create(t.Context(), frameworkresource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: config.Schema, Raw: config.Raw},
+	}, resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+	errorDiag := resp.Diagnostics.Errors()[0]
+	_, ok := errorDiag.(diag.DiagnosticWithPath)
+	assert.False(t, ok, "expected a diagnostic without an attribute, got: %v", errorDiag)
+	assert.Equal(t, "Client Error", errorDiag.Summary())
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/radarnex/terraform-provider-wormly/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &sensorAcknowledgementResource{}
+	_ resource.ResourceWithConfigure = &sensorAcknowledgementResource{}
+)
+
+// sensorAcknowledgementResourceModel represents the resource data model.
+type sensorAcknowledgementResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	SensorID types.Int64  `tfsdk:"sensor_id"`
+	Triggers types.Map    `tfsdk:"triggers"`
+}
+
+// sensorAcknowledgementResource acknowledges a sensor's current error. It is an
+// action rather than desired state: creating it acknowledges the error, and
+// destroying it only forgets that it did.
+type sensorAcknowledgementResource struct {
+	client client.SensorAPI
+}
+
+// NewSensorAcknowledgementResource creates a new sensor acknowledgement resource.
+func NewSensorAcknowledgementResource() resource.Resource {
+	return &sensorAcknowledgementResource{}
+}
+
+func (r *sensorAcknowledgementResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sensor_acknowledgement"
+}
+
+func (r *sensorAcknowledgementResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Acknowledges the current error of a Wormly sensor, which stops further alerts for it until the sensor recovers. " +
+			"Creating the resource acknowledges the error; destroying it does nothing in Wormly. " +
+			"Change `triggers` to acknowledge again, for example during a later incident.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Resource identifier (the sensor ID)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sensor_id": schema.Int64Attribute{
+				MarkdownDescription: "HSID of the sensor to acknowledge. Changing it acknowledges the new sensor.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that acknowledge the sensor again when they change, such as an incident identifier",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *sensorAcknowledgementResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.SensorAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.SensorAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *sensorAcknowledgementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data sensorAcknowledgementResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sensorID := int(data.SensorID.ValueInt64())
+	if err := r.client.AcknowledgeSensor(ctx, sensorID); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to acknowledge sensor %d, got error: %s%s", sensorID, err, apiErrorHint(err)))
+		return
+	}
+
+	data.ID = types.StringValue(strconv.Itoa(sensorID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *sensorAcknowledgementResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data sensorAcknowledgementResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An acknowledgement is a past action with nothing to read back, so the
+	// state is kept as-is
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *sensorAcknowledgementResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data sensorAcknowledgementResourceModel
+
+	// Every configurable attribute requires replacement, so there is nothing to
+	// send; keep the plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *sensorAcknowledgementResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// An acknowledgement cannot be undone, and Wormly clears it once the sensor
+	// recovers; removing the resource from state is all there is to do
+}
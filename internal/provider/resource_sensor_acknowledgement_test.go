@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"errors"
+	"testing"
+
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/radarnex/terraform-provider-wormly/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSensorAcknowledgementResource_Configure(t *testing.T) {
+	r := &sensorAcknowledgementResource{}
+	mockClient := &client.MockSensorAPI{}
+	resp := &frameworkresource.ConfigureResponse{}
+
+	r.Configure(t.Context(), frameworkresource.ConfigureRequest{ProviderData: mockClient}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.Equal(t, mockClient, r.client)
+}
+
+func TestSensorAcknowledgementResource_Configure_InvalidType(t *testing.T) {
+	r := &sensorAcknowledgementResource{}
+	resp := &frameworkresource.ConfigureResponse{}
+
+	r.Configure(t.Context(), frameworkresource.ConfigureRequest{ProviderData: "invalid"}, resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+}
+
+// newSensorAcknowledgementTestPlan builds a plan for sensorID with no triggers.
+func newSensorAcknowledgementTestPlan(t *testing.T, r *sensorAcknowledgementResource, sensorID int) tfsdk.Plan {
+	t.Helper()
+
+	return newResourceTestValue(t, r, map[string]tftypes.Value{
+		"id":        tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"sensor_id": tftypes.NewValue(tftypes.Number, sensorID),
+	}).Plan()
+}
+
+func TestSensorAcknowledgementResource_Create(t *testing.T) {
+	mockClient := &client.MockSensorAPI{}
+	mockClient.On("AcknowledgeSensor", mock.Anything, 456).Return(nil).Once()
+
+	r := &sensorAcknowledgementResource{client: mockClient}
+	plan := newSensorAcknowledgementTestPlan(t, r, 456)
+	resp := &frameworkresource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+
+	r.Create(t.Context(), frameworkresource.CreateRequest{Plan: plan}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+	var state sensorAcknowledgementResourceModel
+	assert.False(t, resp.State.Get(t.Context(), &state).HasError())
+	assert.Equal(t, "456", state.ID.ValueString())
+	mockClient.AssertExpectations(t)
+}
+
+func TestSensorAcknowledgementResource_Create_Error(t *testing.T) {
+	mockClient := &client.MockSensorAPI{}
+	mockClient.On("AcknowledgeSensor", mock.Anything, 456).Return(errors.New("invalid hsid")).Once()
+
+	r := &sensorAcknowledgementResource{client: mockClient}
+	plan := newSensorAcknowledgementTestPlan(t, r, 456)
+	resp := &frameworkresource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+
+	r.Create(t.Context(), frameworkresource.CreateRequest{Plan: plan}, resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "invalid hsid")
+	assert.True(t, resp.State.Raw.IsNull())
+}
+
+func TestSensorAcknowledgementResource_Delete_IsNoOp(t *testing.T) {
+	mockClient := &client.MockSensorAPI{}
+
+	r := &sensorAcknowledgementResource{client: mockClient}
+	plan := newSensorAcknowledgementTestPlan(t, r, 456)
+	resp := &frameworkresource.DeleteResponse{}
+
+	r.Delete(t.Context(), frameworkresource.DeleteRequest{
+		State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw},
+	}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	mockClient.AssertNotCalled(t, "AcknowledgeSensor", mock.Anything, mock.Anything)
+}
@@ -1,34 +1,53 @@
 package provider
 
 import (
+	"cmp"
 	"context"
+	"encoding/json"
 	"fmt"
+	"math"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/radarnex/terraform-provider-wormly/internal/client"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &sensorHTTPResource{}
-	_ resource.ResourceWithConfigure   = &sensorHTTPResource{}
-	_ resource.ResourceWithImportState = &sensorHTTPResource{}
+	_ resource.Resource                     = &sensorHTTPResource{}
+	_ resource.ResourceWithConfigure        = &sensorHTTPResource{}
+	_ resource.ResourceWithImportState      = &sensorHTTPResource{}
+	_ resource.ResourceWithConfigValidators = &sensorHTTPResource{}
+	_ resource.ResourceWithMoveState        = &sensorHTTPResource{}
+	_ resource.ResourceWithModifyPlan       = &sensorHTTPResource{}
 )
 
+// legacySensorHTTPTypeName is the resource type that HTTP sensors can be moved
+// from with a moved block, keeping the sensor instead of recreating it.
+const legacySensorHTTPTypeName = "wormly_http_sensor"
+
 // sensorHTTPResourceModel represents the resource data model.
 type sensorHTTPResourceModel struct {
 	ID                   types.String `tfsdk:"id"`
 	HostID               types.Int64  `tfsdk:"host_id"`
+	HostName             types.String `tfsdk:"host_name"`
 	URL                  types.String `tfsdk:"url"`
 	NiceName             types.String `tfsdk:"nice_name"`
 	Enabled              types.Bool   `tfsdk:"enabled"`
@@ -37,18 +56,34 @@ type sensorHTTPResourceModel struct {
 	VerifySSLCert        types.Bool   `tfsdk:"verify_ssl_cert"`
 	SearchHeaders        types.Bool   `tfsdk:"search_headers"`
 	ExpectedText         types.String `tfsdk:"expected_text"`
+	ExpectedTextIsRegex  types.Bool   `tfsdk:"expected_text_is_regex"`
 	UnwantedText         types.String `tfsdk:"unwanted_text"`
 	SSLValidity          types.Int64  `tfsdk:"ssl_validity"`
+	SSLMinExpiryDate     types.String `tfsdk:"ssl_min_expiry_date"`
 	Cookies              types.String `tfsdk:"cookies"`
+	HTTPMethod           types.String `tfsdk:"http_method"`
 	PostParams           types.String `tfsdk:"post_params"`
+	PostContentType      types.String `tfsdk:"post_content_type"`
 	CustomRequestHeaders types.String `tfsdk:"custom_request_headers"`
+	RequestHeaders       types.Map    `tfsdk:"request_headers"`
 	UserAgent            types.String `tfsdk:"user_agent"`
 	ForceResolve         types.String `tfsdk:"force_resolve"`
+	AuthUsername         types.String `tfsdk:"auth_username"`
+	AuthPassword         types.String `tfsdk:"auth_password"`
+	TestLocations        types.Set    `tfsdk:"test_locations"`
+	FollowRedirects      types.Bool   `tfsdk:"follow_redirects"`
+	AlertAfterFailures   types.Int64  `tfsdk:"alert_after_failures"`
+	ResponseContentType  types.String `tfsdk:"response_content_type"`
 }
 
 // sensorHTTPResource defines the resource implementation.
 type sensorHTTPResource struct {
 	client client.SensorHTTPAPI
+
+	// Used to resolve host_name; nil when the provider data does not implement it.
+	hosts client.HostAPI
+
+	eventualConsistencyRetries int
 }
 
 // NewSensorHTTPResource creates a new HTTP sensor resource.
@@ -62,7 +97,7 @@ func (r *sensorHTTPResource) Metadata(_ context.Context, req resource.MetadataRe
 
 func (r *sensorHTTPResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Wormly HTTP sensor resource\n\n~> Note: Wormly's public API does not currently provide a dedicated update command for HTTP sensor settings, so changes to attributes other than `enabled` require resource replacement.",
+		MarkdownDescription: "Wormly HTTP sensor resource\n\n~> Note: Wormly's public API does not currently provide a dedicated update command for HTTP sensor settings, so changes to attributes other than `enabled` require resource replacement. The plan warns which changed attributes cause a replacement, since the new sensor starts without check history.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Sensor identifier in format <host_id>/<sensor_id>",
@@ -72,15 +107,27 @@ func (r *sensorHTTPResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"host_id": schema.Int64Attribute{
-				MarkdownDescription: "Host ID",
-				Required:            true,
+				MarkdownDescription: "Host ID. Exactly one of `host_id` or `host_name` must be set; when `host_name` is used, the resolved ID is stored here",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.Int64{
+					hostIDFromStateUnlessHostNameChanges{},
 					int64planmodifier.RequiresReplace(),
 				},
 			},
+			"host_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the host, resolved to its ID at create time. Exactly one of `host_id` or `host_name` must be set, and the name must match exactly one host",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"url": schema.StringAttribute{
-				MarkdownDescription: "URL to monitor",
+				MarkdownDescription: "URL to monitor. Must be an absolute `http://` or `https://` URL",
 				Required:            true,
+				Validators: []validator.String{
+					urlValidator{},
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -104,34 +151,39 @@ func (r *sensorHTTPResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"timeout": schema.Int64Attribute{
-				MarkdownDescription: "Timeout in seconds",
+				MarkdownDescription: "Timeout in seconds, between 1 and 120",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{min: 1, max: 120},
+				},
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 					int64planmodifier.RequiresReplace(),
 				},
 			},
 			"response_code": schema.StringAttribute{
-				MarkdownDescription: "Expected HTTP response code",
+				MarkdownDescription: "Expected HTTP response code: a status code (`200`), a range (`200-299`), or a comma-separated list of these (`200,301`)",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					responseCodeValidator{},
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"verify_ssl_cert": schema.BoolAttribute{
-				MarkdownDescription: "Whether to verify SSL certificate",
+				MarkdownDescription: "Whether to verify SSL certificate. Changing it updates the sensor in place",
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.Bool{
 					boolplanmodifier.UseStateForUnknown(),
-					boolplanmodifier.RequiresReplace(),
 				},
 			},
 			"search_headers": schema.BoolAttribute{
-				MarkdownDescription: "Whether to search headers",
+				MarkdownDescription: "Whether to search headers for `expected_text` and `unwanted_text`. Requires at least one of them",
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.Bool{
@@ -148,8 +200,17 @@ func (r *sensorHTTPResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"expected_text_is_regex": schema.BoolAttribute{
+				MarkdownDescription: "Whether `expected_text` is a regular expression rather than literal text. Regular expression matching is only available on some Wormly plans. Defaults to `false`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
 			"unwanted_text": schema.StringAttribute{
-				MarkdownDescription: "Unwanted text in response",
+				MarkdownDescription: "Unwanted text in response. Must differ from `expected_text`",
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
@@ -158,7 +219,31 @@ func (r *sensorHTTPResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"ssl_validity": schema.Int64Attribute{
-				MarkdownDescription: "SSL validity period in days",
+				MarkdownDescription: "SSL validity period in days. Must not be negative. A non-zero value requires an https `url`",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{min: 0, max: math.MaxInt64},
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"ssl_min_expiry_date": schema.StringAttribute{
+				MarkdownDescription: "Date in YYYY-MM-DD format the SSL certificate must stay valid until, as an alternative to `ssl_validity`. " +
+					"It is converted into the `ssl_validity` it comes to when the sensor is created, counting days from today in UTC, and cannot be set together with `ssl_validity`. " +
+					"Must be after today. Requires an https `url`",
+				Optional: true,
+				Validators: []validator.String{
+					dateValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"alert_after_failures": schema.Int64Attribute{
+				MarkdownDescription: "Number of consecutive failed checks before an alert is sent. Must be at least 1. The Wormly account default applies when unset",
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.Int64{
@@ -167,25 +252,53 @@ func (r *sensorHTTPResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"cookies": schema.StringAttribute{
-				MarkdownDescription: "Cookies to send with request",
+				MarkdownDescription: "Cookies to send with request, as `name=value` pairs separated by semicolons (e.g., `session=abc; theme=dark`)",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					cookiesValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"http_method": schema.StringAttribute{
+				MarkdownDescription: "HTTP method checks are made with. Must be one of `GET`, `POST`, `HEAD` or `PUT`. Wormly uses `GET`, or `POST` when `post_params` is set, when unset",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringOneOfValidator{values: client.SensorHTTPMethods},
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"post_params": schema.StringAttribute{
-				MarkdownDescription: "POST parameters",
+				MarkdownDescription: "POST parameters, such as `probe=1&team=web`. When `post_content_type` is `application/json`, the raw JSON body to send instead. Cannot be set when `http_method` is `GET` or `HEAD`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"post_content_type": schema.StringAttribute{
+				MarkdownDescription: "Content type `post_params` are sent as. Must be one of `application/x-www-form-urlencoded` or `application/json`. Defaults to `application/x-www-form-urlencoded`",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					stringOneOfValidator{values: client.SensorHTTPPostContentTypes},
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"custom_request_headers": schema.StringAttribute{
-				MarkdownDescription: "Custom request headers",
+				MarkdownDescription: "Custom request headers, one `Name: Value` line per header. Deprecated alias of `request_headers`.",
+				DeprecationMessage:  "Use request_headers instead.",
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
@@ -193,6 +306,19 @@ func (r *sensorHTTPResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"request_headers": schema.MapAttribute{
+				MarkdownDescription: "Custom request headers keyed by header name. Only one value can be sent per header name",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Map{
+					requestHeadersValidator{},
+				},
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
 			"user_agent": schema.StringAttribute{
 				MarkdownDescription: "User agent string",
 				Optional:            true,
@@ -202,26 +328,101 @@ func (r *sensorHTTPResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"follow_redirects": schema.BoolAttribute{
+				MarkdownDescription: "Whether checks follow 3xx redirects, so the final response is the one matched against `response_code` and the text checks. The Wormly default applies when unset",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
 			"force_resolve": schema.StringAttribute{
-				MarkdownDescription: "Force resolve to specific IP",
+				MarkdownDescription: "Force resolve to specific IP. Must be an IPv4 or IPv6 address",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					ipAddressValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"auth_username": schema.StringAttribute{
+				MarkdownDescription: "Username for HTTP basic authentication with the monitored URL. Must be set together with `auth_password`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"auth_password": schema.StringAttribute{
+				MarkdownDescription: "Password for HTTP basic authentication with the monitored URL. Must be set together with `auth_username`. Wormly may not return it, in which case the configured value is kept in state",
 				Optional:            true,
 				Computed:            true,
+				Sensitive:           true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"test_locations": schema.SetAttribute{
+				MarkdownDescription: "Codes of the locations the sensor is checked from (e.g., `lon` or `nyc`). Wormly chooses the locations when unset",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Set{
+					testLocationsValidator{},
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"response_content_type": schema.StringAttribute{
+				MarkdownDescription: "Content type the URL is expected to return (e.g., `text/html` or `application/pdf`). It is only used to validate the configuration and is not sent to Wormly: a warning is shown when `expected_text` or `unwanted_text` is set for binary content, where text matching is meaningless.",
+				Optional:            true,
+			},
 		},
 	}
 }
 
+func (r *sensorHTTPResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return sensorHTTPConfigValidators
+}
+
+// ModifyPlan warns when a planned change replaces an existing sensor. Terraform
+// already marks the replacement in the plan, but not that the sensor loses its
+// check history, which users tweaking a setting such as timeout do not expect.
+func (r *sensorHTTPResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing is replaced when the sensor is created or destroyed
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || len(resp.RequiresReplace) == 0 {
+		return
+	}
+
+	attributes := make([]string, 0, len(resp.RequiresReplace))
+	for _, p := range resp.RequiresReplace {
+		attributes = append(attributes, "`"+p.String()+"`")
+	}
+	slices.Sort(attributes)
+
+	resp.Diagnostics.AddWarning(
+		"HTTP Sensor Will Be Replaced",
+		fmt.Sprintf("Changing %s replaces this HTTP sensor. Wormly's API cannot update these settings of an existing sensor, "+
+			"so the sensor is deleted and created again, losing its check history. Only `enabled` and `verify_ssl_cert` can change in place.",
+			strings.Join(attributes, ", ")),
+	)
+}
+
 func (r *sensorHTTPResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(client.SensorHTTPAPI)
+	sensorClient, ok := req.ProviderData.(client.SensorHTTPAPI)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
@@ -230,7 +431,13 @@ func (r *sensorHTTPResource) Configure(_ context.Context, req resource.Configure
 		return
 	}
 
-	r.client = client
+	r.client = sensorClient
+
+	// The provider client also implements the host API used to resolve host_name
+	if hosts, ok := req.ProviderData.(client.HostAPI); ok {
+		r.hosts = hosts
+	}
+	r.eventualConsistencyRetries = eventualConsistencyRetriesFrom(req.ProviderData)
 }
 
 func (r *sensorHTTPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -242,6 +449,25 @@ func (r *sensorHTTPResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	if !data.HostName.IsNull() {
+		hostID, diags := r.resolveHostID(ctx, data.HostName.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.HostID = types.Int64Value(int64(hostID))
+	}
+
+	// The API only takes days, so the date is converted as of today
+	if !data.SSLMinExpiryDate.IsNull() && !data.SSLMinExpiryDate.IsUnknown() {
+		days, err := sslValidityDays(data.SSLMinExpiryDate.ValueString(), time.Now())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("ssl_min_expiry_date"), "Invalid SSL Minimum Expiry Date", err.Error())
+			return
+		}
+		data.SSLValidity = types.Int64Value(int64(days))
+	}
+
 	plannedData := data
 
 	// Build create request
@@ -268,6 +494,9 @@ func (r *sensorHTTPResource) Create(ctx context.Context, req resource.CreateRequ
 	if !data.ExpectedText.IsNull() && !data.ExpectedText.IsUnknown() {
 		createReq.ExpectedText = data.ExpectedText.ValueString()
 	}
+	if !data.ExpectedTextIsRegex.IsNull() && !data.ExpectedTextIsRegex.IsUnknown() {
+		createReq.ExpectedTextIsRegex = data.ExpectedTextIsRegex.ValueBool()
+	}
 	if !data.UnwantedText.IsNull() && !data.UnwantedText.IsUnknown() {
 		createReq.UnwantedText = data.UnwantedText.ValueString()
 	}
@@ -277,59 +506,126 @@ func (r *sensorHTTPResource) Create(ctx context.Context, req resource.CreateRequ
 	if !data.Cookies.IsNull() && !data.Cookies.IsUnknown() {
 		createReq.Cookies = data.Cookies.ValueString()
 	}
+	if !data.HTTPMethod.IsNull() && !data.HTTPMethod.IsUnknown() {
+		createReq.HTTPMethod = data.HTTPMethod.ValueString()
+	}
 	if !data.PostParams.IsNull() && !data.PostParams.IsUnknown() {
 		createReq.PostParams = data.PostParams.ValueString()
 	}
+	if !data.PostContentType.IsNull() && !data.PostContentType.IsUnknown() {
+		createReq.PostContentType = data.PostContentType.ValueString()
+	}
 	if !data.CustomRequestHeaders.IsNull() && !data.CustomRequestHeaders.IsUnknown() {
 		createReq.CustomRequestHeaders = data.CustomRequestHeaders.ValueString()
 	}
+	if !data.RequestHeaders.IsNull() && !data.RequestHeaders.IsUnknown() {
+		var headers map[string]string
+		resp.Diagnostics.Append(data.RequestHeaders.ElementsAs(ctx, &headers, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		createReq.CustomRequestHeaders = formatRequestHeaders(headers)
+	}
 	if !data.UserAgent.IsNull() && !data.UserAgent.IsUnknown() {
 		createReq.UserAgent = data.UserAgent.ValueString()
 	}
 	if !data.ForceResolve.IsNull() && !data.ForceResolve.IsUnknown() {
 		createReq.ForceResolve = data.ForceResolve.ValueString()
 	}
+	if !data.AuthUsername.IsNull() && !data.AuthUsername.IsUnknown() {
+		createReq.AuthUsername = data.AuthUsername.ValueString()
+	}
+	if !data.AuthPassword.IsNull() && !data.AuthPassword.IsUnknown() {
+		createReq.AuthPassword = data.AuthPassword.ValueString()
+	}
+	if !data.TestLocations.IsNull() && !data.TestLocations.IsUnknown() {
+		resp.Diagnostics.Append(data.TestLocations.ElementsAs(ctx, &createReq.TestLocations, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if !data.FollowRedirects.IsNull() && !data.FollowRedirects.IsUnknown() {
+		createReq.FollowRedirects = data.FollowRedirects.ValueBoolPointer()
+	}
+	if !data.AlertAfterFailures.IsNull() && !data.AlertAfterFailures.IsUnknown() {
+		createReq.AlertAfterFailures = int(data.AlertAfterFailures.ValueInt64())
+	}
 
 	// Create the sensor
 	sensor, err := r.client.CreateSensorHTTP(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create HTTP sensor, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create HTTP sensor, got error: %s%s", err, apiErrorHint(err)))
 		return
 	}
 
-	// Handle enabled state - ensure sensor matches desired state
-	if data.Enabled.ValueBool() {
-		// Explicitly enable the sensor to ensure it's enabled
-		err = r.client.EnableSensorHTTP(ctx, sensor.ID)
-		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to enable HTTP sensor after creation, got error: %s", err))
-			return
-		}
-	} else {
-		// Explicitly disable the sensor
+	// Record the sensor as soon as it exists, so a failed follow-up step leaves
+	// it in state for the next refresh to reconcile instead of orphaning it.
+	// The create response echoes the request, so only the plan's unknowns come
+	// from it.
+	data.ID = types.StringValue(formatSensorID(sensor.HostID, sensor.ID))
+	setSensorHTTPResourceModelFromAPI(&data, sensor)
+	data.HostID = plannedData.HostID
+	data.URL = plannedData.URL
+	data.Enabled = plannedData.Enabled
+	applyKnownSensorHTTPPlanValues(&data, &plannedData)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Wormly creates sensors enabled, so only a disabled sensor needs another call
+	if !data.Enabled.ValueBool() {
 		err = r.client.DisableSensorHTTP(ctx, sensor.ID)
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disable HTTP sensor after creation, got error: %s", err))
+			addSensorHTTPCreateFollowUpWarning(&resp.Diagnostics, "disabled", err)
 			return
 		}
 	}
 
 	// Read the created sensor so all computed attributes are known in state.
-	sensor, err = r.client.GetSensorHTTP(ctx, sensor.HostID, sensor.ID)
+	created := sensor
+	sensor, err = retryWhileNotFound(ctx, r.eventualConsistencyRetries, func() (*client.SensorHTTP, error) {
+		return r.client.GetSensorHTTP(ctx, created.HostID, created.ID)
+	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read HTTP sensor after creation, got error: %s", err))
+		addSensorHTTPCreateFollowUpWarning(&resp.Diagnostics, "read", err)
 		return
 	}
 
+	// Enable the sensor explicitly should the API not have created it enabled
+	if data.Enabled.ValueBool() && !sensor.Enabled {
+		err = r.client.EnableSensorHTTP(ctx, sensor.ID)
+		if err != nil {
+			addSensorHTTPCreateFollowUpWarning(&resp.Diagnostics, "enabled", err)
+			return
+		}
+		sensor.Enabled = true
+	}
+
 	// Set the computed ID in format <host_id>/<sensor_id>
-	data.ID = types.StringValue(fmt.Sprintf("%d/%d", sensor.HostID, sensor.ID))
+	data.ID = types.StringValue(formatSensorID(sensor.HostID, sensor.ID))
 	setSensorHTTPResourceModelFromAPI(&data, sensor)
+
+	// Let users confirm Wormly stored what they configured
+	tflog.Debug(ctx, "Created HTTP sensor", sensorHTTPParamLogFields(data))
+
 	applyKnownSensorHTTPPlanValues(&data, &plannedData)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// addSensorHTTPCreateFollowUpWarning reports a step after creation that failed.
+// The sensor exists and is already in state, so this is a warning rather than
+// an error: an error would taint the sensor and replace it on the next apply.
+func addSensorHTTPCreateFollowUpWarning(diags *diag.Diagnostics, step string, err error) {
+	diags.AddWarning(
+		"Incomplete HTTP Sensor Creation",
+		fmt.Sprintf("The HTTP sensor was created but could not be %s afterwards, got error: %s\n\n"+
+			"The sensor is kept in state. The next refresh reads it back from Wormly and the next plan corrects any difference.", step, err),
+	)
+}
+
 func (r *sensorHTTPResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data sensorHTTPResourceModel
 
@@ -346,11 +642,13 @@ func (r *sensorHTTPResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	// Get the sensor
-	sensor, err := r.client.GetSensorHTTP(ctx, hostID, sensorID)
+	// Get the sensor, allowing for a sensor that was only just created
+	sensor, err := retryWhileNotFound(ctx, r.eventualConsistencyRetries, func() (*client.SensorHTTP, error) {
+		return r.client.GetSensorHTTP(ctx, hostID, sensorID)
+	})
 	if err != nil {
-		// If sensor is not found (404), remove from state
-		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "not found") {
+		// The sensor was deleted outside Terraform, remove it from state so it is recreated
+		if isNotFoundError(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -359,9 +657,20 @@ func (r *sensorHTTPResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Update the model with the current state from API
-	previousSSLValidity := data.SSLValidity
+	recorded := data
 	setSensorHTTPResourceModelFromAPI(&data, sensor)
-	preserveReadValuesWhenAPIDoesNotReturnThem(&data, sensor, previousSSLValidity)
+	preserveReadValuesWhenAPIDoesNotReturnThem(&data, sensor, recorded)
+	readSSLMinExpiryDate(&data, recorded, time.Now())
+
+	// Explain which attributes will show up in the plan as changed
+	for _, diff := range diffSensorHTTPParams(recorded, data) {
+		tflog.Debug(ctx, "HTTP sensor attribute differs from the live API", map[string]interface{}{
+			"sensor_id":  data.ID.ValueString(),
+			"attribute":  diff.Attribute,
+			"configured": sensorHTTPParamLogValue(diff.Attribute, diff.Configured),
+			"live":       sensorHTTPParamLogValue(diff.Attribute, diff.Live),
+		})
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -377,25 +686,13 @@ func (r *sensorHTTPResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	// Parse the ID to get sensor information
-	_, _, err := parseSensorID(state.ID.ValueString())
+	// Parse the ID to get the HSID (which is the sensor ID from the client)
+	_, hsid, err := parseSensorID(state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse sensor ID: %s", err))
 		return
 	}
 
-	// Parse the sensor ID to get the HSID (which is the sensor ID from the client)
-	parts := strings.Split(state.ID.ValueString(), "/")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError("Parse Error", "Invalid sensor ID format")
-		return
-	}
-	hsid, err := strconv.Atoi(parts[1])
-	if err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Invalid sensor ID: %s", err))
-		return
-	}
-
 	// Check if enabled state changed
 	if !plan.Enabled.Equal(state.Enabled) {
 		if plan.Enabled.ValueBool() {
@@ -415,6 +712,15 @@ func (r *sensorHTTPResource) Update(ctx context.Context, req resource.UpdateRequ
 		}
 	}
 
+	// SSL verification is the only sensor setting the API can change in place
+	if !plan.VerifySSLCert.IsUnknown() && !plan.VerifySSLCert.Equal(state.VerifySSLCert) {
+		err = r.client.SetSensorHTTPVerifySSL(ctx, hsid, plan.VerifySSLCert.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update SSL verification of HTTP sensor, got error: %s", err))
+			return
+		}
+	}
+
 	// Use the plan values but preserve the ID from state
 	plan.ID = state.ID
 
@@ -447,39 +753,148 @@ func (r *sensorHTTPResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *sensorHTTPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := req.ID
+
+	// A bare sensor ID, as shown in the Wormly UI, is resolved to its host
+	if !strings.Contains(id, "/") {
+		sensorID, err := strconv.Atoi(id)
+		if err != nil {
+			resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Expected import identifier with format: host_id/sensor_id or sensor_id. Got: %s", req.ID))
+			return
+		}
+
+		hostID, err := r.client.FindSensorHost(ctx, sensorID)
+		if err != nil {
+			if isNotFoundError(err) {
+				resp.Diagnostics.AddError("Sensor Not Found",
+					fmt.Sprintf("No host has an HTTP sensor with ID %d. Check the ID in the Wormly UI, or import with host_id/sensor_id if you know the host.", sensorID))
+				return
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find the host of sensor %d, got error: %s", sensorID, err))
+			return
+		}
+
+		id = formatSensorID(hostID, sensorID)
+	}
+
 	// Parse the import ID to validate format
-	hostID, _, err := parseSensorID(req.ID)
+	hostID, _, err := parseSensorID(id)
 	if err != nil {
-		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Expected import identifier with format: host_id/sensor_id. Got: %s", req.ID))
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Expected import identifier with format: host_id/sensor_id or sensor_id. Got: %s", req.ID))
 		return
 	}
 
 	// Set the ID and host_id in state
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host_id"), int64(hostID))...)
 
 	// Trigger a read to populate the rest of the attributes
 	// The Read method will be called automatically after import
 }
 
-// parseSensorID parses a sensor ID in format "host_id/sensor_id" and returns the components.
-func parseSensorID(id string) (hostID int, sensorID int, err error) {
-	parts := strings.Split(id, "/")
-	if len(parts) != 2 {
-		return 0, 0, fmt.Errorf("invalid ID format, expected host_id/sensor_id")
+// MoveState supports moving the state of a wormly_http_sensor resource of this
+// provider, at any schema version, into wormly_sensor_http. Only the sensor
+// identity is carried over; the next refresh reads every other attribute from
+// the API, as after an import.
+func (r *sensorHTTPResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != legacySensorHTTPTypeName || !strings.HasSuffix(req.SourceProviderAddress, "radarnex/wormly") {
+					return
+				}
+				if req.SourceRawState == nil {
+					resp.Diagnostics.AddError("Move State Error", "The source state of "+legacySensorHTTPTypeName+" is empty.")
+					return
+				}
+
+				var source struct {
+					ID string `json:"id"`
+				}
+				if err := json.Unmarshal(req.SourceRawState.JSON, &source); err != nil {
+					resp.Diagnostics.AddError("Move State Error", fmt.Sprintf("Unable to decode the source state of %s: %s", legacySensorHTTPTypeName, err))
+					return
+				}
+
+				// The composite ID carries the host, so host_id is derived from it
+				hostID, sensorID, err := parseSensorID(source.ID)
+				if err != nil {
+					resp.Diagnostics.AddError("Move State Error", fmt.Sprintf("Unable to parse the sensor ID %q of %s: %s", source.ID, legacySensorHTTPTypeName, err))
+					return
+				}
+
+				resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("id"), formatSensorID(hostID, sensorID))...)
+				resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("host_id"), int64(hostID))...)
+			},
+		},
 	}
+}
 
-	hostID, err = strconv.Atoi(parts[0])
-	if err != nil {
-		return 0, 0, fmt.Errorf("invalid host_id: %s", err)
+// resolveHostID returns the ID of the only host named hostName.
+func (r *sensorHTTPResource) resolveHostID(ctx context.Context, hostName string) (int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if r.hosts == nil {
+		diags.AddAttributeError(path.Root("host_name"), "Host Lookup Unavailable",
+			"The provider client does not support listing hosts. Please report this issue to the provider developers.")
+		return 0, diags
 	}
 
-	sensorID, err = strconv.Atoi(parts[1])
+	hosts, err := r.hosts.ListHosts(ctx)
 	if err != nil {
-		return 0, 0, fmt.Errorf("invalid sensor_id: %s", err)
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list hosts to resolve host_name, got error: %s", err))
+		return 0, diags
+	}
+
+	var matches []int
+	for _, host := range hosts {
+		if host.Name == hostName {
+			matches = append(matches, host.ID)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddAttributeError(path.Root("host_name"), "Host Not Found",
+			fmt.Sprintf("No host is named %q. Check the name or use host_id instead.", hostName))
+	case 1:
+		return matches[0], diags
+	default:
+		diags.AddAttributeError(path.Root("host_name"), "Ambiguous Host Name",
+			fmt.Sprintf("%d hosts are named %q (IDs %v). Use host_id to choose one.", len(matches), hostName, matches))
+	}
+
+	return 0, diags
+}
+
+// hostIDFromStateUnlessHostNameChanges keeps a host_id resolved from host_name
+// across plans, and leaves it unknown when host_name changes so the new host is
+// resolved on create.
+type hostIDFromStateUnlessHostNameChanges struct{}
+
+func (m hostIDFromStateUnlessHostNameChanges) Description(_ context.Context) string {
+	return "Uses the prior host_id unless host_name changes."
+}
+
+func (m hostIDFromStateUnlessHostNameChanges) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m hostIDFromStateUnlessHostNameChanges) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
 	}
 
-	return hostID, sensorID, nil
+	var planned, prior types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("host_name"), &planned)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("host_name"), &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if planned.Equal(prior) {
+		resp.PlanValue = req.StateValue
+	}
 }
 
 func setSensorHTTPResourceModelFromAPI(data *sensorHTTPResourceModel, sensor *client.SensorHTTP) {
@@ -492,18 +907,167 @@ func setSensorHTTPResourceModelFromAPI(data *sensorHTTPResourceModel, sensor *cl
 	data.VerifySSLCert = types.BoolValue(sensor.VerifySSLCert)
 	data.SearchHeaders = types.BoolValue(sensor.SearchHeaders)
 	data.ExpectedText = types.StringValue(sensor.ExpectedText)
+	data.ExpectedTextIsRegex = types.BoolValue(sensor.ExpectedTextIsRegex)
 	data.UnwantedText = types.StringValue(sensor.UnwantedText)
 	data.SSLValidity = types.Int64Value(int64(sensor.SSLValidity))
 	data.Cookies = types.StringValue(sensor.Cookies)
+	data.HTTPMethod = types.StringValue(sensor.HTTPMethod)
 	data.PostParams = types.StringValue(sensor.PostParams)
+	data.PostContentType = types.StringValue(cmp.Or(sensor.PostContentType, client.SensorHTTPPostContentTypeForm))
 	data.CustomRequestHeaders = types.StringValue(sensor.CustomRequestHeaders)
+	data.RequestHeaders = requestHeadersValue(sensor.CustomRequestHeaders)
 	data.UserAgent = types.StringValue(sensor.UserAgent)
 	data.ForceResolve = types.StringValue(sensor.ForceResolve)
+	data.AuthUsername = types.StringValue(sensor.AuthUsername)
+	data.AuthPassword = types.StringValue(sensor.AuthPassword)
+	data.TestLocations = testLocationsValue(sensor.TestLocations)
+	data.FollowRedirects = types.BoolPointerValue(sensor.FollowRedirects)
+	data.AlertAfterFailures = types.Int64Null()
+	if sensor.AlertAfterFailures > 0 {
+		data.AlertAfterFailures = types.Int64Value(int64(sensor.AlertAfterFailures))
+	}
+}
+
+// sensorHTTPParamAttributes lists the HTTP sensor attributes compared against the live API, in schema order.
+var sensorHTTPParamAttributes = []string{
+	"url", "nice_name", "enabled", "timeout", "response_code", "verify_ssl_cert", "search_headers",
+	"expected_text", "expected_text_is_regex", "unwanted_text", "ssl_validity", "cookies", "http_method", "post_params", "post_content_type", "custom_request_headers",
+	"user_agent", "force_resolve", "auth_username", "auth_password", "test_locations",
+	"follow_redirects", "alert_after_failures",
+}
+
+// sensorHTTPSensitiveParamAttributes lists the attributes that commonly carry
+// credentials, such as session cookies, form passwords or Authorization headers.
+var sensorHTTPSensitiveParamAttributes = []string{"cookies", "post_params", "custom_request_headers", "auth_password"}
+
+// sensorHTTPParamLogValue formats the value of a compared attribute for logging.
+// Sensitive attributes are redacted whenever they are set, and credentials are
+// masked in every other value.
+func sensorHTTPParamLogValue(name string, value attr.Value) string {
+	if slices.Contains(sensorHTTPSensitiveParamAttributes, name) &&
+		!value.IsNull() && !value.IsUnknown() && !value.Equal(types.StringValue("")) {
+		return client.Redacted
+	}
+	return client.Redact(value.String())
+}
+
+// sensorHTTPParamLogFields returns the compared attributes of data as log fields.
+func sensorHTTPParamLogFields(data sensorHTTPResourceModel) map[string]interface{} {
+	fields := map[string]interface{}{"sensor_id": data.ID.ValueString()}
+	for name, value := range sensorHTTPParamValues(data) {
+		fields[name] = sensorHTTPParamLogValue(name, value)
+	}
+	return fields
+}
+
+// sensorHTTPParamDiff describes an attribute whose live value differs from the value recorded by Terraform.
+type sensorHTTPParamDiff struct {
+	Attribute  string
+	Configured attr.Value
+	Live       attr.Value
+}
+
+// sensorHTTPParamValues returns the compared attributes of data keyed by attribute name.
+func sensorHTTPParamValues(data sensorHTTPResourceModel) map[string]attr.Value {
+	return map[string]attr.Value{
+		"url":                    data.URL,
+		"nice_name":              data.NiceName,
+		"enabled":                data.Enabled,
+		"timeout":                data.Timeout,
+		"response_code":          data.ResponseCode,
+		"verify_ssl_cert":        data.VerifySSLCert,
+		"search_headers":         data.SearchHeaders,
+		"expected_text":          data.ExpectedText,
+		"expected_text_is_regex": data.ExpectedTextIsRegex,
+		"unwanted_text":          data.UnwantedText,
+		"ssl_validity":           data.SSLValidity,
+		"cookies":                data.Cookies,
+		"http_method":            data.HTTPMethod,
+		"post_params":            data.PostParams,
+		"post_content_type":      data.PostContentType,
+		"custom_request_headers": data.CustomRequestHeaders,
+		"user_agent":             data.UserAgent,
+		"force_resolve":          data.ForceResolve,
+		"auth_username":          data.AuthUsername,
+		"auth_password":          data.AuthPassword,
+		"test_locations":         data.TestLocations,
+		"follow_redirects":       data.FollowRedirects,
+		"alert_after_failures":   data.AlertAfterFailures,
+	}
+}
+
+// diffSensorHTTPParams compares the values Terraform recorded for a sensor with
+// its live values, field by field. Attributes without a recorded value, such as
+// right after import, are skipped since they cannot cause a diff.
+func diffSensorHTTPParams(configured, live sensorHTTPResourceModel) []sensorHTTPParamDiff {
+	configuredValues := sensorHTTPParamValues(configured)
+	liveValues := sensorHTTPParamValues(live)
+
+	var diffs []sensorHTTPParamDiff
+	for _, name := range sensorHTTPParamAttributes {
+		configuredValue := configuredValues[name]
+		if configuredValue.IsNull() || configuredValue.IsUnknown() {
+			continue
+		}
+		if !configuredValue.Equal(liveValues[name]) {
+			diffs = append(diffs, sensorHTTPParamDiff{
+				Attribute:  name,
+				Configured: configuredValue,
+				Live:       liveValues[name],
+			})
+		}
+	}
+
+	return diffs
 }
 
-func preserveReadValuesWhenAPIDoesNotReturnThem(data *sensorHTTPResourceModel, sensor *client.SensorHTTP, previousSSLValidity types.Int64) {
-	if sensor.SSLValidity == 0 && !previousSSLValidity.IsNull() && !previousSSLValidity.IsUnknown() && previousSSLValidity.ValueInt64() > 0 {
-		data.SSLValidity = previousSSLValidity
+// preserveReadValuesWhenAPIDoesNotReturnThem keeps the previous value of the
+// write-only parameters getHostSensors may not echo back, so they do not drift
+// to empty after apply. A parameter the API did return stays authoritative,
+// which keeps a genuine clear made outside Terraform detectable.
+func preserveReadValuesWhenAPIDoesNotReturnThem(data *sensorHTTPResourceModel, sensor *client.SensorHTTP, previous sensorHTTPResourceModel) {
+	if !sensor.ReturnedParams["sslvalidity"] && sensor.SSLValidity == 0 &&
+		!previous.SSLValidity.IsNull() && !previous.SSLValidity.IsUnknown() && previous.SSLValidity.ValueInt64() > 0 {
+		data.SSLValidity = previous.SSLValidity
+	}
+	if !sensor.ReturnedParams["postcontenttype"] && sensor.PostContentType == "" &&
+		!previous.PostContentType.IsNull() && !previous.PostContentType.IsUnknown() {
+		data.PostContentType = previous.PostContentType
+	}
+	if !sensor.ReturnedParams["expectedtextregex"] && !sensor.ExpectedTextIsRegex &&
+		!previous.ExpectedTextIsRegex.IsNull() && !previous.ExpectedTextIsRegex.IsUnknown() {
+		data.ExpectedTextIsRegex = previous.ExpectedTextIsRegex
+	}
+	if !sensor.ReturnedParams["followredirects"] && data.FollowRedirects.IsNull() &&
+		!previous.FollowRedirects.IsNull() && !previous.FollowRedirects.IsUnknown() {
+		data.FollowRedirects = previous.FollowRedirects
+	}
+	if !sensor.ReturnedParams["failsbeforenotify"] && data.AlertAfterFailures.IsNull() &&
+		!previous.AlertAfterFailures.IsNull() && !previous.AlertAfterFailures.IsUnknown() {
+		data.AlertAfterFailures = previous.AlertAfterFailures
+	}
+
+	preserveString := func(param string, value *types.String, previousValue types.String) {
+		if sensor.ReturnedParams[param] || value.ValueString() != "" {
+			return
+		}
+		if !previousValue.IsNull() && !previousValue.IsUnknown() && previousValue.ValueString() != "" {
+			*value = previousValue
+		}
+	}
+	preserveString("cookies", &data.Cookies, previous.Cookies)
+	preserveString("method", &data.HTTPMethod, previous.HTTPMethod)
+	preserveString("postparams", &data.PostParams, previous.PostParams)
+	preserveString("customrequestheaders", &data.CustomRequestHeaders, previous.CustomRequestHeaders)
+	// request_headers is the parsed form of customrequestheaders, so it follows the preserved value
+	data.RequestHeaders = requestHeadersValue(data.CustomRequestHeaders.ValueString())
+	preserveString("useragent", &data.UserAgent, previous.UserAgent)
+	preserveString("httpusername", &data.AuthUsername, previous.AuthUsername)
+	preserveString("httppassword", &data.AuthPassword, previous.AuthPassword)
+
+	if !sensor.ReturnedParams["locations"] && len(sensor.TestLocations) == 0 &&
+		!previous.TestLocations.IsNull() && !previous.TestLocations.IsUnknown() {
+		data.TestLocations = previous.TestLocations
 	}
 }
 
@@ -526,6 +1090,9 @@ func applyKnownSensorHTTPPlanValues(data *sensorHTTPResourceModel, plan *sensorH
 	if !plan.ExpectedText.IsUnknown() {
 		data.ExpectedText = plan.ExpectedText
 	}
+	if !plan.ExpectedTextIsRegex.IsUnknown() {
+		data.ExpectedTextIsRegex = plan.ExpectedTextIsRegex
+	}
 	if !plan.UnwantedText.IsUnknown() {
 		data.UnwantedText = plan.UnwantedText
 	}
@@ -535,16 +1102,50 @@ func applyKnownSensorHTTPPlanValues(data *sensorHTTPResourceModel, plan *sensorH
 	if !plan.Cookies.IsUnknown() {
 		data.Cookies = plan.Cookies
 	}
+	if !plan.HTTPMethod.IsUnknown() {
+		data.HTTPMethod = plan.HTTPMethod
+	}
 	if !plan.PostParams.IsUnknown() {
 		data.PostParams = plan.PostParams
 	}
+	if !plan.PostContentType.IsUnknown() {
+		data.PostContentType = plan.PostContentType
+	}
 	if !plan.CustomRequestHeaders.IsUnknown() {
 		data.CustomRequestHeaders = plan.CustomRequestHeaders
 	}
+	if !plan.RequestHeaders.IsUnknown() {
+		data.RequestHeaders = plan.RequestHeaders
+	}
 	if !plan.UserAgent.IsUnknown() {
 		data.UserAgent = plan.UserAgent
 	}
 	if !plan.ForceResolve.IsUnknown() {
 		data.ForceResolve = plan.ForceResolve
 	}
+	if !plan.AuthUsername.IsUnknown() {
+		data.AuthUsername = plan.AuthUsername
+	}
+	if !plan.AuthPassword.IsUnknown() {
+		data.AuthPassword = plan.AuthPassword
+	}
+	if !plan.TestLocations.IsUnknown() {
+		data.TestLocations = plan.TestLocations
+	}
+	if !plan.FollowRedirects.IsUnknown() {
+		data.FollowRedirects = plan.FollowRedirects
+	}
+	if !plan.AlertAfterFailures.IsUnknown() {
+		data.AlertAfterFailures = plan.AlertAfterFailures
+	}
+}
+
+// testLocationsValue converts test location codes into a test_locations set value.
+func testLocationsValue(locations []string) types.Set {
+	values := make([]attr.Value, 0, len(locations))
+	for _, location := range locations {
+		values = append(values, types.StringValue(location))
+	}
+
+	return types.SetValueMust(types.StringType, values)
 }
@@ -1,14 +1,25 @@
 package provider
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/radarnex/terraform-provider-wormly/internal/client"
@@ -286,6 +297,1007 @@ func TestSensorHTTPResource_ModelMapping(t *testing.T) {
 	assert.Equal(t, "1.2.3.4", model.ForceResolve.ValueString())
 }
 
+func TestSetSensorHTTPResourceModelFromAPI_FollowRedirects(t *testing.T) {
+	follow := false
+	tests := []struct {
+		name     string
+		follow   *bool
+		expected types.Bool
+	}{
+		{name: "set", follow: &follow, expected: types.BoolValue(false)},
+		{name: "API default", expected: types.BoolNull()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var data sensorHTTPResourceModel
+			setSensorHTTPResourceModelFromAPI(&data, &client.SensorHTTP{FollowRedirects: tt.follow})
+
+			assert.Equal(t, tt.expected, data.FollowRedirects)
+		})
+	}
+}
+
+func TestDiffSensorHTTPParams(t *testing.T) {
+	configured := sensorHTTPResourceModel{
+		URL:          types.StringValue("https://example.com"),
+		Timeout:      types.Int64Value(30),
+		ResponseCode: types.StringValue("200"),
+		ExpectedText: types.StringNull(),
+	}
+	live := configured
+	live.Timeout = types.Int64Value(29)
+	live.ExpectedText = types.StringValue("Welcome")
+
+	diffs := diffSensorHTTPParams(configured, live)
+
+	if assert.Len(t, diffs, 1) {
+		assert.Equal(t, "timeout", diffs[0].Attribute)
+		assert.Equal(t, types.Int64Value(30), diffs[0].Configured)
+		assert.Equal(t, types.Int64Value(29), diffs[0].Live)
+	}
+}
+
+func TestSensorHTTPResource_Read_LogsParamDiff(t *testing.T) {
+	mockClient := &client.MockSensorHTTPAPI{}
+	mockClient.On("GetSensorHTTP", mock.Anything, 123, 456).Return(&client.SensorHTTP{
+		ID:      456,
+		HostID:  123,
+		URL:     "https://example.com",
+		Enabled: true,
+		Timeout: 29,
+	}, nil)
+
+	r := &sensorHTTPResource{client: mockClient}
+	config := newSensorHTTPTestConfig(t, r, map[string]tftypes.Value{
+		"id":      tftypes.NewValue(tftypes.String, "123/456"),
+		"host_id": tftypes.NewValue(tftypes.Number, 123),
+		"url":     tftypes.NewValue(tftypes.String, "https://example.com"),
+		"enabled": tftypes.NewValue(tftypes.Bool, true),
+		"timeout": tftypes.NewValue(tftypes.Number, 30),
+	})
+	state := tfsdk.State{Schema: config.Schema, Raw: config.Raw}
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(t.Context(), &output)
+	resp := &frameworkresource.ReadResponse{State: state}
+
+	r.Read(ctx, frameworkresource.ReadRequest{State: state}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	assert.NoError(t, err)
+
+	var diffEntries []map[string]interface{}
+	for _, entry := range entries {
+		if entry["@message"] == "HTTP sensor attribute differs from the live API" {
+			diffEntries = append(diffEntries, entry)
+		}
+	}
+	if assert.Len(t, diffEntries, 1) {
+		assert.Equal(t, "timeout", diffEntries[0]["attribute"])
+		assert.Equal(t, "30", diffEntries[0]["configured"])
+		assert.Equal(t, "29", diffEntries[0]["live"])
+	}
+	mockClient.AssertExpectations(t)
+}
+
+func TestSensorHTTPResource_Read_RemovesDeletedSensor(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("cmd") {
+		case "getHostSensor":
+			fmt.Fprint(w, `{"errorcode": 1, "message": "Invalid hsid"}`)
+		case "getHostSensors":
+			// The host still exists but no longer lists sensor 456
+			fmt.Fprint(w, `{"errorcode": 0, "sensors": [{"hsid": "789", "sensorid": "1"}]}`)
+		default:
+			t.Errorf("Unexpected command %q", r.FormValue("cmd"))
+		}
+	}))
+	defer server.Close()
+
+	apiClient, err := client.New(client.Options{
+		HTTPClient:        &http.Client{Timeout: 30 * time.Second},
+		APIKey:            "test-api-key",
+		BaseURL:           server.URL,
+		UserAgent:         "test-agent/1.0",
+		RequestsPerSecond: 10.0,
+		MaxRetries:        3,
+		InitialBackoff:    time.Second,
+		BackoffMultiplier: 2.0,
+		MaxBackoff:        30 * time.Second,
+	})
+	assert.NoError(t, err)
+
+	r := &sensorHTTPResource{client: apiClient}
+	config := newSensorHTTPTestConfig(t, r, map[string]tftypes.Value{
+		"id":      tftypes.NewValue(tftypes.String, "123/456"),
+		"host_id": tftypes.NewValue(tftypes.Number, 123),
+		"url":     tftypes.NewValue(tftypes.String, "https://example.com"),
+	})
+	state := tfsdk.State{Schema: config.Schema, Raw: config.Raw}
+	resp := &frameworkresource.ReadResponse{State: state}
+
+	r.Read(t.Context(), frameworkresource.ReadRequest{State: state}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull(), "deleted sensor should be removed from state")
+}
+
+func TestSensorHTTPResource_MoveState(t *testing.T) {
+	tests := []struct {
+		name           string
+		sourceType     string
+		sourceProvider string
+		sourceState    string
+		expectMoved    bool
+		expectError    bool
+	}{
+		{
+			name:           "legacy type",
+			sourceType:     "wormly_http_sensor",
+			sourceProvider: "registry.terraform.io/radarnex/wormly",
+			sourceState:    `{"id": "123/456", "host_id": 123, "url": "https://example.com"}`,
+			expectMoved:    true,
+		},
+		{
+			name:           "other type",
+			sourceType:     "wormly_host",
+			sourceProvider: "registry.terraform.io/radarnex/wormly",
+			sourceState:    `{"id": "123"}`,
+		},
+		{
+			name:           "other provider",
+			sourceType:     "wormly_http_sensor",
+			sourceProvider: "registry.terraform.io/example/wormly",
+			sourceState:    `{"id": "123/456"}`,
+		},
+		{
+			name:           "invalid id",
+			sourceType:     "wormly_http_sensor",
+			sourceProvider: "registry.terraform.io/radarnex/wormly",
+			sourceState:    `{"id": "456"}`,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &sensorHTTPResource{}
+			movers := r.MoveState(t.Context())
+			if !assert.Len(t, movers, 1) {
+				return
+			}
+
+			target := newSensorHTTPTestConfig(t, r, nil)
+			resp := &frameworkresource.MoveStateResponse{
+				TargetState: tfsdk.State{Schema: target.Schema, Raw: tftypes.NewValue(target.Raw.Type(), nil)},
+			}
+			movers[0].StateMover(t.Context(), frameworkresource.MoveStateRequest{
+				SourceTypeName:        tt.sourceType,
+				SourceProviderAddress: tt.sourceProvider,
+				SourceRawState:        &tfprotov6.RawState{JSON: []byte(tt.sourceState)},
+			}, resp)
+
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+			if !tt.expectMoved {
+				assert.True(t, resp.TargetState.Raw.IsNull(), "state should not be moved")
+				return
+			}
+
+			var data sensorHTTPResourceModel
+			assert.False(t, resp.TargetState.Get(t.Context(), &data).HasError())
+			assert.Equal(t, "123/456", data.ID.ValueString())
+			assert.Equal(t, int64(123), data.HostID.ValueInt64())
+			assert.True(t, data.URL.IsNull(), "other attributes are read on the next refresh")
+		})
+	}
+}
+
+func TestSensorHTTPResource_Create_Enabled(t *testing.T) {
+	tests := []struct {
+		name          string
+		enabled       bool
+		readEnabled   bool
+		expectEnable  bool
+		expectDisable bool
+	}{
+		{name: "enabled by default", enabled: true, readEnabled: true},
+		{name: "created disabled by the API", enabled: true, readEnabled: false, expectEnable: true},
+		{name: "disabled", enabled: false, readEnabled: false, expectDisable: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &client.MockSensorHTTPAPI{}
+			mockClient.On("CreateSensorHTTP", mock.Anything, mock.Anything).Return(&client.SensorHTTP{ID: 456, HostID: 123}, nil)
+			mockClient.On("GetSensorHTTP", mock.Anything, 123, 456).Return(&client.SensorHTTP{
+				ID:      456,
+				HostID:  123,
+				URL:     "https://example.com",
+				Enabled: tt.readEnabled,
+			}, nil)
+			if tt.expectEnable {
+				mockClient.On("EnableSensorHTTP", mock.Anything, 456).Return(nil)
+			}
+			if tt.expectDisable {
+				mockClient.On("DisableSensorHTTP", mock.Anything, 456).Return(nil)
+			}
+
+			r := &sensorHTTPResource{client: mockClient}
+			config := newSensorHTTPTestConfig(t, r, map[string]tftypes.Value{
+				"host_id": tftypes.NewValue(tftypes.Number, 123),
+				"url":     tftypes.NewValue(tftypes.String, "https://example.com"),
+				"enabled": tftypes.NewValue(tftypes.Bool, tt.enabled),
+			})
+			resp := &frameworkresource.CreateResponse{State: tfsdk.State{Schema: config.Schema}}
+
+			r.Create(t.Context(), frameworkresource.CreateRequest{
+				Plan: tfsdk.Plan{Schema: config.Schema, Raw: config.Raw},
+			}, resp)
+
+			assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+
+			var state sensorHTTPResourceModel
+			assert.False(t, resp.State.Get(t.Context(), &state).HasError())
+			assert.Equal(t, tt.enabled, state.Enabled.ValueBool())
+			if !tt.expectEnable {
+				mockClient.AssertNotCalled(t, "EnableSensorHTTP", mock.Anything, mock.Anything)
+			}
+			if !tt.expectDisable {
+				mockClient.AssertNotCalled(t, "DisableSensorHTTP", mock.Anything, mock.Anything)
+			}
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSensorHTTPResource_Create_FollowUpFailureKeepsSensor(t *testing.T) {
+	tests := []struct {
+		name    string
+		enabled bool
+		setup   func(m *client.MockSensorHTTPAPI)
+	}{
+		{
+			name:    "enable fails",
+			enabled: true,
+			setup: func(m *client.MockSensorHTTPAPI) {
+				m.On("GetSensorHTTP", mock.Anything, 123, 456).Return(&client.SensorHTTP{ID: 456, HostID: 123, URL: "https://example.com"}, nil)
+				m.On("EnableSensorHTTP", mock.Anything, 456).Return(errors.New("service unavailable"))
+			},
+		},
+		{
+			name:    "disable fails",
+			enabled: false,
+			setup: func(m *client.MockSensorHTTPAPI) {
+				m.On("DisableSensorHTTP", mock.Anything, 456).Return(errors.New("service unavailable"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &client.MockSensorHTTPAPI{}
+			mockClient.On("CreateSensorHTTP", mock.Anything, mock.Anything).Return(&client.SensorHTTP{
+				ID:      456,
+				HostID:  123,
+				URL:     "https://example.com",
+				Enabled: true,
+				Timeout: 30,
+			}, nil)
+			tt.setup(mockClient)
+
+			r := &sensorHTTPResource{client: mockClient}
+			config := newSensorHTTPTestConfig(t, r, map[string]tftypes.Value{
+				"host_id": tftypes.NewValue(tftypes.Number, 123),
+				"url":     tftypes.NewValue(tftypes.String, "https://example.com"),
+				"enabled": tftypes.NewValue(tftypes.Bool, tt.enabled),
+				// Unset computed attributes are unknown until the create response fills them in
+				"timeout": tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			})
+			resp := &frameworkresource.CreateResponse{State: tfsdk.State{Schema: config.Schema}}
+
+			r.Create(t.Context(), frameworkresource.CreateRequest{
+				Plan: tfsdk.Plan{Schema: config.Schema, Raw: config.Raw},
+			}, resp)
+
+			assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+			if assert.Equal(t, 1, resp.Diagnostics.WarningsCount()) {
+				assert.Equal(t, "Incomplete HTTP Sensor Creation", resp.Diagnostics.Warnings()[0].Summary())
+				assert.Contains(t, resp.Diagnostics.Warnings()[0].Detail(), "service unavailable")
+			}
+
+			var state sensorHTTPResourceModel
+			assert.False(t, resp.State.Get(t.Context(), &state).HasError())
+			assert.Equal(t, "123/456", state.ID.ValueString())
+			assert.Equal(t, tt.enabled, state.Enabled.ValueBool())
+			assert.Equal(t, int64(30), state.Timeout.ValueInt64())
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSensorHTTPResource_Create_RequestHeaders(t *testing.T) {
+	mockClient := &client.MockSensorHTTPAPI{}
+	mockClient.On("CreateSensorHTTP", mock.Anything, mock.MatchedBy(func(req *client.SensorHTTPCreateRequest) bool {
+		return req.CustomRequestHeaders == "X-Env: prod\nX-Team: web"
+	})).Return(&client.SensorHTTP{ID: 456, HostID: 123, URL: "https://example.com", Enabled: true}, nil)
+	// The API echoes the headers with CRLF line endings
+	mockClient.On("GetSensorHTTP", mock.Anything, 123, 456).Return(&client.SensorHTTP{
+		ID:                   456,
+		HostID:               123,
+		URL:                  "https://example.com",
+		Enabled:              true,
+		CustomRequestHeaders: "X-Env: prod\r\nX-Team: web",
+	}, nil)
+
+	r := &sensorHTTPResource{client: mockClient}
+	config := newSensorHTTPTestConfig(t, r, map[string]tftypes.Value{
+		"host_id": tftypes.NewValue(tftypes.Number, 123),
+		"url":     tftypes.NewValue(tftypes.String, "https://example.com"),
+		"enabled": tftypes.NewValue(tftypes.Bool, true),
+		"request_headers": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"X-Team": tftypes.NewValue(tftypes.String, "web"),
+			"X-Env":  tftypes.NewValue(tftypes.String, "prod"),
+		}),
+		"custom_request_headers": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+	resp := &frameworkresource.CreateResponse{State: tfsdk.State{Schema: config.Schema}}
+
+	r.Create(t.Context(), frameworkresource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: config.Schema, Raw: config.Raw},
+	}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+	var state sensorHTTPResourceModel
+	assert.False(t, resp.State.Get(t.Context(), &state).HasError())
+	var headers map[string]string
+	assert.False(t, state.RequestHeaders.ElementsAs(t.Context(), &headers, false).HasError())
+	assert.Equal(t, map[string]string{"X-Env": "prod", "X-Team": "web"}, headers)
+	assert.Equal(t, "X-Env: prod\r\nX-Team: web", state.CustomRequestHeaders.ValueString())
+	mockClient.AssertExpectations(t)
+}
+
+func TestSensorHTTPResource_Create_SSLMinExpiryDate(t *testing.T) {
+	minExpiryDate := time.Now().UTC().AddDate(0, 0, 30).Format(time.DateOnly)
+
+	mockClient := &client.MockSensorHTTPAPI{}
+	mockClient.On("CreateSensorHTTP", mock.Anything, mock.MatchedBy(func(req *client.SensorHTTPCreateRequest) bool {
+		return req.SSLValidity == 30
+	})).Return(&client.SensorHTTP{ID: 456, HostID: 123, URL: "https://example.com", Enabled: true, SSLValidity: 30}, nil)
+	mockClient.On("GetSensorHTTP", mock.Anything, 123, 456).Return(&client.SensorHTTP{
+		ID:          456,
+		HostID:      123,
+		URL:         "https://example.com",
+		Enabled:     true,
+		SSLValidity: 30,
+	}, nil)
+
+	r := &sensorHTTPResource{client: mockClient}
+	config := newSensorHTTPTestConfig(t, r, map[string]tftypes.Value{
+		"host_id":             tftypes.NewValue(tftypes.Number, 123),
+		"url":                 tftypes.NewValue(tftypes.String, "https://example.com"),
+		"enabled":             tftypes.NewValue(tftypes.Bool, true),
+		"ssl_min_expiry_date": tftypes.NewValue(tftypes.String, minExpiryDate),
+		"ssl_validity":        tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+	})
+	resp := &frameworkresource.CreateResponse{State: tfsdk.State{Schema: config.Schema}}
+
+	r.Create(t.Context(), frameworkresource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: config.Schema, Raw: config.Raw},
+	}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+	var state sensorHTTPResourceModel
+	assert.False(t, resp.State.Get(t.Context(), &state).HasError())
+	assert.Equal(t, int64(30), state.SSLValidity.ValueInt64())
+	assert.Equal(t, minExpiryDate, state.SSLMinExpiryDate.ValueString())
+	mockClient.AssertExpectations(t)
+}
+
+func TestSensorHTTPResource_Create_PostContentType(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType tftypes.Value
+		sent        string
+		expected    string
+	}{
+		{
+			name:        "json",
+			contentType: tftypes.NewValue(tftypes.String, "application/json"),
+			sent:        "application/json",
+			expected:    "application/json",
+		},
+		{
+			// Unset attributes are unknown in the plan; the API default fills them in
+			name:        "unset",
+			contentType: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected:    "application/x-www-form-urlencoded",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &client.MockSensorHTTPAPI{}
+			mockClient.On("CreateSensorHTTP", mock.Anything, mock.MatchedBy(func(req *client.SensorHTTPCreateRequest) bool {
+				return req.PostContentType == tt.sent && req.PostParams == `{"probe": true}`
+			})).Return(&client.SensorHTTP{ID: 456, HostID: 123, URL: "https://example.com", Enabled: true}, nil)
+			mockClient.On("GetSensorHTTP", mock.Anything, 123, 456).Return(&client.SensorHTTP{
+				ID:              456,
+				HostID:          123,
+				URL:             "https://example.com",
+				Enabled:         true,
+				PostParams:      `{"probe": true}`,
+				PostContentType: tt.sent,
+			}, nil)
+
+			r := &sensorHTTPResource{client: mockClient}
+			config := newSensorHTTPTestConfig(t, r, map[string]tftypes.Value{
+				"host_id":           tftypes.NewValue(tftypes.Number, 123),
+				"url":               tftypes.NewValue(tftypes.String, "https://example.com"),
+				"enabled":           tftypes.NewValue(tftypes.Bool, true),
+				"post_params":       tftypes.NewValue(tftypes.String, `{"probe": true}`),
+				"post_content_type": tt.contentType,
+			})
+			resp := &frameworkresource.CreateResponse{State: tfsdk.State{Schema: config.Schema}}
+
+			r.Create(t.Context(), frameworkresource.CreateRequest{
+				Plan: tfsdk.Plan{Schema: config.Schema, Raw: config.Raw},
+			}, resp)
+
+			assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+			var state sensorHTTPResourceModel
+			assert.False(t, resp.State.Get(t.Context(), &state).HasError())
+			assert.Equal(t, tt.expected, state.PostContentType.ValueString())
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSensorHTTPResource_Create_TestLocations(t *testing.T) {
+	mockClient := &client.MockSensorHTTPAPI{}
+	mockClient.On("CreateSensorHTTP", mock.Anything, mock.MatchedBy(func(req *client.SensorHTTPCreateRequest) bool {
+		return assert.ElementsMatch(t, []string{"lon", "nyc"}, req.TestLocations)
+	})).Return(&client.SensorHTTP{ID: 456, HostID: 123}, nil)
+	mockClient.On("GetSensorHTTP", mock.Anything, 123, 456).Return(&client.SensorHTTP{
+		ID:             456,
+		HostID:         123,
+		URL:            "https://example.com",
+		Enabled:        true,
+		TestLocations:  []string{"nyc", "lon"},
+		ReturnedParams: map[string]bool{"locations": true},
+	}, nil)
+
+	r := &sensorHTTPResource{client: mockClient}
+	config := newSensorHTTPTestConfig(t, r, map[string]tftypes.Value{
+		"host_id": tftypes.NewValue(tftypes.Number, 123),
+		"url":     tftypes.NewValue(tftypes.String, "https://example.com"),
+		"enabled": tftypes.NewValue(tftypes.Bool, true),
+		"test_locations": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "lon"),
+			tftypes.NewValue(tftypes.String, "nyc"),
+		}),
+	})
+	resp := &frameworkresource.CreateResponse{State: tfsdk.State{Schema: config.Schema}}
+
+	r.Create(t.Context(), frameworkresource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: config.Schema, Raw: config.Raw},
+	}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+
+	var state sensorHTTPResourceModel
+	assert.False(t, resp.State.Get(t.Context(), &state).HasError())
+	assert.True(t, testLocationsValue([]string{"lon", "nyc"}).Equal(state.TestLocations), "test_locations: %s", state.TestLocations)
+	mockClient.AssertExpectations(t)
+}
+
+func TestSensorHTTPResource_ModifyPlan_WarnsOnReplacement(t *testing.T) {
+	tests := []struct {
+		name          string
+		priorTimeout  int64
+		planTimeout   int64
+		create        bool
+		expectWarning bool
+	}{
+		{name: "timeout changed", priorTimeout: 30, planTimeout: 60, expectWarning: true},
+		{name: "timeout unchanged", priorTimeout: 30, planTimeout: 30},
+		{name: "create", planTimeout: 60, create: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &sensorHTTPResource{}
+			values := func(timeout int64) map[string]tftypes.Value {
+				return map[string]tftypes.Value{
+					"id":      tftypes.NewValue(tftypes.String, "123/456"),
+					"host_id": tftypes.NewValue(tftypes.Number, 123),
+					"url":     tftypes.NewValue(tftypes.String, "https://example.com"),
+					"enabled": tftypes.NewValue(tftypes.Bool, true),
+					"timeout": tftypes.NewValue(tftypes.Number, timeout),
+				}
+			}
+			prior := newSensorHTTPTestConfig(t, r, values(tt.priorTimeout))
+			if tt.create {
+				prior.Raw = tftypes.NewValue(prior.Raw.Type(), nil)
+			}
+			planned := newSensorHTTPTestConfig(t, r, values(tt.planTimeout))
+			state := tfsdk.State{Schema: prior.Schema, Raw: prior.Raw}
+			plan := tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw}
+
+			// Run the timeout plan modifiers as Terraform does before ModifyPlan
+			var priorTimeout, plannedTimeout types.Int64
+			assert.False(t, state.GetAttribute(t.Context(), path.Root("timeout"), &priorTimeout).HasError())
+			assert.False(t, plan.GetAttribute(t.Context(), path.Root("timeout"), &plannedTimeout).HasError())
+			timeoutAttribute, ok := planned.Schema.GetAttributes()["timeout"].(schema.Int64Attribute)
+			if !assert.True(t, ok, "timeout should be an Int64 attribute") {
+				return
+			}
+			resp := &frameworkresource.ModifyPlanResponse{Plan: plan}
+			for _, modifier := range timeoutAttribute.PlanModifiers {
+				modifierResp := &planmodifier.Int64Response{PlanValue: plannedTimeout}
+				modifier.PlanModifyInt64(t.Context(), planmodifier.Int64Request{
+					Path:        path.Root("timeout"),
+					Config:      planned,
+					ConfigValue: plannedTimeout,
+					Plan:        plan,
+					PlanValue:   plannedTimeout,
+					State:       state,
+					StateValue:  priorTimeout,
+				}, modifierResp)
+				if modifierResp.RequiresReplace {
+					resp.RequiresReplace = append(resp.RequiresReplace, path.Root("timeout"))
+				}
+			}
+
+			r.ModifyPlan(t.Context(), frameworkresource.ModifyPlanRequest{State: state, Plan: plan, Config: planned}, resp)
+
+			assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+			if !tt.expectWarning {
+				assert.Empty(t, resp.Diagnostics.Warnings())
+				return
+			}
+			if assert.Len(t, resp.Diagnostics.Warnings(), 1) {
+				warning := resp.Diagnostics.Warnings()[0]
+				assert.Equal(t, "HTTP Sensor Will Be Replaced", warning.Summary())
+				assert.Contains(t, warning.Detail(), "Changing `timeout` replaces this HTTP sensor")
+				assert.Contains(t, warning.Detail(), "losing its check history")
+			}
+		})
+	}
+}
+
+func TestSensorHTTPResource_Update_VerifySSLCert(t *testing.T) {
+	tests := []struct {
+		name         string
+		state        bool
+		plan         bool
+		expectUpdate bool
+	}{
+		{name: "unchanged", state: true, plan: true},
+		{name: "disabled", state: true, plan: false, expectUpdate: true},
+		{name: "enabled", state: false, plan: true, expectUpdate: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &client.MockSensorHTTPAPI{}
+			if tt.expectUpdate {
+				mockClient.On("SetSensorHTTPVerifySSL", mock.Anything, 456, tt.plan).Return(nil)
+			}
+
+			r := &sensorHTTPResource{client: mockClient}
+			values := func(verify bool) map[string]tftypes.Value {
+				return map[string]tftypes.Value{
+					"id":              tftypes.NewValue(tftypes.String, "123/456"),
+					"host_id":         tftypes.NewValue(tftypes.Number, 123),
+					"url":             tftypes.NewValue(tftypes.String, "https://example.com"),
+					"enabled":         tftypes.NewValue(tftypes.Bool, true),
+					"verify_ssl_cert": tftypes.NewValue(tftypes.Bool, verify),
+				}
+			}
+			prior := newSensorHTTPTestConfig(t, r, values(tt.state))
+			planned := newSensorHTTPTestConfig(t, r, values(tt.plan))
+			resp := &frameworkresource.UpdateResponse{State: tfsdk.State{Schema: prior.Schema, Raw: prior.Raw}}
+
+			r.Update(t.Context(), frameworkresource.UpdateRequest{
+				Plan:  tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw},
+				State: tfsdk.State{Schema: prior.Schema, Raw: prior.Raw},
+			}, resp)
+
+			assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+			mockClient.AssertExpectations(t)
+			if !tt.expectUpdate {
+				mockClient.AssertNotCalled(t, "SetSensorHTTPVerifySSL", mock.Anything, mock.Anything, mock.Anything)
+			}
+
+			var data sensorHTTPResourceModel
+			assert.False(t, resp.State.Get(t.Context(), &data).HasError())
+			assert.Equal(t, tt.plan, data.VerifySSLCert.ValueBool())
+		})
+	}
+}
+
+func TestSensorHTTPResource_ImportState(t *testing.T) {
+	tests := []struct {
+		name           string
+		importID       string
+		findHostErr    error
+		expectedID     string
+		expectedHostID int64
+		expectedError  string
+	}{
+		{
+			name:           "composite ID",
+			importID:       "123/456",
+			expectedID:     "123/456",
+			expectedHostID: 123,
+		},
+		{
+			name:           "bare sensor ID resolves its host",
+			importID:       "456",
+			expectedID:     "123/456",
+			expectedHostID: 123,
+		},
+		{
+			name:          "unknown sensor ID",
+			importID:      "456",
+			findHostErr:   fmt.Errorf("HTTP sensor with ID 456 %w on any of 2 hosts", client.ErrNotFound),
+			expectedError: "Sensor Not Found",
+		},
+		{
+			name:          "invalid ID",
+			importID:      "homepage",
+			expectedError: "Import Error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &client.MockSensorHTTPAPI{}
+			mockClient.On("FindSensorHost", mock.Anything, 456).Return(123, tt.findHostErr)
+
+			r := &sensorHTTPResource{client: mockClient}
+			schemaResp := &frameworkresource.SchemaResponse{}
+			r.Schema(t.Context(), frameworkresource.SchemaRequest{}, schemaResp)
+			resp := &frameworkresource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(t.Context()), nil),
+				},
+			}
+
+			r.ImportState(t.Context(), frameworkresource.ImportStateRequest{ID: tt.importID}, resp)
+
+			if tt.expectedError != "" {
+				if assert.True(t, resp.Diagnostics.HasError()) {
+					assert.Equal(t, tt.expectedError, resp.Diagnostics.Errors()[0].Summary())
+				}
+				return
+			}
+			assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+
+			var id types.String
+			var hostID types.Int64
+			resp.Diagnostics.Append(resp.State.GetAttribute(t.Context(), path.Root("id"), &id)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(t.Context(), path.Root("host_id"), &hostID)...)
+			assert.Equal(t, tt.expectedID, id.ValueString())
+			assert.Equal(t, tt.expectedHostID, hostID.ValueInt64())
+		})
+	}
+}
+
+func TestSensorHTTPResource_Create_HostName(t *testing.T) {
+	hosts := []client.Host{
+		{ID: 122, Name: "db"},
+		{ID: 123, Name: "web"},
+		{ID: 124, Name: "worker"},
+		{ID: 125, Name: "worker"},
+	}
+
+	tests := []struct {
+		name           string
+		hostName       string
+		expectedHostID int64
+		expectError    string
+	}{
+		{
+			name:           "single match",
+			hostName:       "web",
+			expectedHostID: 123,
+		},
+		{
+			name:        "no match",
+			hostName:    "missing",
+			expectError: "Host Not Found",
+		},
+		{
+			name:        "multiple matches",
+			hostName:    "worker",
+			expectError: "Ambiguous Host Name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hostClient := &client.MockHostAPI{}
+			hostClient.On("ListHosts", mock.Anything).Return(hosts, nil)
+
+			sensorClient := &client.MockSensorHTTPAPI{}
+			if tt.expectError == "" {
+				sensorClient.On("CreateSensorHTTP", mock.Anything, mock.MatchedBy(func(req *client.SensorHTTPCreateRequest) bool {
+					return req.HostID == int(tt.expectedHostID)
+				})).Return(&client.SensorHTTP{ID: 456, HostID: int(tt.expectedHostID)}, nil)
+				sensorClient.On("DisableSensorHTTP", mock.Anything, 456).Return(nil)
+				sensorClient.On("GetSensorHTTP", mock.Anything, int(tt.expectedHostID), 456).Return(&client.SensorHTTP{
+					ID:     456,
+					HostID: int(tt.expectedHostID),
+					URL:    "https://example.com",
+				}, nil)
+			}
+
+			r := &sensorHTTPResource{client: sensorClient, hosts: hostClient}
+			config := newSensorHTTPTestConfig(t, r, map[string]tftypes.Value{
+				"host_name": tftypes.NewValue(tftypes.String, tt.hostName),
+				"url":       tftypes.NewValue(tftypes.String, "https://example.com"),
+			})
+			resp := &frameworkresource.CreateResponse{State: tfsdk.State{Schema: config.Schema}}
+
+			r.Create(t.Context(), frameworkresource.CreateRequest{
+				Plan: tfsdk.Plan{Schema: config.Schema, Raw: config.Raw},
+			}, resp)
+
+			if tt.expectError != "" {
+				if assert.Equal(t, 1, resp.Diagnostics.ErrorsCount(), "diagnostics: %v", resp.Diagnostics) {
+					assert.Equal(t, tt.expectError, resp.Diagnostics.Errors()[0].Summary())
+				}
+				sensorClient.AssertNotCalled(t, "CreateSensorHTTP", mock.Anything, mock.Anything)
+				return
+			}
+
+			assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+
+			var state sensorHTTPResourceModel
+			resp.Diagnostics.Append(resp.State.Get(t.Context(), &state)...)
+			assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+			assert.Equal(t, tt.expectedHostID, state.HostID.ValueInt64())
+			assert.Equal(t, tt.hostName, state.HostName.ValueString())
+			assert.Equal(t, fmt.Sprintf("%d/456", tt.expectedHostID), state.ID.ValueString())
+			sensorClient.AssertExpectations(t)
+			hostClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHostIDFromStateUnlessHostNameChanges(t *testing.T) {
+	tests := []struct {
+		name          string
+		plannedName   string
+		expectedValue types.Int64
+	}{
+		{
+			name:          "host name unchanged",
+			plannedName:   "web",
+			expectedValue: types.Int64Value(123),
+		},
+		{
+			name:          "host name changed",
+			plannedName:   "db",
+			expectedValue: types.Int64Unknown(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &sensorHTTPResource{}
+			plan := newSensorHTTPTestConfig(t, r, map[string]tftypes.Value{
+				"host_id":   tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+				"host_name": tftypes.NewValue(tftypes.String, tt.plannedName),
+			})
+			state := newSensorHTTPTestConfig(t, r, map[string]tftypes.Value{
+				"host_id":   tftypes.NewValue(tftypes.Number, 123),
+				"host_name": tftypes.NewValue(tftypes.String, "web"),
+			})
+
+			req := planmodifier.Int64Request{
+				Path:        path.Root("host_id"),
+				ConfigValue: types.Int64Null(),
+				PlanValue:   types.Int64Unknown(),
+				StateValue:  types.Int64Value(123),
+				Plan:        tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+				State:       tfsdk.State{Schema: state.Schema, Raw: state.Raw},
+			}
+			resp := &planmodifier.Int64Response{PlanValue: req.PlanValue}
+
+			hostIDFromStateUnlessHostNameChanges{}.PlanModifyInt64(t.Context(), req, resp)
+
+			assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+			assert.Equal(t, tt.expectedValue, resp.PlanValue)
+		})
+	}
+}
+
+func TestSensorHTTPResource_Create_LogsStoredParams(t *testing.T) {
+	mockClient := &client.MockSensorHTTPAPI{}
+	mockClient.On("CreateSensorHTTP", mock.Anything, mock.Anything).Return(&client.SensorHTTP{ID: 456, HostID: 123}, nil)
+	mockClient.On("DisableSensorHTTP", mock.Anything, 456).Return(nil)
+	mockClient.On("GetSensorHTTP", mock.Anything, 123, 456).Return(&client.SensorHTTP{
+		ID:                   456,
+		HostID:               123,
+		URL:                  "https://example.com/status?key=url-secret",
+		Timeout:              30,
+		Cookies:              "session=cookie-secret",
+		PostParams:           "password=form-secret",
+		CustomRequestHeaders: "Authorization: Bearer header-secret",
+		UserAgent:            "monitor/1.0",
+	}, nil)
+
+	r := &sensorHTTPResource{client: mockClient}
+	config := newSensorHTTPTestConfig(t, r, map[string]tftypes.Value{
+		"host_id": tftypes.NewValue(tftypes.Number, 123),
+		"url":     tftypes.NewValue(tftypes.String, "https://example.com/status?key=url-secret"),
+	})
+	resp := &frameworkresource.CreateResponse{State: tfsdk.State{Schema: config.Schema}}
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(t.Context(), &output)
+	r.Create(ctx, frameworkresource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: config.Schema, Raw: config.Raw},
+	}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+	for _, secret := range []string{"url-secret", "cookie-secret", "form-secret", "header-secret"} {
+		assert.NotContains(t, output.String(), secret)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	assert.NoError(t, err)
+
+	var summary map[string]interface{}
+	for _, entry := range entries {
+		if entry["@message"] == "Created HTTP sensor" {
+			summary = entry
+		}
+	}
+	if assert.NotNil(t, summary, "expected a post-create summary") {
+		assert.Equal(t, "123/456", summary["sensor_id"])
+		assert.Equal(t, `"https://example.com/status?key=[REDACTED]"`, summary["url"])
+		assert.Equal(t, "30", summary["timeout"])
+		assert.Equal(t, `"monitor/1.0"`, summary["user_agent"])
+		assert.Equal(t, client.Redacted, summary["cookies"])
+		assert.Equal(t, client.Redacted, summary["post_params"])
+		assert.Equal(t, client.Redacted, summary["custom_request_headers"])
+		assert.Equal(t, `""`, summary["expected_text"])
+	}
+	mockClient.AssertExpectations(t)
+}
+
+func TestPreserveReadValuesWhenAPIDoesNotReturnThem(t *testing.T) {
+	fields := []struct {
+		param    string
+		previous func(*sensorHTTPResourceModel)
+		value    func(sensorHTTPResourceModel) attr.Value
+		expected attr.Value
+	}{
+		{
+			param:    "cookies",
+			previous: func(m *sensorHTTPResourceModel) { m.Cookies = types.StringValue("session=abc") },
+			value:    func(m sensorHTTPResourceModel) attr.Value { return m.Cookies },
+			expected: types.StringValue("session=abc"),
+		},
+		{
+			param:    "method",
+			previous: func(m *sensorHTTPResourceModel) { m.HTTPMethod = types.StringValue("HEAD") },
+			value:    func(m sensorHTTPResourceModel) attr.Value { return m.HTTPMethod },
+			expected: types.StringValue("HEAD"),
+		},
+		{
+			param:    "postcontenttype",
+			previous: func(m *sensorHTTPResourceModel) { m.PostContentType = types.StringValue("application/json") },
+			value:    func(m sensorHTTPResourceModel) attr.Value { return m.PostContentType },
+			expected: types.StringValue("application/json"),
+		},
+		{
+			param:    "postparams",
+			previous: func(m *sensorHTTPResourceModel) { m.PostParams = types.StringValue("probe=1") },
+			value:    func(m sensorHTTPResourceModel) attr.Value { return m.PostParams },
+			expected: types.StringValue("probe=1"),
+		},
+		{
+			param:    "customrequestheaders",
+			previous: func(m *sensorHTTPResourceModel) { m.CustomRequestHeaders = types.StringValue("X-Probe: 1") },
+			value:    func(m sensorHTTPResourceModel) attr.Value { return m.CustomRequestHeaders },
+			expected: types.StringValue("X-Probe: 1"),
+		},
+		{
+			param:    "useragent",
+			previous: func(m *sensorHTTPResourceModel) { m.UserAgent = types.StringValue("probe/1.0") },
+			value:    func(m sensorHTTPResourceModel) attr.Value { return m.UserAgent },
+			expected: types.StringValue("probe/1.0"),
+		},
+		{
+			param:    "httpusername",
+			previous: func(m *sensorHTTPResourceModel) { m.AuthUsername = types.StringValue("monitor") },
+			value:    func(m sensorHTTPResourceModel) attr.Value { return m.AuthUsername },
+			expected: types.StringValue("monitor"),
+		},
+		{
+			param:    "httppassword",
+			previous: func(m *sensorHTTPResourceModel) { m.AuthPassword = types.StringValue("s3cret") },
+			value:    func(m sensorHTTPResourceModel) attr.Value { return m.AuthPassword },
+			expected: types.StringValue("s3cret"),
+		},
+		{
+			param:    "sslvalidity",
+			previous: func(m *sensorHTTPResourceModel) { m.SSLValidity = types.Int64Value(14) },
+			value:    func(m sensorHTTPResourceModel) attr.Value { return m.SSLValidity },
+			expected: types.Int64Value(14),
+		},
+		{
+			param:    "locations",
+			previous: func(m *sensorHTTPResourceModel) { m.TestLocations = testLocationsValue([]string{"lon"}) },
+			value:    func(m sensorHTTPResourceModel) attr.Value { return m.TestLocations },
+			expected: testLocationsValue([]string{"lon"}),
+		},
+		{
+			param:    "expectedtextregex",
+			previous: func(m *sensorHTTPResourceModel) { m.ExpectedTextIsRegex = types.BoolValue(true) },
+			value:    func(m sensorHTTPResourceModel) attr.Value { return m.ExpectedTextIsRegex },
+			expected: types.BoolValue(true),
+		},
+		{
+			param:    "followredirects",
+			previous: func(m *sensorHTTPResourceModel) { m.FollowRedirects = types.BoolValue(true) },
+			value:    func(m sensorHTTPResourceModel) attr.Value { return m.FollowRedirects },
+			expected: types.BoolValue(true),
+		},
+		{
+			param:    "failsbeforenotify",
+			previous: func(m *sensorHTTPResourceModel) { m.AlertAfterFailures = types.Int64Value(3) },
+			value:    func(m sensorHTTPResourceModel) attr.Value { return m.AlertAfterFailures },
+			expected: types.Int64Value(3),
+		},
+	}
+
+	for _, field := range fields {
+		t.Run(field.param+" not returned is preserved", func(t *testing.T) {
+			var previous sensorHTTPResourceModel
+			field.previous(&previous)
+			sensor := &client.SensorHTTP{ReturnedParams: map[string]bool{"url": true}}
+
+			var data sensorHTTPResourceModel
+			setSensorHTTPResourceModelFromAPI(&data, sensor)
+			preserveReadValuesWhenAPIDoesNotReturnThem(&data, sensor, previous)
+
+			assert.Equal(t, field.expected, field.value(data))
+		})
+
+		t.Run(field.param+" returned empty is cleared", func(t *testing.T) {
+			var previous sensorHTTPResourceModel
+			field.previous(&previous)
+			sensor := &client.SensorHTTP{ReturnedParams: map[string]bool{field.param: true}}
+
+			var data sensorHTTPResourceModel
+			setSensorHTTPResourceModelFromAPI(&data, sensor)
+			preserveReadValuesWhenAPIDoesNotReturnThem(&data, sensor, previous)
+
+			assert.NotEqual(t, field.expected, field.value(data))
+		})
+
+		t.Run(field.param+" without previous value stays empty", func(t *testing.T) {
+			sensor := &client.SensorHTTP{}
+
+			var data sensorHTTPResourceModel
+			setSensorHTTPResourceModelFromAPI(&data, sensor)
+			preserveReadValuesWhenAPIDoesNotReturnThem(&data, sensor, sensorHTTPResourceModel{})
+
+			assert.NotEqual(t, field.expected, field.value(data))
+		})
+	}
+}
+
 func TestAccSensorHTTPResource_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
@@ -386,3 +1398,69 @@ resource "wormly_sensor_http" "test" {
 }
 `, os.Getenv("WORMLY_API_KEY"), hostName, url, niceName, timeout)
 }
+
+func TestAccSensorHTTPResource_fullAttributes(t *testing.T) {
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSensorHTTPResourceConfigFullAttributes(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("wormly_sensor_http.test", "url", "https://example.org"),
+					resource.TestCheckResourceAttr("wormly_sensor_http.test", "timeout", "25"),
+					resource.TestCheckResourceAttr("wormly_sensor_http.test", "response_code", "200"),
+					resource.TestCheckResourceAttr("wormly_sensor_http.test", "verify_ssl_cert", "true"),
+					resource.TestCheckResourceAttr("wormly_sensor_http.test", "expected_text", "Example Domain"),
+					resource.TestCheckResourceAttr("wormly_sensor_http.test", "unwanted_text", "Internal Server Error"),
+					resource.TestCheckResourceAttr("wormly_sensor_http.test", "ssl_validity", "14"),
+					resource.TestCheckResourceAttr("wormly_sensor_http.test", "cookies", "session=abc123"),
+					resource.TestCheckResourceAttr("wormly_sensor_http.test", "post_params", "probe=1"),
+					resource.TestCheckResourceAttr("wormly_sensor_http.test", "custom_request_headers", "X-Probe: wormly"),
+					resource.TestCheckResourceAttr("wormly_sensor_http.test", "user_agent", "wormly-acceptance-test"),
+					resource.TestCheckResourceAttr("wormly_sensor_http.test", "force_resolve", "93.184.215.14"),
+				),
+			},
+			// Import testing: every attribute must round-trip through getHostSensors
+			{
+				ResourceName:      "wormly_sensor_http.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSensorHTTPResourceConfigFullAttributes(hostName string) string {
+	return fmt.Sprintf(`
+provider "wormly" {
+  api_key = "%s"
+}
+
+resource "wormly_host" "test" {
+  name          = "%s"
+  enabled       = true
+  test_interval = 60
+}
+
+resource "wormly_sensor_http" "test" {
+  host_id                = wormly_host.test.id
+  url                    = "https://example.org"
+  enabled                = true
+  timeout                = 25
+  response_code          = "200"
+  verify_ssl_cert        = true
+  expected_text          = "Example Domain"
+  unwanted_text          = "Internal Server Error"
+  ssl_validity           = 14
+  cookies                = "session=abc123"
+  post_params            = "probe=1"
+  custom_request_headers = "X-Probe: wormly"
+  user_agent             = "wormly-acceptance-test"
+  force_resolve          = "93.184.215.14"
+}
+`, os.Getenv("WORMLY_API_KEY"), hostName)
+}
@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/radarnex/terraform-provider-wormly/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &statusPageResource{}
+	_ resource.ResourceWithConfigure   = &statusPageResource{}
+	_ resource.ResourceWithImportState = &statusPageResource{}
+)
+
+// statusPageResourceModel represents the resource data model.
+type statusPageResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Title   types.String `tfsdk:"title"`
+	HostIDs types.Set    `tfsdk:"host_ids"`
+	Public  types.Bool   `tfsdk:"public"`
+}
+
+// statusPageResource defines the resource implementation.
+type statusPageResource struct {
+	client client.StatusPageAPI
+}
+
+// NewStatusPageResource creates a new status page resource.
+func NewStatusPageResource() resource.Resource {
+	return &statusPageResource{}
+}
+
+func (r *statusPageResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_page"
+}
+
+func (r *statusPageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Wormly status page resource. A status page shows the current state of a set of hosts; deleting it does not delete the hosts.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Status page identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Title shown at the top of the status page",
+				Required:            true,
+			},
+			"host_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the hosts shown on the status page",
+				ElementType:         types.Int64Type,
+				Required:            true,
+			},
+			"public": schema.BoolAttribute{
+				MarkdownDescription: "Whether the status page is publicly accessible. Defaults to false",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *statusPageResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.StatusPageAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.StatusPageAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *statusPageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data statusPageResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostIDs, diags := statusPageHosts(ctx, data.HostIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Status pages are created private, then published if requested
+	page, err := r.client.CreateStatusPage(ctx, data.Title.ValueString(), hostIDs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create status page, got error: %s%s", err, apiErrorHint(err)))
+		return
+	}
+
+	data.ID = types.StringValue(strconv.Itoa(page.ID))
+
+	if data.Public.ValueBool() {
+		if err := r.client.PublishStatusPage(ctx, page.ID); err != nil {
+			// Save the page as private so it is tracked, and the next apply publishes it
+			data.Public = types.BoolValue(false)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to publish status page %d, got error: %s", page.ID, err))
+			return
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *statusPageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data statusPageResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Parse the ID
+	id, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse status page ID: %s", err))
+		return
+	}
+
+	// Get the status page
+	page, err := r.client.GetStatusPage(ctx, id)
+	if err != nil {
+		// If the status page is not found, remove it from state
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read status page, got error: %s", err))
+		return
+	}
+
+	data.Title = types.StringValue(page.Title)
+	data.HostIDs = int64SetValue(page.HostIDs)
+	data.Public = types.BoolValue(page.Public)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *statusPageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state statusPageResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read current state data
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Parse the status page ID from the current state (not from plan, since ID is computed)
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse status page ID: %s", err))
+		return
+	}
+
+	hostIDs, diags := statusPageHosts(ctx, data.HostIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = state.ID
+
+	if !data.Title.Equal(state.Title) || !data.HostIDs.Equal(state.HostIDs) {
+		if _, err := r.client.UpdateStatusPage(ctx, id, data.Title.ValueString(), hostIDs); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update status page, got error: %s%s", err, apiErrorHint(err)))
+			return
+		}
+	}
+
+	// Handle visibility changes
+	if !data.Public.Equal(state.Public) {
+		if data.Public.ValueBool() {
+			err = r.client.PublishStatusPage(ctx, id)
+		} else {
+			err = r.client.UnpublishStatusPage(ctx, id)
+		}
+		if err != nil {
+			// Keep the previous visibility in state so the next plan retries the change
+			data.Public = state.Public
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to change the visibility of status page %d, got error: %s", id, err))
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *statusPageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data statusPageResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Parse the ID
+	id, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse status page ID: %s", err))
+		return
+	}
+
+	// Delete the status page; the hosts it shows are left in place
+	if err := r.client.DeleteStatusPage(ctx, id); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete status page, got error: %s", err))
+		return
+	}
+}
+
+func (r *statusPageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Validate status page ID is numeric
+	if _, err := strconv.Atoi(req.ID); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Status Page ID",
+			fmt.Sprintf("Unable to parse status page ID '%s': %s", req.ID, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// statusPageHosts converts the host_ids set into host IDs in ascending order.
+func statusPageHosts(ctx context.Context, hostIDs types.Set) ([]int, diag.Diagnostics) {
+	hosts, diags := int64SetElements(ctx, hostIDs)
+	slices.Sort(hosts)
+	return hosts, diags
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/radarnex/terraform-provider-wormly/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// newStatusPageTestValue builds a status page state and plan with the given attributes.
+func newStatusPageTestValue(t *testing.T, r *statusPageResource, id, title string, hostIDs []int, public bool) (tfsdk.State, tfsdk.Plan) {
+	t.Helper()
+
+	idValue := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	if id != "" {
+		idValue = tftypes.NewValue(tftypes.String, id)
+	}
+
+	value := newResourceTestValue(t, r, map[string]tftypes.Value{
+		"id":       idValue,
+		"title":    tftypes.NewValue(tftypes.String, title),
+		"host_ids": numberSetTestValue(hostIDs),
+		"public":   tftypes.NewValue(tftypes.Bool, public),
+	})
+	return value.State(), value.Plan()
+}
+
+func TestStatusPageResource_Create(t *testing.T) {
+	tests := []struct {
+		name           string
+		public         bool
+		publishErr     error
+		expectError    bool
+		expectedPublic bool
+	}{
+		{name: "private"},
+		{name: "public", public: true, expectedPublic: true},
+		{name: "publishing fails", public: true, publishErr: errors.New("API returned error code 1: Internal error"), expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &client.MockStatusPageAPI{}
+			mockClient.On("CreateStatusPage", mock.Anything, "Service status", []int{1, 2}).
+				Return(&client.StatusPage{ID: 42, Title: "Service status", HostIDs: []int{1, 2}}, nil)
+			if tt.public {
+				mockClient.On("PublishStatusPage", mock.Anything, 42).Return(tt.publishErr)
+			}
+
+			r := &statusPageResource{client: mockClient}
+			state, plan := newStatusPageTestValue(t, r, "", "Service status", []int{2, 1}, tt.public)
+			resp := &frameworkresource.CreateResponse{State: tfsdk.State{Schema: state.Schema}}
+
+			r.Create(t.Context(), frameworkresource.CreateRequest{Plan: plan}, resp)
+
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+			mockClient.AssertExpectations(t)
+
+			// The page is tracked even when publishing it failed
+			var data statusPageResourceModel
+			assert.False(t, resp.State.Get(t.Context(), &data).HasError())
+			assert.Equal(t, "42", data.ID.ValueString())
+			assert.Equal(t, tt.expectedPublic, data.Public.ValueBool())
+		})
+	}
+}
+
+func TestStatusPageResource_Read(t *testing.T) {
+	mockClient := &client.MockStatusPageAPI{}
+	mockClient.On("GetStatusPage", mock.Anything, 42).
+		Return(&client.StatusPage{ID: 42, Title: "Status", HostIDs: []int{3}, Public: true}, nil)
+
+	r := &statusPageResource{client: mockClient}
+	state, _ := newStatusPageTestValue(t, r, "42", "Service status", []int{1, 2}, false)
+	resp := &frameworkresource.ReadResponse{State: state}
+
+	r.Read(t.Context(), frameworkresource.ReadRequest{State: state}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+	var data statusPageResourceModel
+	assert.False(t, resp.State.Get(t.Context(), &data).HasError())
+	assert.Equal(t, "Status", data.Title.ValueString())
+	assert.True(t, data.Public.ValueBool())
+	hosts, diags := statusPageHosts(t.Context(), data.HostIDs)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []int{3}, hosts)
+}
+
+func TestStatusPageResource_ReadRemovesNotFound(t *testing.T) {
+	mockClient := &client.MockStatusPageAPI{}
+	mockClient.On("GetStatusPage", mock.Anything, 42).Return(nil, fmt.Errorf("status page with ID 42 %w", client.ErrNotFound))
+
+	r := &statusPageResource{client: mockClient}
+	state, _ := newStatusPageTestValue(t, r, "42", "Service status", []int{1}, false)
+	resp := &frameworkresource.ReadResponse{State: state}
+
+	r.Read(t.Context(), frameworkresource.ReadRequest{State: state}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.True(t, resp.State.Raw.IsNull())
+	mockClient.AssertExpectations(t)
+}
+
+func TestStatusPageResource_Update(t *testing.T) {
+	t.Run("visibility only", func(t *testing.T) {
+		mockClient := &client.MockStatusPageAPI{}
+		mockClient.On("UnpublishStatusPage", mock.Anything, 42).Return(nil)
+
+		r := &statusPageResource{client: mockClient}
+		state, _ := newStatusPageTestValue(t, r, "42", "Service status", []int{1}, true)
+		_, plan := newStatusPageTestValue(t, r, "42", "Service status", []int{1}, false)
+		resp := &frameworkresource.UpdateResponse{State: state}
+
+		r.Update(t.Context(), frameworkresource.UpdateRequest{Plan: plan, State: state}, resp)
+
+		assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+		mockClient.AssertExpectations(t)
+		mockClient.AssertNotCalled(t, "UpdateStatusPage", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("hosts", func(t *testing.T) {
+		mockClient := &client.MockStatusPageAPI{}
+		mockClient.On("UpdateStatusPage", mock.Anything, 42, "Service status", []int{1, 3}).
+			Return(&client.StatusPage{ID: 42, Title: "Service status", HostIDs: []int{1, 3}}, nil)
+
+		r := &statusPageResource{client: mockClient}
+		state, _ := newStatusPageTestValue(t, r, "42", "Service status", []int{1}, false)
+		_, plan := newStatusPageTestValue(t, r, "42", "Service status", []int{3, 1}, false)
+		resp := &frameworkresource.UpdateResponse{State: state}
+
+		r.Update(t.Context(), frameworkresource.UpdateRequest{Plan: plan, State: state}, resp)
+
+		assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+		mockClient.AssertExpectations(t)
+		mockClient.AssertNotCalled(t, "PublishStatusPage", mock.Anything, mock.Anything)
+		mockClient.AssertNotCalled(t, "UnpublishStatusPage", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// formatRequestHeaders serializes request headers into the customrequestheaders
+// format the API takes: one "Name: Value" line per header, separated by
+// newlines. Headers are sorted by name so the same map always gives the same
+// string.
+func formatRequestHeaders(headers map[string]string) string {
+	lines := make([]string, 0, len(headers))
+	for _, name := range slices.Sorted(maps.Keys(headers)) {
+		lines = append(lines, name+": "+headers[name])
+	}
+	return strings.Join(lines, "\n")
+}
+
+// parseRequestHeaders parses customrequestheaders as returned by the API into
+// a map. Lines may end in CRLF; blank lines and lines without a colon are
+// skipped, and a repeated header keeps its last value.
+func parseRequestHeaders(value string) map[string]string {
+	headers := make(map[string]string)
+	for _, line := range strings.Split(value, "\n") {
+		name, headerValue, ok := strings.Cut(strings.TrimSuffix(line, "\r"), ":")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			continue
+		}
+		headers[name] = strings.TrimSpace(headerValue)
+	}
+	return headers
+}
+
+// requestHeadersValue returns request_headers for a customrequestheaders string.
+func requestHeadersValue(value string) types.Map {
+	headers := parseRequestHeaders(value)
+	elements := make(map[string]attr.Value, len(headers))
+	for name, headerValue := range headers {
+		elements[name] = types.StringValue(headerValue)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
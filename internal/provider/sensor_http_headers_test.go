@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestHeaders_RoundTrip(t *testing.T) {
+	headers := map[string]string{
+		"X-Env":         "prod",
+		"Authorization": "Bearer abc",
+		"X-Time":        "12:00",
+		"X-Empty":       "",
+	}
+
+	formatted := formatRequestHeaders(headers)
+
+	assert.Equal(t, "Authorization: Bearer abc\nX-Empty: \nX-Env: prod\nX-Time: 12:00", formatted)
+	assert.Equal(t, headers, parseRequestHeaders(formatted))
+}
+
+func TestParseRequestHeaders(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected map[string]string
+	}{
+		{name: "empty", value: "", expected: map[string]string{}},
+		{name: "CRLF separated", value: "X-Env: prod\r\nX-Team: web\r\n", expected: map[string]string{"X-Env": "prod", "X-Team": "web"}},
+		{name: "without space after colon", value: "X-Env:prod", expected: map[string]string{"X-Env": "prod"}},
+		{name: "blank and malformed lines skipped", value: "X-Env: prod\n\nnot a header\n: no name", expected: map[string]string{"X-Env": "prod"}},
+		{name: "repeated header keeps last value", value: "X-Env: dev\nX-Env: prod", expected: map[string]string{"X-Env": "prod"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseRequestHeaders(tt.value))
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// sslValidityDays converts ssl_min_expiry_date into the ssl_validity the API
+// takes: the number of days from today (UTC) to the date, so the sensor alerts
+// once the certificate expires before it.
+func sslValidityDays(minExpiryDate string, now time.Time) (int, error) {
+	date, err := time.Parse(time.DateOnly, minExpiryDate)
+	if err != nil {
+		return 0, fmt.Errorf("ssl_min_expiry_date must be a date in YYYY-MM-DD format, got: %q", minExpiryDate)
+	}
+
+	today := now.UTC().Truncate(24 * time.Hour)
+	days := int(date.Sub(today).Hours() / 24)
+	if days < 1 {
+		return 0, fmt.Errorf("ssl_min_expiry_date must be after today (%s), got: %s", today.Format(time.DateOnly), minExpiryDate)
+	}
+	return days, nil
+}
+
+// sslMinExpiryDate converts an ssl_validity in days back into the date it
+// reaches from today (UTC).
+func sslMinExpiryDate(days int, now time.Time) string {
+	return now.UTC().Truncate(24*time.Hour).AddDate(0, 0, days).Format(time.DateOnly)
+}
+
+// readSSLMinExpiryDate sets ssl_min_expiry_date after a read. The API only
+// stores the days the date was converted to, which stand for a later date
+// every day, so the recorded date is kept while the days are unchanged. Days
+// changed outside Terraform are converted back into a date counted from today.
+func readSSLMinExpiryDate(data *sensorHTTPResourceModel, previous sensorHTTPResourceModel, now time.Time) {
+	data.SSLMinExpiryDate = previous.SSLMinExpiryDate
+	if previous.SSLMinExpiryDate.IsNull() || previous.SSLMinExpiryDate.IsUnknown() || data.SSLValidity.Equal(previous.SSLValidity) {
+		return
+	}
+
+	if days := data.SSLValidity.ValueInt64(); days > 0 {
+		data.SSLMinExpiryDate = types.StringValue(sslMinExpiryDate(int(days), now))
+	} else {
+		data.SSLMinExpiryDate = types.StringNull()
+	}
+}
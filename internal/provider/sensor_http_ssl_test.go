@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSLValidityDays(t *testing.T) {
+	// Late in the day, to show days are counted from the start of the UTC day
+	now := time.Date(2026, time.March, 1, 23, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		date          string
+		expected      int
+		expectedError string
+	}{
+		{name: "tomorrow", date: "2026-03-02", expected: 1},
+		{name: "across a month", date: "2026-04-01", expected: 31},
+		{name: "across a year", date: "2027-03-01", expected: 365},
+		{name: "today", date: "2026-03-01", expectedError: "must be after today (2026-03-01)"},
+		{name: "past", date: "2025-12-31", expectedError: "must be after today"},
+		{name: "not a date", date: "01/04/2026", expectedError: "YYYY-MM-DD"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			days, err := sslValidityDays(tt.date, now)
+
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, days)
+			assert.Equal(t, tt.date, sslMinExpiryDate(days, now))
+		})
+	}
+}
+
+func TestSSLValidityDays_NonUTCClock(t *testing.T) {
+	// 01:00 on 2 March in UTC+2 is still 1 March in UTC
+	now := time.Date(2026, time.March, 2, 1, 0, 0, 0, time.FixedZone("UTC+2", 2*60*60))
+
+	days, err := sslValidityDays("2026-03-11", now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 10, days)
+}
+
+func TestReadSSLMinExpiryDate(t *testing.T) {
+	now := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		previousDate types.String
+		previousDays types.Int64
+		liveDays     int64
+		expected     types.String
+	}{
+		{
+			name:         "unchanged days keep the configured date",
+			previousDate: types.StringValue("2026-06-01"),
+			previousDays: types.Int64Value(30),
+			liveDays:     30,
+			expected:     types.StringValue("2026-06-01"),
+		},
+		{
+			name:         "days changed outside Terraform",
+			previousDate: types.StringValue("2026-06-01"),
+			previousDays: types.Int64Value(30),
+			liveDays:     10,
+			expected:     types.StringValue("2026-03-11"),
+		},
+		{
+			name:         "check cleared outside Terraform",
+			previousDate: types.StringValue("2026-06-01"),
+			previousDays: types.Int64Value(30),
+			liveDays:     0,
+			expected:     types.StringNull(),
+		},
+		{
+			name:         "date not used",
+			previousDate: types.StringNull(),
+			previousDays: types.Int64Value(30),
+			liveDays:     10,
+			expected:     types.StringNull(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			previous := sensorHTTPResourceModel{SSLMinExpiryDate: tt.previousDate, SSLValidity: tt.previousDays}
+			data := sensorHTTPResourceModel{SSLValidity: types.Int64Value(tt.liveDays)}
+
+			readSSLMinExpiryDate(&data, previous, now)
+
+			assert.Equal(t, tt.expected, data.SSLMinExpiryDate)
+		})
+	}
+}
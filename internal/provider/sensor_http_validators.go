@@ -0,0 +1,300 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/radarnex/terraform-provider-wormly/internal/client"
+)
+
+// sensorHTTPConfigValidators codifies which HTTP sensor attributes only make sense together.
+var sensorHTTPConfigValidators = []resource.ConfigValidator{
+	sensorHTTPRule{
+		description: "exactly one of host_id or host_name must be set",
+		validate: func(data sensorHTTPResourceModel, diags *diag.Diagnostics) {
+			switch {
+			case !data.HostID.IsNull() && !data.HostName.IsNull():
+				diags.AddAttributeError(
+					path.Root("host_name"),
+					"Conflicting Host Attributes",
+					"host_id and host_name cannot both be set. Use host_id to reference a host by ID, or host_name to look it up by name.",
+				)
+			case data.HostID.IsNull() && data.HostName.IsNull():
+				diags.AddAttributeError(
+					path.Root("host_id"),
+					"Missing Host Attribute",
+					"One of host_id or host_name must be set.",
+				)
+			}
+		},
+	},
+	sensorHTTPRule{
+		description: "at most one of custom_request_headers or request_headers can be set",
+		validate: func(data sensorHTTPResourceModel, diags *diag.Diagnostics) {
+			if data.CustomRequestHeaders.IsNull() || data.RequestHeaders.IsNull() {
+				return
+			}
+			diags.AddAttributeError(
+				path.Root("custom_request_headers"),
+				"Conflicting Request Header Attributes",
+				"custom_request_headers is a deprecated alias of request_headers, only one of them can be set.",
+			)
+		},
+	},
+	sensorHTTPRule{
+		description: "search_headers requires expected_text or unwanted_text",
+		validate: func(data sensorHTTPResourceModel, diags *diag.Diagnostics) {
+			if data.SearchHeaders.IsUnknown() || !data.SearchHeaders.ValueBool() {
+				return
+			}
+			if !data.ExpectedText.IsNull() || !data.UnwantedText.IsNull() {
+				return
+			}
+			diags.AddAttributeError(
+				path.Root("search_headers"),
+				"Missing Text To Search",
+				"search_headers only changes where expected_text and unwanted_text are searched for; set at least one of them.",
+			)
+		},
+	},
+	sensorHTTPRule{
+		description: "expected_text and unwanted_text must differ",
+		validate: func(data sensorHTTPResourceModel, diags *diag.Diagnostics) {
+			if data.ExpectedText.IsNull() || data.ExpectedText.IsUnknown() || data.UnwantedText.IsNull() || data.UnwantedText.IsUnknown() {
+				return
+			}
+			if data.ExpectedText.ValueString() != data.UnwantedText.ValueString() {
+				return
+			}
+			diags.AddAttributeError(
+				path.Root("unwanted_text"),
+				"Identical Expected And Unwanted Text",
+				fmt.Sprintf("expected_text and unwanted_text are both %q, so the sensor fails whether or not the text is present. Set only the one that matches the intended check.",
+					data.ExpectedText.ValueString()),
+			)
+		},
+	},
+	sensorHTTPRule{
+		description: "expected_text must be a valid regular expression when expected_text_is_regex is true",
+		validate: func(data sensorHTTPResourceModel, diags *diag.Diagnostics) {
+			if data.ExpectedTextIsRegex.IsUnknown() || !data.ExpectedTextIsRegex.ValueBool() {
+				return
+			}
+			if data.ExpectedText.IsNull() || data.ExpectedText.IsUnknown() {
+				return
+			}
+			if _, err := regexp.Compile(data.ExpectedText.ValueString()); err != nil {
+				diags.AddAttributeError(
+					path.Root("expected_text"),
+					"Invalid Expected Text Regular Expression",
+					fmt.Sprintf("expected_text_is_regex is true, so expected_text must be a valid regular expression: %s", err),
+				)
+			}
+		},
+	},
+	sensorHTTPRule{
+		description: "http_method POST should be used with post_params",
+		validate: func(data sensorHTTPResourceModel, diags *diag.Diagnostics) {
+			if data.HTTPMethod.ValueString() != http.MethodPost || data.PostParams.IsUnknown() || data.PostParams.ValueString() != "" {
+				return
+			}
+			diags.AddAttributeWarning(
+				path.Root("http_method"),
+				"POST Without Post Params",
+				"http_method is POST but post_params is not set, so checks send an empty request body. Set post_params, or use GET if the URL does not expect a body.",
+			)
+		},
+	},
+	sensorHTTPRule{
+		description: "post_params cannot be set when http_method is GET or HEAD",
+		validate: func(data sensorHTTPResourceModel, diags *diag.Diagnostics) {
+			method := data.HTTPMethod.ValueString()
+			if method != http.MethodGet && method != http.MethodHead {
+				return
+			}
+			if data.PostParams.IsNull() || data.PostParams.IsUnknown() || data.PostParams.ValueString() == "" {
+				return
+			}
+			diags.AddAttributeError(
+				path.Root("post_params"),
+				"Post Params Without Request Body",
+				fmt.Sprintf("http_method is %s, which sends no request body, so post_params would be ignored. Use POST or PUT to send post_params, or remove them.", method),
+			)
+		},
+	},
+	sensorHTTPRule{
+		description: "auth_username and auth_password must be set together",
+		validate: func(data sensorHTTPResourceModel, diags *diag.Diagnostics) {
+			if data.AuthUsername.IsUnknown() || data.AuthPassword.IsUnknown() || data.AuthUsername.IsNull() == data.AuthPassword.IsNull() {
+				return
+			}
+			set, missing := "auth_username", "auth_password"
+			if data.AuthUsername.IsNull() {
+				set, missing = missing, set
+			}
+			diags.AddAttributeError(
+				path.Root(missing),
+				"Incomplete HTTP Authentication",
+				fmt.Sprintf("%s is set but %s is not. HTTP authentication needs both a username and a password; set both or neither.", set, missing),
+			)
+		},
+	},
+	sensorHTTPRule{
+		description: "post_params must be JSON when post_content_type is application/json",
+		validate: func(data sensorHTTPResourceModel, diags *diag.Diagnostics) {
+			if data.PostContentType.ValueString() != client.SensorHTTPPostContentTypeJSON ||
+				data.PostParams.IsNull() || data.PostParams.IsUnknown() || json.Valid([]byte(data.PostParams.ValueString())) {
+				return
+			}
+			diags.AddAttributeError(
+				path.Root("post_params"),
+				"Invalid JSON Post Body",
+				"post_content_type is application/json, so post_params is sent as the raw request body and must be valid JSON, such as {\"probe\": true}. "+
+					"Use application/x-www-form-urlencoded for name=value pairs.",
+			)
+		},
+	},
+	sensorHTTPRule{
+		description: "at most one of ssl_validity or ssl_min_expiry_date can be set",
+		validate: func(data sensorHTTPResourceModel, diags *diag.Diagnostics) {
+			if data.SSLValidity.IsNull() || data.SSLMinExpiryDate.IsNull() {
+				return
+			}
+			diags.AddAttributeError(
+				path.Root("ssl_min_expiry_date"),
+				"Conflicting SSL Validity Attributes",
+				"ssl_validity and ssl_min_expiry_date both set the SSL certificate expiry threshold, only one of them can be set.",
+			)
+		},
+	},
+	sensorHTTPRule{
+		description: "a non-zero ssl_validity or ssl_min_expiry_date requires an https url",
+		validate: func(data sensorHTTPResourceModel, diags *diag.Diagnostics) {
+			if data.URL.IsNull() || data.URL.IsUnknown() || strings.HasPrefix(strings.ToLower(data.URL.ValueString()), "https://") {
+				return
+			}
+			values := map[string]attr.Value{"ssl_validity": data.SSLValidity, "ssl_min_expiry_date": data.SSLMinExpiryDate}
+			for _, name := range []string{"ssl_validity", "ssl_min_expiry_date"} {
+				if value := values[name]; value.IsNull() || value.IsUnknown() {
+					continue
+				}
+				// ssl_validity = 0 skips the certificate check, which is valid for any URL
+				if name == "ssl_validity" && data.SSLValidity.ValueInt64() == 0 {
+					continue
+				}
+				diags.AddAttributeError(
+					path.Root(name),
+					"SSL Validity Requires HTTPS",
+					name+" checks the expiry of the SSL certificate, which is only presented for https URLs; got: "+data.URL.ValueString(),
+				)
+			}
+		},
+	},
+	sensorHTTPRule{
+		description: "alert_after_failures must be at least 1",
+		validate: func(data sensorHTTPResourceModel, diags *diag.Diagnostics) {
+			if data.AlertAfterFailures.IsNull() || data.AlertAfterFailures.IsUnknown() || data.AlertAfterFailures.ValueInt64() >= 1 {
+				return
+			}
+			diags.AddAttributeError(
+				path.Root("alert_after_failures"),
+				"Invalid Alert After Failures",
+				fmt.Sprintf("alert_after_failures is the number of consecutive failures before an alert is sent and must be at least 1, got: %d", data.AlertAfterFailures.ValueInt64()),
+			)
+		},
+	},
+	sensorHTTPRule{
+		description: "expected_text and unwanted_text should not be used for binary content",
+		validate: func(data sensorHTTPResourceModel, diags *diag.Diagnostics) {
+			if !isBinarySensorHTTPContent(data) {
+				return
+			}
+			for name, value := range map[string]types.String{"expected_text": data.ExpectedText, "unwanted_text": data.UnwantedText} {
+				if value.IsNull() || value.IsUnknown() {
+					continue
+				}
+				diags.AddAttributeWarning(
+					path.Root(name),
+					"Text Matching On Binary Content",
+					name+" is matched against the response as text, but the sensor appears to monitor binary content such as an image or PDF. "+
+						"The match may behave unpredictably; consider checking response_code instead.",
+				)
+			}
+		},
+	},
+}
+
+// binaryContentTypePrefixes lists content types whose responses are not text.
+var binaryContentTypePrefixes = []string{
+	"image/", "audio/", "video/", "font/",
+	"application/pdf", "application/octet-stream", "application/zip", "application/gzip",
+}
+
+// binaryURLExtensions lists URL path extensions that usually serve binary content.
+var binaryURLExtensions = []string{
+	".png", ".jpg", ".jpeg", ".gif", ".webp", ".ico", ".pdf", ".zip", ".gz", ".tar",
+	".exe", ".bin", ".mp3", ".mp4", ".woff", ".woff2",
+}
+
+// isBinarySensorHTTPContent reports whether response_content_type, or failing
+// that the URL path, suggests the sensor monitors binary content.
+func isBinarySensorHTTPContent(data sensorHTTPResourceModel) bool {
+	if !data.ResponseContentType.IsNull() && !data.ResponseContentType.IsUnknown() {
+		contentType := strings.ToLower(strings.TrimSpace(data.ResponseContentType.ValueString()))
+		for _, prefix := range binaryContentTypePrefixes {
+			if strings.HasPrefix(contentType, prefix) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if data.URL.IsNull() || data.URL.IsUnknown() {
+		return false
+	}
+	parsed, err := url.Parse(data.URL.ValueString())
+	if err != nil {
+		return false
+	}
+	urlPath := strings.ToLower(parsed.Path)
+	for _, extension := range binaryURLExtensions {
+		if strings.HasSuffix(urlPath, extension) {
+			return true
+		}
+	}
+	return false
+}
+
+// sensorHTTPRule is a resource.ConfigValidator enforcing one relationship between HTTP sensor attributes.
+type sensorHTTPRule struct {
+	description string
+	validate    func(data sensorHTTPResourceModel, diags *diag.Diagnostics)
+}
+
+func (v sensorHTTPRule) Description(_ context.Context) string {
+	return v.description
+}
+
+func (v sensorHTTPRule) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v sensorHTTPRule) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data sensorHTTPResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	v.validate(data, &resp.Diagnostics)
+}
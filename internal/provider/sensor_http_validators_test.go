@@ -0,0 +1,391 @@
+package provider
+
+import (
+	"testing"
+
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+// newSensorHTTPTestConfig builds an HTTP sensor configuration with the given
+// attribute values; every other attribute is null.
+func newSensorHTTPTestConfig(t *testing.T, r *sensorHTTPResource, attributes map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+
+	return newResourceTestValue(t, r, attributes).Config()
+}
+
+func TestSensorHTTPResource_ConfigValidators(t *testing.T) {
+	tests := []struct {
+		name          string
+		attributes    map[string]tftypes.Value
+		expectError   string
+		expectWarning string
+	}{
+		{
+			name: "host name",
+			attributes: map[string]tftypes.Value{
+				"host_name": tftypes.NewValue(tftypes.String, "web"),
+			},
+		},
+		{
+			name: "host id and host name",
+			attributes: map[string]tftypes.Value{
+				"host_id":   tftypes.NewValue(tftypes.Number, 123),
+				"host_name": tftypes.NewValue(tftypes.String, "web"),
+			},
+			expectError: "Conflicting Host Attributes",
+		},
+		{
+			name: "neither host id nor host name",
+			attributes: map[string]tftypes.Value{
+				"host_id": tftypes.NewValue(tftypes.Number, nil),
+			},
+			expectError: "Missing Host Attribute",
+		},
+		{
+			name: "request headers and deprecated custom request headers",
+			attributes: map[string]tftypes.Value{
+				"custom_request_headers": tftypes.NewValue(tftypes.String, "X-Env: prod"),
+				"request_headers": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"X-Env": tftypes.NewValue(tftypes.String, "prod"),
+				}),
+			},
+			expectError: "Conflicting Request Header Attributes",
+		},
+		{
+			name: "request headers only",
+			attributes: map[string]tftypes.Value{
+				"request_headers": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"X-Env": tftypes.NewValue(tftypes.String, "prod"),
+				}),
+			},
+		},
+		{
+			name: "search headers with expected text",
+			attributes: map[string]tftypes.Value{
+				"search_headers": tftypes.NewValue(tftypes.Bool, true),
+				"expected_text":  tftypes.NewValue(tftypes.String, "X-Status: ok"),
+			},
+		},
+		{
+			name: "search headers with unwanted text",
+			attributes: map[string]tftypes.Value{
+				"search_headers": tftypes.NewValue(tftypes.Bool, true),
+				"unwanted_text":  tftypes.NewValue(tftypes.String, "X-Status: down"),
+			},
+		},
+		{
+			name: "search headers disabled without text",
+			attributes: map[string]tftypes.Value{
+				"search_headers": tftypes.NewValue(tftypes.Bool, false),
+			},
+		},
+		{
+			name: "search headers with unknown text",
+			attributes: map[string]tftypes.Value{
+				"search_headers": tftypes.NewValue(tftypes.Bool, true),
+				"expected_text":  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			},
+		},
+		{
+			name: "search headers without text",
+			attributes: map[string]tftypes.Value{
+				"search_headers": tftypes.NewValue(tftypes.Bool, true),
+			},
+			expectError: "Missing Text To Search",
+		},
+		{
+			name: "different expected and unwanted text",
+			attributes: map[string]tftypes.Value{
+				"expected_text": tftypes.NewValue(tftypes.String, "OK"),
+				"unwanted_text": tftypes.NewValue(tftypes.String, "Error"),
+			},
+		},
+		{
+			name: "identical expected and unwanted text",
+			attributes: map[string]tftypes.Value{
+				"expected_text": tftypes.NewValue(tftypes.String, "OK"),
+				"unwanted_text": tftypes.NewValue(tftypes.String, "OK"),
+			},
+			expectError: "Identical Expected And Unwanted Text",
+		},
+		{
+			name: "identical text with search headers",
+			attributes: map[string]tftypes.Value{
+				"search_headers": tftypes.NewValue(tftypes.Bool, true),
+				"expected_text":  tftypes.NewValue(tftypes.String, "X-Status: ok"),
+				"unwanted_text":  tftypes.NewValue(tftypes.String, "X-Status: ok"),
+			},
+			expectError: "Identical Expected And Unwanted Text",
+		},
+		{
+			name: "expected text with unknown unwanted text",
+			attributes: map[string]tftypes.Value{
+				"expected_text": tftypes.NewValue(tftypes.String, "OK"),
+				"unwanted_text": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			},
+		},
+		{
+			name: "expected text regex",
+			attributes: map[string]tftypes.Value{
+				"expected_text":          tftypes.NewValue(tftypes.String, "^Status: (ok|degraded)$"),
+				"expected_text_is_regex": tftypes.NewValue(tftypes.Bool, true),
+			},
+		},
+		{
+			name: "invalid expected text regex",
+			attributes: map[string]tftypes.Value{
+				"expected_text":          tftypes.NewValue(tftypes.String, "Status: (ok"),
+				"expected_text_is_regex": tftypes.NewValue(tftypes.Bool, true),
+			},
+			expectError: "Invalid Expected Text Regular Expression",
+		},
+		{
+			name: "literal expected text is not compiled",
+			attributes: map[string]tftypes.Value{
+				"expected_text": tftypes.NewValue(tftypes.String, "Status: (ok"),
+			},
+		},
+		{
+			name: "post method with post params",
+			attributes: map[string]tftypes.Value{
+				"http_method": tftypes.NewValue(tftypes.String, "POST"),
+				"post_params": tftypes.NewValue(tftypes.String, "probe=1"),
+			},
+		},
+		{
+			name: "post method without post params",
+			attributes: map[string]tftypes.Value{
+				"http_method": tftypes.NewValue(tftypes.String, "POST"),
+			},
+			expectWarning: "POST Without Post Params",
+		},
+		{
+			name: "head method without post params",
+			attributes: map[string]tftypes.Value{
+				"http_method": tftypes.NewValue(tftypes.String, "HEAD"),
+			},
+		},
+		{
+			name: "get method with post params",
+			attributes: map[string]tftypes.Value{
+				"http_method": tftypes.NewValue(tftypes.String, "GET"),
+				"post_params": tftypes.NewValue(tftypes.String, "probe=1"),
+			},
+			expectError: "Post Params Without Request Body",
+		},
+		{
+			name: "head method with post params",
+			attributes: map[string]tftypes.Value{
+				"http_method": tftypes.NewValue(tftypes.String, "HEAD"),
+				"post_params": tftypes.NewValue(tftypes.String, "probe=1"),
+			},
+			expectError: "Post Params Without Request Body",
+		},
+		{
+			name: "put method with post params",
+			attributes: map[string]tftypes.Value{
+				"http_method": tftypes.NewValue(tftypes.String, "PUT"),
+				"post_params": tftypes.NewValue(tftypes.String, "probe=1"),
+			},
+		},
+		{
+			name: "auth username and password",
+			attributes: map[string]tftypes.Value{
+				"auth_username": tftypes.NewValue(tftypes.String, "monitor"),
+				"auth_password": tftypes.NewValue(tftypes.String, "secret"),
+			},
+		},
+		{
+			name: "auth username without password",
+			attributes: map[string]tftypes.Value{
+				"auth_username": tftypes.NewValue(tftypes.String, "monitor"),
+			},
+			expectError: "Incomplete HTTP Authentication",
+		},
+		{
+			name: "auth password without username",
+			attributes: map[string]tftypes.Value{
+				"auth_password": tftypes.NewValue(tftypes.String, "secret"),
+			},
+			expectError: "Incomplete HTTP Authentication",
+		},
+		{
+			name: "auth username with unknown password",
+			attributes: map[string]tftypes.Value{
+				"auth_username": tftypes.NewValue(tftypes.String, "monitor"),
+				"auth_password": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			},
+		},
+		{
+			name: "json post body",
+			attributes: map[string]tftypes.Value{
+				"post_content_type": tftypes.NewValue(tftypes.String, "application/json"),
+				"post_params":       tftypes.NewValue(tftypes.String, `{"probe": true}`),
+			},
+		},
+		{
+			name: "form params with json content type",
+			attributes: map[string]tftypes.Value{
+				"post_content_type": tftypes.NewValue(tftypes.String, "application/json"),
+				"post_params":       tftypes.NewValue(tftypes.String, "probe=1"),
+			},
+			expectError: "Invalid JSON Post Body",
+		},
+		{
+			name: "form params with form content type",
+			attributes: map[string]tftypes.Value{
+				"post_content_type": tftypes.NewValue(tftypes.String, "application/x-www-form-urlencoded"),
+				"post_params":       tftypes.NewValue(tftypes.String, "probe=1"),
+			},
+		},
+		{
+			name: "json content type with unknown post params",
+			attributes: map[string]tftypes.Value{
+				"post_content_type": tftypes.NewValue(tftypes.String, "application/json"),
+				"post_params":       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			},
+		},
+		{
+			name: "ssl validity with https url",
+			attributes: map[string]tftypes.Value{
+				"url":          tftypes.NewValue(tftypes.String, "https://example.com"),
+				"ssl_validity": tftypes.NewValue(tftypes.Number, 14),
+			},
+		},
+		{
+			name: "ssl validity with unknown url",
+			attributes: map[string]tftypes.Value{
+				"url":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"ssl_validity": tftypes.NewValue(tftypes.Number, 14),
+			},
+		},
+		{
+			name: "ssl validity with http url",
+			attributes: map[string]tftypes.Value{
+				"url":          tftypes.NewValue(tftypes.String, "http://example.com"),
+				"ssl_validity": tftypes.NewValue(tftypes.Number, 14),
+			},
+			expectError: "SSL Validity Requires HTTPS",
+		},
+		{
+			name: "zero ssl validity with http url",
+			attributes: map[string]tftypes.Value{
+				"url":          tftypes.NewValue(tftypes.String, "http://example.com"),
+				"ssl_validity": tftypes.NewValue(tftypes.Number, 0),
+			},
+		},
+		{
+			name: "ssl min expiry date with http url",
+			attributes: map[string]tftypes.Value{
+				"url":                 tftypes.NewValue(tftypes.String, "http://example.com"),
+				"ssl_min_expiry_date": tftypes.NewValue(tftypes.String, "2030-01-01"),
+			},
+			expectError: "SSL Validity Requires HTTPS",
+		},
+		{
+			name: "ssl min expiry date with https url",
+			attributes: map[string]tftypes.Value{
+				"url":                 tftypes.NewValue(tftypes.String, "https://example.com"),
+				"ssl_min_expiry_date": tftypes.NewValue(tftypes.String, "2030-01-01"),
+			},
+		},
+		{
+			name: "ssl validity and ssl min expiry date",
+			attributes: map[string]tftypes.Value{
+				"url":                 tftypes.NewValue(tftypes.String, "https://example.com"),
+				"ssl_validity":        tftypes.NewValue(tftypes.Number, 14),
+				"ssl_min_expiry_date": tftypes.NewValue(tftypes.String, "2030-01-01"),
+			},
+			expectError: "Conflicting SSL Validity Attributes",
+		},
+		{
+			name: "alert after one failure",
+			attributes: map[string]tftypes.Value{
+				"host_id":              tftypes.NewValue(tftypes.Number, 123),
+				"alert_after_failures": tftypes.NewValue(tftypes.Number, 1),
+			},
+		},
+		{
+			name: "alert after zero failures",
+			attributes: map[string]tftypes.Value{
+				"host_id":              tftypes.NewValue(tftypes.Number, 123),
+				"alert_after_failures": tftypes.NewValue(tftypes.Number, 0),
+			},
+			expectError: "Invalid Alert After Failures",
+		},
+		{
+			name: "expected text with binary content type",
+			attributes: map[string]tftypes.Value{
+				"url":                   tftypes.NewValue(tftypes.String, "https://example.com/report"),
+				"response_content_type": tftypes.NewValue(tftypes.String, "application/pdf"),
+				"expected_text":         tftypes.NewValue(tftypes.String, "Quarterly"),
+			},
+			expectWarning: "Text Matching On Binary Content",
+		},
+		{
+			name: "unwanted text with binary url",
+			attributes: map[string]tftypes.Value{
+				"url":           tftypes.NewValue(tftypes.String, "https://example.com/logo.PNG?v=2"),
+				"unwanted_text": tftypes.NewValue(tftypes.String, "error"),
+			},
+			expectWarning: "Text Matching On Binary Content",
+		},
+		{
+			name: "expected text with text content type overrides binary url",
+			attributes: map[string]tftypes.Value{
+				"url":                   tftypes.NewValue(tftypes.String, "https://example.com/download.zip"),
+				"response_content_type": tftypes.NewValue(tftypes.String, "text/html"),
+				"expected_text":         tftypes.NewValue(tftypes.String, "Download"),
+			},
+		},
+		{
+			name: "binary content type without text matching",
+			attributes: map[string]tftypes.Value{
+				"url":                   tftypes.NewValue(tftypes.String, "https://example.com/logo.png"),
+				"response_content_type": tftypes.NewValue(tftypes.String, "image/png"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attributes := map[string]tftypes.Value{
+				"host_id": tftypes.NewValue(tftypes.Number, 123),
+			}
+			if _, ok := tt.attributes["host_name"]; ok {
+				delete(attributes, "host_id")
+			}
+			for name, value := range tt.attributes {
+				attributes[name] = value
+			}
+
+			r := &sensorHTTPResource{}
+			req := frameworkresource.ValidateConfigRequest{
+				Config: newSensorHTTPTestConfig(t, r, attributes),
+			}
+			resp := &frameworkresource.ValidateConfigResponse{}
+
+			for _, validator := range r.ConfigValidators(t.Context()) {
+				validator.ValidateResource(t.Context(), req, resp)
+			}
+
+			if tt.expectWarning == "" {
+				assert.Zero(t, resp.Diagnostics.WarningsCount(), "diagnostics: %v", resp.Diagnostics)
+			} else if assert.Equal(t, 1, resp.Diagnostics.WarningsCount(), "diagnostics: %v", resp.Diagnostics) {
+				assert.Equal(t, tt.expectWarning, resp.Diagnostics.Warnings()[0].Summary())
+			}
+
+			if tt.expectError == "" {
+				assert.False(t, resp.Diagnostics.HasError(), "diagnostics: %v", resp.Diagnostics)
+				return
+			}
+			if assert.Equal(t, 1, resp.Diagnostics.ErrorsCount(), "diagnostics: %v", resp.Diagnostics) {
+				assert.Equal(t, tt.expectError, resp.Diagnostics.Errors()[0].Summary())
+			}
+		})
+	}
+}
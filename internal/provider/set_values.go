@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// int64SetElements converts a set of integers, such as host_ids, into ints.
+// A null or unknown set has no elements.
+func int64SetElements(ctx context.Context, set types.Set) ([]int, diag.Diagnostics) {
+	if set.IsNull() || set.IsUnknown() {
+		return nil, nil
+	}
+
+	var values []int64
+	diags := set.ElementsAs(ctx, &values, false)
+	elements := make([]int, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, int(value))
+	}
+
+	return elements, diags
+}
+
+// int64SetValue converts ints, such as host IDs, into a set value.
+func int64SetValue(elements []int) types.Set {
+	values := make([]attr.Value, 0, len(elements))
+	for _, element := range elements {
+		values = append(values, types.Int64Value(int64(element)))
+	}
+
+	return types.SetValueMust(types.Int64Type, values)
+}
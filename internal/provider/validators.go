@@ -0,0 +1,348 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math"
+	"net"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/radarnex/terraform-provider-wormly/internal/client"
+)
+
+// urlValidator requires a string attribute to be an absolute http or https URL with a host.
+type urlValidator struct{}
+
+var _ validator.String = urlValidator{}
+
+func (v urlValidator) Description(_ context.Context) string {
+	return "value must be an absolute http or https URL"
+}
+
+func (v urlValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v urlValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	parsed, err := url.ParseRequestURI(value)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid URL",
+			fmt.Sprintf("%q is not a valid URL to monitor. Use an absolute http:// or https:// URL including the host, such as https://example.com.", value),
+		)
+	}
+}
+
+// dateValidator requires a string attribute to be a date in YYYY-MM-DD format.
+type dateValidator struct{}
+
+var _ validator.String = dateValidator{}
+
+func (v dateValidator) Description(_ context.Context) string {
+	return "value must be a date in YYYY-MM-DD format"
+}
+
+func (v dateValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v dateValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if _, err := time.Parse(time.DateOnly, value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Date",
+			fmt.Sprintf("%q is not a date. Use the YYYY-MM-DD format, such as 2026-12-31.", value),
+		)
+	}
+}
+
+// ipAddressValidator requires a string attribute to be an IPv4 or IPv6 address.
+type ipAddressValidator struct{}
+
+var _ validator.String = ipAddressValidator{}
+
+func (v ipAddressValidator) Description(_ context.Context) string {
+	return "value must be an IPv4 or IPv6 address"
+}
+
+func (v ipAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipAddressValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if net.ParseIP(value) == nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Address",
+			fmt.Sprintf("%q is not an IP address. Use an IPv4 address such as 192.0.2.10 or an IPv6 address such as 2001:db8::10.", value),
+		)
+	}
+}
+
+// responseCodeValidator requires a string attribute to be an HTTP status code (100-599), a range
+// of codes such as 200-299, or a comma-separated list of either.
+type responseCodeValidator struct{}
+
+var _ validator.String = responseCodeValidator{}
+
+func (v responseCodeValidator) Description(_ context.Context) string {
+	return "value must be an HTTP status code, a range such as 200-299, or a comma-separated list of these"
+}
+
+func (v responseCodeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v responseCodeValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	for _, part := range strings.Split(value, ",") {
+		if !isResponseCodePattern(strings.TrimSpace(part)) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Response Code",
+				fmt.Sprintf("%q is not a valid response code. Use a status code between 100 and 599 (200), a range (200-299), or a comma-separated list of these (200,301).", value),
+			)
+			return
+		}
+	}
+}
+
+// isResponseCodePattern reports whether pattern is a single status code or an ascending range of codes.
+func isResponseCodePattern(pattern string) bool {
+	low, high, isRange := strings.Cut(pattern, "-")
+	if !isRange {
+		high = low
+	}
+
+	lowCode, ok := parseStatusCode(low)
+	if !ok {
+		return false
+	}
+	highCode, ok := parseStatusCode(high)
+	if !ok {
+		return false
+	}
+
+	return lowCode <= highCode
+}
+
+// parseStatusCode parses a three-digit HTTP status code between 100 and 599.
+func parseStatusCode(s string) (int, bool) {
+	if len(s) != 3 {
+		return 0, false
+	}
+	code, err := strconv.Atoi(s)
+	if err != nil || code < 100 || code > 599 {
+		return 0, false
+	}
+
+	return code, true
+}
+
+// stringOneOfValidator requires a string attribute to be one of values.
+type stringOneOfValidator struct {
+	values []string
+}
+
+var _ validator.String = stringOneOfValidator{}
+
+func (v stringOneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", strings.Join(v.values, ", "))
+}
+
+func (v stringOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringOneOfValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if !slices.Contains(v.values, value) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("%s must be one of %s, got: %q", req.Path, strings.Join(v.values, ", "), value),
+		)
+	}
+}
+
+// cookiesValidator requires a string attribute to be a list of cookies in
+// Cookie header form, such as "session=abc; theme=dark". Each cookie needs a
+// name and an "=", while values may be empty.
+type cookiesValidator struct{}
+
+var _ validator.String = cookiesValidator{}
+
+func (v cookiesValidator) Description(_ context.Context) string {
+	return "value must be a list of name=value cookies separated by semicolons"
+}
+
+func (v cookiesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cookiesValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// Cookies usually carry session tokens, so errors name the position rather than the value
+	for i, cookie := range strings.Split(req.ConfigValue.ValueString(), ";") {
+		cookie = strings.TrimSpace(cookie)
+		if cookie == "" {
+			continue
+		}
+		name, _, found := strings.Cut(cookie, "=")
+		if !found || strings.TrimSpace(name) == "" {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Cookies",
+				fmt.Sprintf("Cookie %d is not in name=value form. Separate cookies with semicolons, such as \"session=abc; theme=dark\".", i+1),
+			)
+		}
+	}
+}
+
+// requestHeadersValidator requires a map attribute of request headers to
+// survive the "Name: Value" line format the API stores them in: names must be
+// non-empty tokens without colons or whitespace, and values must fit on one
+// line without surrounding spaces, which the API would not keep.
+type requestHeadersValidator struct{}
+
+var _ validator.Map = requestHeadersValidator{}
+
+func (v requestHeadersValidator) Description(_ context.Context) string {
+	return "header names must not contain colons or whitespace, and values must be a single line without leading or trailing spaces"
+}
+
+func (v requestHeadersValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v requestHeadersValidator) ValidateMap(_ context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	elements := req.ConfigValue.Elements()
+	// Headers often carry credentials, so errors name the header rather than the value
+	for _, name := range slices.Sorted(maps.Keys(elements)) {
+		if name == "" || strings.ContainsAny(name, ": \t\r\n") {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Request Header",
+				fmt.Sprintf("Header name %q must not be empty or contain colons or whitespace.", name),
+			)
+			continue
+		}
+
+		value, ok := elements[name].(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if strings.ContainsAny(value.ValueString(), "\r\n") || strings.TrimSpace(value.ValueString()) != value.ValueString() {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtMapKey(name),
+				"Invalid Request Header",
+				fmt.Sprintf("The value of header %q must be a single line without leading or trailing spaces.", name),
+			)
+		}
+	}
+}
+
+// testLocationsValidator requires every element of a string set attribute to be a known Wormly test location code.
+type testLocationsValidator struct{}
+
+var _ validator.Set = testLocationsValidator{}
+
+func (v testLocationsValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("each value must be one of the Wormly test location codes: %s", strings.Join(client.TestLocations, ", "))
+}
+
+func (v testLocationsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v testLocationsValidator) ValidateSet(_ context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, element := range req.ConfigValue.Elements() {
+		location, ok := element.(types.String)
+		if !ok || location.IsNull() || location.IsUnknown() {
+			continue
+		}
+		if !client.IsValidTestLocation(location.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Test Location",
+				fmt.Sprintf("%q is not a known Wormly test location. Use one of: %s.", location.ValueString(), strings.Join(client.TestLocations, ", ")),
+			)
+		}
+	}
+}
+
+// int64RangeValidator requires an int64 attribute to be between min and max, inclusive.
+// Use math.MaxInt64 as max for a lower bound only.
+type int64RangeValidator struct {
+	min, max int64
+}
+
+var _ validator.Int64 = int64RangeValidator{}
+
+func (v int64RangeValidator) Description(_ context.Context) string {
+	if v.max == math.MaxInt64 {
+		return fmt.Sprintf("value must be at least %d", v.min)
+	}
+	return fmt.Sprintf("value must be between %d and %d", v.min, v.max)
+}
+
+func (v int64RangeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v int64RangeValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt64()
+	if value < v.min || value > v.max {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Value Out Of Range",
+			fmt.Sprintf("%s %s, got: %d", req.Path, v.Description(ctx), value),
+		)
+	}
+}
@@ -0,0 +1,298 @@
+package provider
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/radarnex/terraform-provider-wormly/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestURLValidator(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       types.String
+		expectError bool
+	}{
+		{name: "https URL", value: types.StringValue("https://x.com")},
+		{name: "http URL with path", value: types.StringValue("http://x.com/health?full=1")},
+		{name: "unsupported scheme", value: types.StringValue("ftp://x.com"), expectError: true},
+		{name: "not a URL", value: types.StringValue("notaurl"), expectError: true},
+		{name: "missing scheme", value: types.StringValue("example.com"), expectError: true},
+		{name: "missing host", value: types.StringValue("https:///path"), expectError: true},
+		{name: "null", value: types.StringNull()},
+		{name: "unknown", value: types.StringUnknown()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("url"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+
+			urlValidator{}.ValidateString(t.Context(), req, resp)
+
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError())
+			if tt.expectError {
+				assert.Equal(t, "Invalid URL", resp.Diagnostics.Errors()[0].Summary())
+				withPath, ok := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath)
+				assert.True(t, ok, "Expected an attribute diagnostic")
+				if ok {
+					assert.Equal(t, path.Root("url"), withPath.Path())
+				}
+			}
+		})
+	}
+}
+
+func TestIPAddressValidator(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       types.String
+		expectError bool
+	}{
+		{name: "ipv4", value: types.StringValue("1.2.3.4")},
+		{name: "ipv6", value: types.StringValue("::1")},
+		{name: "hostname", value: types.StringValue("not-an-ip"), expectError: true},
+		{name: "ipv4 with port", value: types.StringValue("1.2.3.4:443"), expectError: true},
+		{name: "empty", value: types.StringValue(""), expectError: true},
+		{name: "null", value: types.StringNull()},
+		{name: "unknown", value: types.StringUnknown()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("force_resolve"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+
+			ipAddressValidator{}.ValidateString(t.Context(), req, resp)
+
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError())
+			if tt.expectError {
+				assert.Equal(t, "Invalid IP Address", resp.Diagnostics.Errors()[0].Summary())
+			}
+		})
+	}
+}
+
+func TestResponseCodeValidator(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       types.String
+		expectError bool
+	}{
+		{name: "single code", value: types.StringValue("200")},
+		{name: "range", value: types.StringValue("200-299")},
+		{name: "list", value: types.StringValue("200,301")},
+		{name: "list with spaces and range", value: types.StringValue("200, 301-302")},
+		{name: "word", value: types.StringValue("ok"), expectError: true},
+		{name: "letters", value: types.StringValue("abc"), expectError: true},
+		{name: "out of range", value: types.StringValue("600"), expectError: true},
+		{name: "too short", value: types.StringValue("99"), expectError: true},
+		{name: "descending range", value: types.StringValue("299-200"), expectError: true},
+		{name: "empty list entry", value: types.StringValue("200,"), expectError: true},
+		{name: "empty", value: types.StringValue(""), expectError: true},
+		{name: "null", value: types.StringNull()},
+		{name: "unknown", value: types.StringUnknown()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("response_code"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+
+			responseCodeValidator{}.ValidateString(t.Context(), req, resp)
+
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError())
+			if tt.expectError {
+				assert.Equal(t, "Invalid Response Code", resp.Diagnostics.Errors()[0].Summary())
+			}
+		})
+	}
+}
+
+func TestStringOneOfValidator(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       types.String
+		expectError bool
+	}{
+		{name: "get", value: types.StringValue("GET")},
+		{name: "head", value: types.StringValue("HEAD")},
+		{name: "put", value: types.StringValue("PUT")},
+		{name: "unsupported method", value: types.StringValue("DELETE"), expectError: true},
+		{name: "lower case", value: types.StringValue("post"), expectError: true},
+		{name: "empty", value: types.StringValue(""), expectError: true},
+		{name: "null", value: types.StringNull()},
+		{name: "unknown", value: types.StringUnknown()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("http_method"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+
+			stringOneOfValidator{values: client.SensorHTTPMethods}.ValidateString(t.Context(), req, resp)
+
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError())
+			if tt.expectError {
+				assert.Equal(t, "Invalid Attribute Value", resp.Diagnostics.Errors()[0].Summary())
+			}
+		})
+	}
+}
+
+func TestCookiesValidator(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       types.String
+		expectError bool
+	}{
+		{name: "single pair", value: types.StringValue("session=abc123")},
+		{name: "pair list", value: types.StringValue("session=abc123; theme=dark;lang=en")},
+		{name: "trailing semicolon", value: types.StringValue("session=abc123;")},
+		{name: "empty value", value: types.StringValue("consent=")},
+		{name: "value containing equals", value: types.StringValue("token=YWJj==")},
+		{name: "empty", value: types.StringValue("")},
+		{name: "missing equals", value: types.StringValue("session=abc123; theme"), expectError: true},
+		{name: "empty name", value: types.StringValue("=abc123"), expectError: true},
+		{name: "null", value: types.StringNull()},
+		{name: "unknown", value: types.StringUnknown()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("cookies"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+
+			cookiesValidator{}.ValidateString(t.Context(), req, resp)
+
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError())
+			if tt.expectError {
+				assert.Equal(t, "Invalid Cookies", resp.Diagnostics.Errors()[0].Summary())
+				assert.NotContains(t, resp.Diagnostics.Errors()[0].Detail(), "abc123")
+			}
+		})
+	}
+}
+
+func TestRequestHeadersValidator(t *testing.T) {
+	headers := func(values map[string]string) types.Map {
+		elements := make(map[string]attr.Value, len(values))
+		for name, value := range values {
+			elements[name] = types.StringValue(value)
+		}
+		return types.MapValueMust(types.StringType, elements)
+	}
+
+	tests := []struct {
+		name        string
+		value       types.Map
+		expectError bool
+	}{
+		{name: "headers", value: headers(map[string]string{"Authorization": "Bearer secret", "X-Env": "prod"})},
+		{name: "empty value", value: headers(map[string]string{"X-Empty": ""})},
+		{name: "value containing colon", value: headers(map[string]string{"X-Time": "12:00"})},
+		{name: "empty", value: headers(nil)},
+		{name: "name with colon", value: headers(map[string]string{"X-Env:": "prod"}), expectError: true},
+		{name: "name with space", value: headers(map[string]string{"X Env": "prod"}), expectError: true},
+		{name: "empty name", value: headers(map[string]string{"": "prod"}), expectError: true},
+		{name: "multiline value", value: headers(map[string]string{"Authorization": "Bearer secret\nX-Injected: 1"}), expectError: true},
+		{name: "padded value", value: headers(map[string]string{"Authorization": " Bearer secret"}), expectError: true},
+		{name: "null", value: types.MapNull(types.StringType)},
+		{name: "unknown", value: types.MapUnknown(types.StringType)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.MapRequest{Path: path.Root("request_headers"), ConfigValue: tt.value}
+			resp := &validator.MapResponse{}
+
+			requestHeadersValidator{}.ValidateMap(t.Context(), req, resp)
+
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError())
+			if tt.expectError {
+				assert.Equal(t, "Invalid Request Header", resp.Diagnostics.Errors()[0].Summary())
+				assert.NotContains(t, resp.Diagnostics.Errors()[0].Detail(), "secret")
+			}
+		})
+	}
+}
+
+func TestTestLocationsValidator(t *testing.T) {
+	locations := func(codes ...string) types.Set {
+		return testLocationsValue(codes)
+	}
+
+	tests := []struct {
+		name        string
+		value       types.Set
+		expectError bool
+	}{
+		{name: "known locations", value: locations("lon", "nyc")},
+		{name: "empty", value: locations()},
+		{name: "unknown location", value: locations("lon", "mars"), expectError: true},
+		{name: "wrong case", value: locations("LON"), expectError: true},
+		{name: "null", value: types.SetNull(types.StringType)},
+		{name: "unknown", value: types.SetUnknown(types.StringType)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.SetRequest{Path: path.Root("test_locations"), ConfigValue: tt.value}
+			resp := &validator.SetResponse{}
+
+			testLocationsValidator{}.ValidateSet(t.Context(), req, resp)
+
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError())
+			if tt.expectError {
+				assert.Equal(t, "Invalid Test Location", resp.Diagnostics.Errors()[0].Summary())
+				withPath, ok := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath)
+				assert.True(t, ok, "Expected an attribute diagnostic")
+				if ok {
+					assert.Equal(t, path.Root("test_locations"), withPath.Path())
+				}
+			}
+		})
+	}
+}
+
+func TestInt64RangeValidator(t *testing.T) {
+	tests := []struct {
+		name        string
+		validator   int64RangeValidator
+		value       types.Int64
+		expectError string
+	}{
+		{name: "timeout in range", validator: int64RangeValidator{min: 1, max: 120}, value: types.Int64Value(30)},
+		{name: "timeout at bounds", validator: int64RangeValidator{min: 1, max: 120}, value: types.Int64Value(120)},
+		{name: "timeout zero", validator: int64RangeValidator{min: 1, max: 120}, value: types.Int64Value(0),
+			expectError: "timeout value must be between 1 and 120, got: 0"},
+		{name: "timeout too large", validator: int64RangeValidator{min: 1, max: 120}, value: types.Int64Value(3600),
+			expectError: "timeout value must be between 1 and 120, got: 3600"},
+		{name: "lower bound only", validator: int64RangeValidator{min: 0, max: math.MaxInt64}, value: types.Int64Value(math.MaxInt64)},
+		{name: "negative", validator: int64RangeValidator{min: 0, max: math.MaxInt64}, value: types.Int64Value(-1),
+			expectError: "timeout value must be at least 0, got: -1"},
+		{name: "null", validator: int64RangeValidator{min: 1, max: 120}, value: types.Int64Null()},
+		{name: "unknown", validator: int64RangeValidator{min: 1, max: 120}, value: types.Int64Unknown()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.Int64Request{Path: path.Root("timeout"), ConfigValue: tt.value}
+			resp := &validator.Int64Response{}
+
+			tt.validator.ValidateInt64(t.Context(), req, resp)
+
+			assert.Equal(t, tt.expectError != "", resp.Diagnostics.HasError())
+			if tt.expectError != "" {
+				assert.Equal(t, "Value Out Of Range", resp.Diagnostics.Errors()[0].Summary())
+				assert.Equal(t, tt.expectError, resp.Diagnostics.Errors()[0].Detail())
+			}
+		})
+	}
+}